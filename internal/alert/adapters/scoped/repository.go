@@ -0,0 +1,94 @@
+// Package scoped confines an alert repository to the tenant carried in the
+// request context, through the project each alert and threshold belongs to.
+// Platform calls see every tenant, and requests carrying neither see no
+// alerts at all.
+package scoped
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/alert/domain"
+	"github.com/captainhbb/tbs-backend/internal/alert/ports"
+	projectScoped "github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+)
+
+type repository struct {
+	next			ports.Repository
+	projectRepo		projectPorts.Repository
+}
+
+// New wraps next so that every query is confined to the projects of the
+// tenant in ctx, which projectRepo looks up.
+func New(next ports.Repository, projectRepo projectPorts.Repository) ports.Repository {
+	return &repository{next: next, projectRepo: projectRepo}
+}
+
+func(r *repository) SetThresholds(ctx context.Context, projectID int, percents []int) error {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, projectID)
+	if err != nil {
+		return err
+	}
+	return r.next.SetThresholds(ctx, projectID, percents)
+}
+
+func(r *repository) GetThresholds(ctx context.Context, projectID int) ([]int, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.GetThresholds(ctx, projectID)
+}
+
+func(r *repository) CreateAlert(ctx context.Context, alert domain.Alert) (domain.Alert, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, alert.ProjectID)
+	if err != nil {
+		return domain.Alert{}, err
+	}
+	return r.next.CreateAlert(ctx, alert)
+}
+
+func(r *repository) UpdateAlert(ctx context.Context, alert domain.Alert) (domain.Alert, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, alert.ProjectID)
+	if err != nil {
+		return domain.Alert{}, err
+	}
+	return r.next.UpdateAlert(ctx, alert)
+}
+
+func(r *repository) ListAlerts(ctx context.Context, projectID int) ([]domain.Alert, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListAlerts(ctx, projectID)
+}
+
+// ListActiveAlerts leaves out the alerts of projects in other tenants.
+func(r *repository) ListActiveAlerts(ctx context.Context) ([]domain.Alert, error) {
+	alerts, err := r.next.ListActiveAlerts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]domain.Alert, 0, len(alerts))
+	checked := make(map[int]bool)
+	for _, alert := range alerts {
+		projectVisible, ok := checked[alert.ProjectID]
+		if !ok {
+			err := projectScoped.CheckProject(ctx, r.projectRepo, alert.ProjectID)
+			switch err {
+			case nil:
+				projectVisible = true
+			case projectPorts.ErrProjectNotFound:
+			default:
+				return nil, err
+			}
+			checked[alert.ProjectID] = projectVisible
+		}
+		if projectVisible {
+			visible = append(visible, alert)
+		}
+	}
+	return visible, nil
+}
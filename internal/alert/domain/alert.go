@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+// DefaultThresholds apply to projects that have not configured their own, as
+// percentages of the proposed budget.
+var DefaultThresholds = []int{75, 90, 100}
+
+// Alert records that a project's spend crossed ThresholdPercent of its budget.
+// It stays active until spend drops back below the threshold, so a threshold
+// raises a new alert only when it is crossed again.
+type Alert struct {
+	ID 					int
+	ProjectID			int
+	ThresholdPercent	int
+	Spent				money.Money
+	Budget				money.Money
+	TriggeredAt			time.Time
+	// NotifiedAt is zero until the alert has been delivered to the notifier.
+	NotifiedAt			time.Time
+	ResolvedAt			time.Time
+}
+
+func (a Alert) Active() bool {
+	return a.ResolvedAt.IsZero()
+}
+
+// OverThreshold summarises a project that currently has active alerts by the
+// highest threshold it has crossed.
+type OverThreshold struct {
+	ProjectID			int
+	ThresholdPercent	int
+	Spent				money.Money
+	Budget				money.Money
+	Since				time.Time
+}
@@ -0,0 +1,7 @@
+package ports
+
+import "errors"
+
+var (
+	ErrAlertExists				= errors.New("an active alert already exists for this threshold")
+)
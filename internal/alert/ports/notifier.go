@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/alert/domain"
+)
+
+// Notifier delivers alert events to project owners.
+//
+//go:generate mockery --dir . --name Notifier --structname MockNotifier --filename mock_notifier.go --output ./mock --outpkg mock
+type Notifier interface {
+	Notify(ctx context.Context, alert domain.Alert) error
+}
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/alert/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	SetThresholds(ctx context.Context, projectID int, percents []int) error
+	// GetThresholds returns nil when the project has not configured thresholds.
+	GetThresholds(ctx context.Context, projectID int) ([]int, error)
+	// CreateAlert fails with ErrAlertExists when the project already has an
+	// active alert for the same threshold.
+	CreateAlert(ctx context.Context, alert domain.Alert) (domain.Alert, error)
+	UpdateAlert(ctx context.Context, alert domain.Alert) (domain.Alert, error)
+	ListAlerts(ctx context.Context, projectID int) ([]domain.Alert, error)
+	ListActiveAlerts(ctx context.Context) ([]domain.Alert, error)
+}
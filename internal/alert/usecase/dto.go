@@ -0,0 +1,7 @@
+package usecase
+
+type SetThresholdsRequest struct {
+	ProjectID 				int
+	// Percents of the proposed budget. An empty list restores the defaults.
+	Percents 				[]int
+}
@@ -0,0 +1,8 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrInvalidThreshold			= errors.New("thresholds must be positive percentages")
+	ErrDuplicateThreshold		= errors.New("thresholds must be unique")
+)
@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/alert/domain"
+	"github.com/captainhbb/tbs-backend/internal/alert/ports"
+	costingUseCase "github.com/captainhbb/tbs-backend/internal/costing/usecase"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+)
+
+//go:generate mockery --dir . --name AlertService --structname MockAlertService --filename mock_alert_service.go --output ./mock --outpkg mock
+type AlertService interface {
+	SetThresholds(ctx context.Context, thresholds SetThresholdsRequest) ([]int, error)
+	GetThresholds(ctx context.Context, projectID int) ([]int, error)
+	// Evaluate compares the project's actual cost, expenses and labor, with its
+	// thresholds, raising alerts for newly crossed thresholds and resolving
+	// those spend fell back below. It returns the alerts raised by this call.
+	Evaluate(ctx context.Context, projectID int) ([]domain.Alert, error)
+	ListAlerts(ctx context.Context, projectID int) ([]domain.Alert, error)
+	ListOverThresholdProjects(ctx context.Context) ([]domain.OverThreshold, error)
+}
+
+type alertService struct {
+	repo ports.Repository
+	notifier ports.Notifier
+	projectRepo projectPorts.Repository
+	costingService costingUseCase.CostingService
+	membershipService membershipUseCase.MembershipService
+}
+
+func New(repo ports.Repository, notifier ports.Notifier, projectRepo projectPorts.Repository, costingService costingUseCase.CostingService, membershipService membershipUseCase.MembershipService) AlertService {
+	return &alertService{
+		repo: repo,
+		notifier: notifier,
+		projectRepo: projectRepo,
+		costingService: costingService,
+		membershipService: membershipService,
+	}
+}
+
+func(s *alertService) SetThresholds(ctx context.Context, setThresholdsRequest SetThresholdsRequest) ([]int, error) {
+	percents := append([]int(nil), setThresholdsRequest.Percents...)
+	sort.Ints(percents)
+	for i, percent := range percents {
+		if percent <= 0 {
+			return nil, ErrInvalidThreshold
+		}
+		if i > 0 && percents[i-1] == percent {
+			return nil, ErrDuplicateThreshold
+		}
+	}
+
+	err := s.membershipService.Authorize(ctx, setThresholdsRequest.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.SetThresholds(ctx, setThresholdsRequest.ProjectID, percents)
+	if err != nil {
+		return nil, err
+	}
+	if len(percents) == 0 {
+		return domain.DefaultThresholds, nil
+	}
+	return percents, nil
+}
+
+func(s *alertService) GetThresholds(ctx context.Context, projectID int) ([]int, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return s.thresholds(ctx, projectID)
+}
+
+func(s *alertService) Evaluate(ctx context.Context, projectID int) ([]domain.Alert, error) {
+	project, err := s.projectRepo.GetProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	budget := project.ProposedBudget
+	if budget.IsZero() {
+		return nil, nil
+	}
+
+	actualCost, err := s.costingService.ProjectActualCost(ctx, costingUseCase.ActualCostRequest{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	spent := actualCost.Total
+	thresholds, err := s.thresholds(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	alerts, err := s.repo.ListAlerts(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make(map[int]domain.Alert)
+	for _, alert := range alerts {
+		if alert.Active() {
+			active[alert.ThresholdPercent] = alert
+		}
+	}
+
+	now := time.Now()
+	var raised []domain.Alert
+	for _, threshold := range thresholds {
+		alert, isActive := active[threshold]
+		crossed := spent.Amount() * 100 >= int64(threshold) * budget.Amount()
+
+		switch {
+		case crossed && !isActive:
+			alert, err = s.repo.CreateAlert(ctx, domain.Alert{
+				ProjectID: projectID,
+				ThresholdPercent: threshold,
+				Spent: spent,
+				Budget: budget,
+				TriggeredAt: now,
+			})
+			if err == ports.ErrAlertExists {
+				// A concurrent evaluation raised it first.
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			raised = append(raised, alert)
+		case !crossed && isActive:
+			alert.ResolvedAt = now
+			_, err = s.repo.UpdateAlert(ctx, alert)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		case !crossed:
+			continue
+		}
+
+		if alert.NotifiedAt.IsZero() {
+			err = s.notifier.Notify(ctx, alert)
+			if err != nil {
+				return nil, err
+			}
+			alert.NotifiedAt = now
+			_, err = s.repo.UpdateAlert(ctx, alert)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return raised, nil
+}
+
+func(s *alertService) ListAlerts(ctx context.Context, projectID int) ([]domain.Alert, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListAlerts(ctx, projectID)
+}
+
+func(s *alertService) ListOverThresholdProjects(ctx context.Context) ([]domain.OverThreshold, error) {
+	alerts, err := s.repo.ListActiveAlerts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	highest := make(map[int]domain.Alert)
+	for _, alert := range alerts {
+		current, ok := highest[alert.ProjectID]
+		if !ok || alert.ThresholdPercent > current.ThresholdPercent {
+			highest[alert.ProjectID] = alert
+		}
+	}
+
+	projects := make([]domain.OverThreshold, 0, len(highest))
+	for projectID, alert := range highest {
+		err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+		switch err {
+		case nil:
+		case membershipUseCase.ErrForbidden:
+			continue
+		default:
+			return nil, err
+		}
+
+		projects = append(projects, domain.OverThreshold{
+			ProjectID: projectID,
+			ThresholdPercent: alert.ThresholdPercent,
+			Spent: alert.Spent,
+			Budget: alert.Budget,
+			Since: alert.TriggeredAt,
+		})
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].ProjectID < projects[j].ProjectID
+	})
+	return projects, nil
+}
+
+func(s *alertService) thresholds(ctx context.Context, projectID int) ([]int, error) {
+	percents, err := s.repo.GetThresholds(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if len(percents) == 0 {
+		return domain.DefaultThresholds, nil
+	}
+	return percents, nil
+}
@@ -0,0 +1,178 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/alert/domain"
+	"github.com/captainhbb/tbs-backend/internal/alert/ports"
+	portsMock "github.com/captainhbb/tbs-backend/internal/alert/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/alert/usecase"
+	budgetDomain "github.com/captainhbb/tbs-backend/internal/budget/domain"
+	costingDomain "github.com/captainhbb/tbs-backend/internal/costing/domain"
+	costingUseCase "github.com/captainhbb/tbs-backend/internal/costing/usecase"
+	costingUseCaseMock "github.com/captainhbb/tbs-backend/internal/costing/usecase/mock"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		spent int64
+		mockSetup func(repo *portsMock.MockRepository, notifier *portsMock.MockNotifier)
+		expectedRaised []int
+	}{
+		{
+			name: "crossing raises and notifies once",
+			spent: 8000,
+			mockSetup: func(repo *portsMock.MockRepository, notifier *portsMock.MockNotifier) {
+				repo.On("ListAlerts", mock.Anything, 1).Return([]domain.Alert{}, nil)
+				repo.On("CreateAlert", mock.Anything, mock.Anything).Return(func(_ context.Context, alert domain.Alert) (domain.Alert, error) {
+					require.Equal(t, 75, alert.ThresholdPercent)
+					alert.ID = 1
+					return alert, nil
+				}).Once()
+				notifier.On("Notify", mock.Anything, mock.Anything).Return(nil).Once()
+				repo.On("UpdateAlert", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.Alert)
+					require.False(t, capturedArg.NotifiedAt.IsZero())
+				}).Return(domain.Alert{}, nil).Once()
+			},
+			expectedRaised: []int{75},
+		},
+		{
+			name: "already alerted threshold stays quiet",
+			spent: 8000,
+			mockSetup: func(repo *portsMock.MockRepository, _ *portsMock.MockNotifier) {
+				repo.On("ListAlerts", mock.Anything, 1).Return([]domain.Alert{
+					{ID: 1, ProjectID: 1, ThresholdPercent: 75, NotifiedAt: time.Now()},
+				}, nil)
+			},
+		},
+		{
+			name: "undelivered alert is notified again",
+			spent: 8000,
+			mockSetup: func(repo *portsMock.MockRepository, notifier *portsMock.MockNotifier) {
+				repo.On("ListAlerts", mock.Anything, 1).Return([]domain.Alert{
+					{ID: 1, ProjectID: 1, ThresholdPercent: 75},
+				}, nil)
+				notifier.On("Notify", mock.Anything, mock.Anything).Return(nil).Once()
+				repo.On("UpdateAlert", mock.Anything, mock.Anything).Return(domain.Alert{}, nil).Once()
+			},
+		},
+		{
+			name: "falling back below resolves the alert",
+			spent: 5000,
+			mockSetup: func(repo *portsMock.MockRepository, _ *portsMock.MockNotifier) {
+				repo.On("ListAlerts", mock.Anything, 1).Return([]domain.Alert{
+					{ID: 1, ProjectID: 1, ThresholdPercent: 75, NotifiedAt: time.Now()},
+				}, nil)
+				repo.On("UpdateAlert", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.Alert)
+					require.False(t, capturedArg.Active())
+				}).Return(domain.Alert{}, nil).Once()
+			},
+		},
+		{
+			name: "concurrent evaluation raised it first",
+			spent: 7500,
+			mockSetup: func(repo *portsMock.MockRepository, _ *portsMock.MockNotifier) {
+				repo.On("ListAlerts", mock.Anything, 1).Return([]domain.Alert{}, nil)
+				repo.On("CreateAlert", mock.Anything, mock.Anything).Return(domain.Alert{}, ports.ErrAlertExists).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			notifierMock := portsMock.NewMockNotifier(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			costingServiceMock := costingUseCaseMock.NewMockCostingService(t)
+			service := usecase.New(repoMock, notifierMock, projectRepoMock, costingServiceMock, membershipUseCaseMock.NewMockMembershipService(t))
+
+			projectRepoMock.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, ProposedBudget: money.MustNew(10000, "USD")}, nil)
+			// Half of the spend is labor, which counts as much as expenses.
+			costingServiceMock.On("ProjectActualCost", mock.Anything, costingUseCase.ActualCostRequest{ProjectID: 1}).Return(costingDomain.ActualCost{
+				ProjectID: 1,
+				Expenses: map[string]money.Money{budgetDomain.CategoryTravel: money.MustNew(tt.spent / 2, "USD")},
+				Labor: money.MustNew(tt.spent - tt.spent / 2, "USD"),
+				Total: money.MustNew(tt.spent, "USD"),
+			}, nil)
+			repoMock.On("GetThresholds", mock.Anything, 1).Return(nil, nil)
+			tt.mockSetup(repoMock, notifierMock)
+
+			raised, err := service.Evaluate(context.Background(), 1)
+			require.NoError(t, err)
+			require.Len(t, raised, len(tt.expectedRaised))
+			for i, threshold := range tt.expectedRaised {
+				require.Equal(t, threshold, raised[i].ThresholdPercent)
+			}
+		})
+	}
+}
+
+func TestSetThresholds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		input []int
+		expected []int
+		expectedError error
+	}{
+		{name: "sorted", input: []int{100, 50}, expected: []int{50, 100}},
+		{name: "defaults", input: nil, expected: domain.DefaultThresholds},
+		{name: "non-positive", input: []int{0, 50}, expectedError: usecase.ErrInvalidThreshold},
+		{name: "duplicate", input: []int{50, 50}, expectedError: usecase.ErrDuplicateThreshold},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			service := usecase.New(repoMock, portsMock.NewMockNotifier(t), projectPortsMock.NewMockRepository(t), costingUseCaseMock.NewMockCostingService(t), membershipServiceMock)
+
+			membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil).Maybe()
+			repoMock.On("SetThresholds", mock.Anything, 1, mock.Anything).Return(nil).Maybe()
+
+			thresholds, err := service.SetThresholds(context.Background(), usecase.SetThresholdsRequest{ProjectID: 1, Percents: tt.input})
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, thresholds)
+			}
+		})
+	}
+}
+
+func TestListOverThresholdProjects(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	service := usecase.New(repoMock, portsMock.NewMockNotifier(t), projectPortsMock.NewMockRepository(t), costingUseCaseMock.NewMockCostingService(t), membershipServiceMock)
+
+	repoMock.On("ListActiveAlerts", mock.Anything).Return([]domain.Alert{
+		{ID: 1, ProjectID: 1, ThresholdPercent: 75},
+		{ID: 2, ProjectID: 1, ThresholdPercent: 90},
+		{ID: 3, ProjectID: 2, ThresholdPercent: 100},
+	}, nil)
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionView).Return(nil)
+	membershipServiceMock.On("Authorize", mock.Anything, 2, membershipDomain.PermissionView).Return(membershipUseCase.ErrForbidden)
+
+	projects, err := service.ListOverThresholdProjects(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []domain.OverThreshold{{ProjectID: 1, ThresholdPercent: 90}}, projects)
+}
@@ -0,0 +1,52 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/captainhbb/tbs-backend/internal/audit/domain"
+	"github.com/captainhbb/tbs-backend/internal/audit/ports"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+)
+
+type repository struct {
+	mu			sync.RWMutex
+	entries		[]domain.Entry
+}
+
+func New() ports.Repository {
+	return &repository{}
+}
+
+func(r *repository) CreateEntry(ctx context.Context, entry domain.Entry) (domain.Entry, error) {
+	tenantID, ok := tenant.IDFromContext(ctx)
+	if !ok && !tenant.IsPlatform(ctx) {
+		return domain.Entry{}, tenant.ErrTenantRequired
+	}
+	entry.TenantID = tenantID
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry.ID = len(r.entries) + 1
+	entry.Changes = append([]domain.FieldChange(nil), entry.Changes...)
+	r.entries = append(r.entries, entry)
+	return entry, nil
+}
+
+func(r *repository) ListEntries(ctx context.Context, filter domain.Filter) ([]domain.Entry, error) {
+	if _, ok := tenant.IDFromContext(ctx); !ok && !tenant.IsPlatform(ctx) {
+		return nil, tenant.ErrTenantRequired
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := []domain.Entry{}
+	for _, entry := range r.entries {
+		if tenant.Visible(ctx, entry.TenantID) && filter.Matches(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,78 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/audit/adapters/memory"
+	"github.com/captainhbb/tbs-backend/internal/audit/domain"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListEntries(t *testing.T) {
+	t.Parallel()
+
+	repo := memory.New()
+	ctx := tenant.WithID(context.Background(), 1)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	entries := []domain.Entry{
+		{ActorID: 1, Action: domain.ActionCreate, EntityType: domain.EntityProject, EntityID: 10, Timestamp: start},
+		{ActorID: 2, Action: domain.ActionUpdate, EntityType: domain.EntityProject, EntityID: 10, Timestamp: start.Add(time.Hour)},
+		{ActorID: 1, Action: domain.ActionCreate, EntityType: domain.EntityUser, EntityID: 10, Timestamp: start.Add(2 * time.Hour)},
+	}
+	for _, entry := range entries {
+		createdEntry, err := repo.CreateEntry(ctx, entry)
+		require.NoError(t, err)
+		require.Greater(t, createdEntry.ID, 0)
+		require.Equal(t, 1, createdEntry.TenantID)
+	}
+	_, err := repo.CreateEntry(tenant.WithID(context.Background(), 2), domain.Entry{ActorID: 3, Action: domain.ActionCreate, EntityType: domain.EntityProject, EntityID: 10, Timestamp: start})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		filter domain.Filter
+		expectedIDs []int
+	}{
+		{name: "no filter", filter: domain.Filter{}, expectedIDs: []int{1, 2, 3}},
+		{name: "by entity", filter: domain.Filter{EntityType: domain.EntityProject, EntityID: 10}, expectedIDs: []int{1, 2}},
+		{name: "by actor", filter: domain.Filter{ActorID: 1}, expectedIDs: []int{1, 3}},
+		{name: "by time range", filter: domain.Filter{From: start.Add(time.Hour), To: start.Add(2 * time.Hour)}, expectedIDs: []int{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found, err := repo.ListEntries(ctx, tt.filter)
+			require.NoError(t, err)
+
+			ids := []int{}
+			for _, entry := range found {
+				ids = append(ids, entry.ID)
+			}
+			require.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
+
+func TestEntriesNeedATenant(t *testing.T) {
+	t.Parallel()
+
+	repo := memory.New()
+	entry := domain.Entry{ActorID: 1, Action: domain.ActionCreate, EntityType: domain.EntityProject, EntityID: 10, Timestamp: time.Now()}
+
+	_, err := repo.CreateEntry(context.Background(), entry)
+	require.ErrorIs(t, err, tenant.ErrTenantRequired)
+	_, err = repo.ListEntries(context.Background(), domain.Filter{})
+	require.ErrorIs(t, err, tenant.ErrTenantRequired)
+
+	_, err = repo.CreateEntry(tenant.WithID(context.Background(), 1), entry)
+	require.NoError(t, err)
+	_, err = repo.CreateEntry(tenant.WithID(context.Background(), 2), entry)
+	require.NoError(t, err)
+	entries, err := repo.ListEntries(tenant.AsPlatform(context.Background()), domain.Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
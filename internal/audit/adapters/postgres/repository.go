@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/captainhbb/tbs-backend/internal/audit/domain"
+	"github.com/captainhbb/tbs-backend/internal/audit/ports"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
+)
+
+// Schema creates the table backing the repository.
+const Schema = `
+CREATE TABLE IF NOT EXISTS audit_entries (
+	id			SERIAL PRIMARY KEY,
+	tenant_id	INTEGER NOT NULL DEFAULT 0,
+	actor_id	INTEGER NOT NULL,
+	action		TEXT NOT NULL,
+	entity_type	TEXT NOT NULL,
+	entity_id	INTEGER NOT NULL,
+	created_at	TIMESTAMPTZ NOT NULL,
+	changes		JSONB NOT NULL
+);
+ALTER TABLE audit_entries ADD COLUMN IF NOT EXISTS tenant_id INTEGER NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS audit_entries_tenant_idx ON audit_entries (tenant_id, created_at);
+CREATE INDEX IF NOT EXISTS audit_entries_entity_idx ON audit_entries (entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS audit_entries_actor_idx ON audit_entries (actor_id);
+CREATE INDEX IF NOT EXISTS audit_entries_created_at_idx ON audit_entries (created_at);
+`
+
+type repository struct {
+	db *sql.DB
+}
+
+// New returns a repository on db. Entries are written within the transaction
+// carried by the context, so they commit or roll back with the change they record.
+func New(db *sql.DB) ports.Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+func(r *repository) CreateEntry(ctx context.Context, entry domain.Entry) (domain.Entry, error) {
+	tenantID, ok := tenant.IDFromContext(ctx)
+	if !ok && !tenant.IsPlatform(ctx) {
+		return domain.Entry{}, tenant.ErrTenantRequired
+	}
+	entry.TenantID = tenantID
+
+	changes, err := json.Marshal(entry.Changes)
+	if err != nil {
+		return domain.Entry{}, err
+	}
+
+	err = transaction.From(ctx, r.db).QueryRowContext(ctx,
+		`INSERT INTO audit_entries (tenant_id, actor_id, action, entity_type, entity_id, created_at, changes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`,
+		entry.TenantID, entry.ActorID, entry.Action, entry.EntityType, entry.EntityID, entry.Timestamp, changes,
+	).Scan(&entry.ID)
+	if err != nil {
+		return domain.Entry{}, err
+	}
+	return entry, nil
+}
+
+func(r *repository) ListEntries(ctx context.Context, filter domain.Filter) ([]domain.Entry, error) {
+	var conditions []string
+	var args []any
+	addCondition := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, condition + " $" + strconv.Itoa(len(args)))
+	}
+
+	if tenantID, ok := tenant.IDFromContext(ctx); ok {
+		addCondition("tenant_id =", tenantID)
+	} else if !tenant.IsPlatform(ctx) {
+		return nil, tenant.ErrTenantRequired
+	}
+	if filter.EntityType != "" {
+		addCondition("entity_type =", filter.EntityType)
+	}
+	if filter.EntityID != 0 {
+		addCondition("entity_id =", filter.EntityID)
+	}
+	if filter.ActorID != 0 {
+		addCondition("actor_id =", filter.ActorID)
+	}
+	if !filter.From.IsZero() {
+		addCondition("created_at >=", filter.From)
+	}
+	if !filter.To.IsZero() {
+		addCondition("created_at <", filter.To)
+	}
+
+	query := `SELECT id, tenant_id, actor_id, action, entity_type, entity_id, created_at, changes FROM audit_entries`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY created_at, id"
+
+	rows, err := transaction.From(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []domain.Entry{}
+	for rows.Next() {
+		var entry domain.Entry
+		var changes []byte
+		err := rows.Scan(&entry.ID, &entry.TenantID, &entry.ActorID, &entry.Action, &entry.EntityType, &entry.EntityID, &entry.Timestamp, &changes)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(changes, &entry.Changes)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
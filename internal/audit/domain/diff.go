@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Diff compares two values of the same struct type field by field and returns
+// the fields whose values differ. Either side may be nil, which is how creates
+// and deletes are recorded. Fields tagged `audit:"-"` are never reported.
+func Diff(before any, after any) []FieldChange {
+	beforeValue := structValue(before)
+	afterValue := structValue(after)
+
+	var structType reflect.Type
+	switch {
+	case beforeValue.IsValid():
+		structType = beforeValue.Type()
+	case afterValue.IsValid():
+		structType = afterValue.Type()
+	default:
+		return nil
+	}
+
+	var changes []FieldChange
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() || field.Tag.Get("audit") == "-" {
+			continue
+		}
+
+		beforeText := fieldText(beforeValue, i)
+		afterText := fieldText(afterValue, i)
+		if beforeText == afterText {
+			continue
+		}
+		changes = append(changes, FieldChange{
+			Field: field.Name,
+			Before: beforeText,
+			After: afterText,
+		})
+	}
+	return changes
+}
+
+func structValue(v any) reflect.Value {
+	if v == nil {
+		return reflect.Value{}
+	}
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return value
+}
+
+func fieldText(value reflect.Value, index int) string {
+	if !value.IsValid() {
+		return ""
+	}
+	field := value.Field(index).Interface()
+	if t, ok := field.(time.Time); ok {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339Nano)
+	}
+	return fmt.Sprint(field)
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	ActionCreate				= "create"
+	ActionUpdate				= "update"
+	ActionDelete				= "delete"
+	ActionTransferOwnership		= "transfer_ownership"
+)
+
+const (
+	EntityUser					= "user"
+	EntityProject				= "project"
+	EntityOwnershipTransfer		= "ownership_transfer"
+	EntityFieldDefinition		= "field_definition"
+)
+
+type FieldChange struct {
+	Field				string
+	Before				string
+	After				string
+}
+
+type Entry struct {
+	ID 					int
+	// TenantID is the tenant the change was made within, or zero for platform
+	// calls.
+	TenantID			int
+	ActorID				int
+	Action				string
+	EntityType			string
+	EntityID			int
+	Timestamp			time.Time
+	Changes				[]FieldChange
+}
+
+// Filter narrows an audit query. Zero-valued fields do not filter.
+type Filter struct {
+	EntityType			string
+	EntityID			int
+	ActorID				int
+	From				time.Time
+	To					time.Time
+}
+
+// Matches reports whether entry satisfies every non-zero field of the filter.
+// From is inclusive and To is exclusive.
+func (f Filter) Matches(entry Entry) bool {
+	if f.EntityType != "" && f.EntityType != entry.EntityType {
+		return false
+	}
+	if f.EntityID != 0 && f.EntityID != entry.EntityID {
+		return false
+	}
+	if f.ActorID != 0 && f.ActorID != entry.ActorID {
+		return false
+	}
+	if !f.From.IsZero() && entry.Timestamp.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && !entry.Timestamp.Before(f.To) {
+		return false
+	}
+	return true
+}
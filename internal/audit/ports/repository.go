@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/audit/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	// CreateEntry records the entry within the tenant carried by ctx, or
+	// outside of any for platform calls. Other requests fail with
+	// tenant.ErrTenantRequired.
+	CreateEntry(ctx context.Context, entry domain.Entry) (domain.Entry, error)
+	// ListEntries returns the entries matching filter, oldest first. Requests
+	// acting within a tenant only see that tenant's entries and platform calls
+	// see every entry; other requests fail with tenant.ErrTenantRequired.
+	ListEntries(ctx context.Context, filter domain.Filter) ([]domain.Entry, error)
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import "time"
+
+type RecordRequest struct {
+	Action				string
+	EntityType			string
+	EntityID			int
+	// Before and After are the entity as it was and as it is now. Before is nil
+	// for creates and After is nil for deletes.
+	Before				any
+	After				any
+}
+
+type ListEntriesRequest struct {
+	EntityType			string
+	EntityID			int
+	ActorID				int
+	From				time.Time
+	To					time.Time
+}
@@ -0,0 +1,7 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrInvalidTimeRange			= errors.New("audit query range ends before it starts")
+)
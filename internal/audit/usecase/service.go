@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/audit/domain"
+	"github.com/captainhbb/tbs-backend/internal/audit/ports"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+)
+
+//go:generate mockery --dir . --name AuditService --structname MockAuditService --filename mock_audit_service.go --output ./mock --outpkg mock
+type AuditService interface {
+	// Record stores an entry for the change. Callers record within the
+	// transaction of the change itself so that neither outlives the other.
+	Record(ctx context.Context, record RecordRequest) error
+	// ListEntries is open to global admins, and to users who may view the
+	// project when the query is for a single project's entries.
+	ListEntries(ctx context.Context, query ListEntriesRequest) ([]domain.Entry, error)
+}
+
+// RoleChecker is satisfied by the user service, and ProjectAuthorizer by the
+// membership service. Both depend on AuditService in turn, so they are
+// usually wired through RoleCheckerFunc and ProjectAuthorizerFunc.
+//go:generate mockery --dir . --name RoleChecker --structname MockRoleChecker --filename mock_role_checker.go --output ./mock --outpkg mock
+type RoleChecker interface {
+	RequireRole(ctx context.Context, roles ...string) error
+}
+
+//go:generate mockery --dir . --name ProjectAuthorizer --structname MockProjectAuthorizer --filename mock_project_authorizer.go --output ./mock --outpkg mock
+type ProjectAuthorizer interface {
+	Authorize(ctx context.Context, projectID int, permission string) error
+}
+
+// RoleCheckerFunc adapts a function to RoleChecker.
+type RoleCheckerFunc func(ctx context.Context, roles ...string) error
+
+func(f RoleCheckerFunc) RequireRole(ctx context.Context, roles ...string) error {
+	return f(ctx, roles...)
+}
+
+// ProjectAuthorizerFunc adapts a function to ProjectAuthorizer.
+type ProjectAuthorizerFunc func(ctx context.Context, projectID int, permission string) error
+
+func(f ProjectAuthorizerFunc) Authorize(ctx context.Context, projectID int, permission string) error {
+	return f(ctx, projectID, permission)
+}
+
+type auditService struct {
+	repo ports.Repository
+	roleChecker RoleChecker
+	projectAuthorizer ProjectAuthorizer
+}
+
+func New(repo ports.Repository, roleChecker RoleChecker, projectAuthorizer ProjectAuthorizer) AuditService {
+	return &auditService{
+		repo: repo,
+		roleChecker: roleChecker,
+		projectAuthorizer: projectAuthorizer,
+	}
+}
+
+func(s *auditService) Record(ctx context.Context, recordRequest RecordRequest) error {
+	actorID, _ := actor.IDFromContext(ctx)
+
+	entry := domain.Entry{
+		ActorID: actorID,
+		Action: recordRequest.Action,
+		EntityType: recordRequest.EntityType,
+		EntityID: recordRequest.EntityID,
+		Timestamp: time.Now(),
+		Changes: domain.Diff(recordRequest.Before, recordRequest.After),
+	}
+	_, err := s.repo.CreateEntry(ctx, entry)
+	return err
+}
+
+func(s *auditService) ListEntries(ctx context.Context, listEntriesRequest ListEntriesRequest) ([]domain.Entry, error) {
+	if !listEntriesRequest.From.IsZero() && !listEntriesRequest.To.IsZero() && listEntriesRequest.To.Before(listEntriesRequest.From) {
+		return nil, ErrInvalidTimeRange
+	}
+
+	var err error
+	if listEntriesRequest.EntityType == domain.EntityProject && listEntriesRequest.EntityID != 0 {
+		err = s.projectAuthorizer.Authorize(ctx, listEntriesRequest.EntityID, membershipDomain.PermissionView)
+	} else {
+		err = s.roleChecker.RequireRole(ctx, userDomain.RoleAdmin)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	filter := domain.Filter{
+		EntityType: listEntriesRequest.EntityType,
+		EntityID: listEntriesRequest.EntityID,
+		ActorID: listEntriesRequest.ActorID,
+		From: listEntriesRequest.From,
+		To: listEntriesRequest.To,
+	}
+	return s.repo.ListEntries(ctx, filter)
+}
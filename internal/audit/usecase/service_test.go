@@ -0,0 +1,187 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/audit/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/audit/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/audit/usecase"
+	usecaseMock "github.com/captainhbb/tbs-backend/internal/audit/usecase/mock"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type budgetRecord struct {
+	ID				int
+	Name			string
+	Budget			float64
+	Secret			string		`audit:"-"`
+	UpdatedAt		time.Time
+}
+
+func TestRecord(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		input usecase.RecordRequest
+		expectedChanges []domain.FieldChange
+	}{
+		{
+			name: "create records every field",
+			input: usecase.RecordRequest{
+				Action: domain.ActionCreate,
+				EntityType: domain.EntityProject,
+				EntityID: 1,
+				After: budgetRecord{ID: 1, Name: "Bridge", Budget: 1000, Secret: "x", UpdatedAt: updatedAt},
+			},
+			expectedChanges: []domain.FieldChange{
+				{Field: "ID", Before: "", After: "1"},
+				{Field: "Name", Before: "", After: "Bridge"},
+				{Field: "Budget", Before: "", After: "1000"},
+				{Field: "UpdatedAt", Before: "", After: "2025-03-01T10:00:00Z"},
+			},
+		},
+		{
+			name: "update records changed fields only",
+			input: usecase.RecordRequest{
+				Action: domain.ActionUpdate,
+				EntityType: domain.EntityProject,
+				EntityID: 1,
+				Before: budgetRecord{ID: 1, Name: "Bridge", Budget: 1000, Secret: "x"},
+				After: &budgetRecord{ID: 1, Name: "Bridge", Budget: 1500, Secret: "y"},
+			},
+			expectedChanges: []domain.FieldChange{
+				{Field: "Budget", Before: "1000", After: "1500"},
+			},
+		},
+		{
+			name: "delete records previous values",
+			input: usecase.RecordRequest{
+				Action: domain.ActionDelete,
+				EntityType: domain.EntityProject,
+				EntityID: 1,
+				Before: budgetRecord{ID: 1, Name: "Bridge"},
+			},
+			expectedChanges: []domain.FieldChange{
+				{Field: "ID", Before: "1", After: ""},
+				{Field: "Name", Before: "Bridge", After: ""},
+				{Field: "Budget", Before: "0", After: ""},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			service := usecase.New(repoMock, usecaseMock.NewMockRoleChecker(t), usecaseMock.NewMockProjectAuthorizer(t))
+
+			ctx := actor.WithID(context.Background(), 7)
+
+			repoMock.On("CreateEntry", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				capturedArg := args.Get(1).(domain.Entry)
+				require.Equal(t, 7, capturedArg.ActorID)
+				require.Equal(t, tt.input.Action, capturedArg.Action)
+				require.Equal(t, tt.input.EntityType, capturedArg.EntityType)
+				require.Equal(t, tt.input.EntityID, capturedArg.EntityID)
+				require.WithinDuration(t, time.Now(), capturedArg.Timestamp, time.Minute)
+				require.Equal(t, tt.expectedChanges, capturedArg.Changes)
+			}).Return(domain.Entry{ID: 1}, nil)
+
+			err := service.Record(ctx, tt.input)
+			require.NoError(t, err)
+		})
+	}
+}
+
+var errForbidden = errors.New("forbidden")
+
+func TestListEntries(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		input usecase.ListEntriesRequest
+		mockSetup func(repo *portsMock.MockRepository, roleChecker *usecaseMock.MockRoleChecker, projectAuthorizer *usecaseMock.MockProjectAuthorizer)
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "project history for a project viewer",
+			input: usecase.ListEntriesRequest{EntityType: domain.EntityProject, EntityID: 1, ActorID: 2, From: from, To: to},
+			mockSetup: func(repo *portsMock.MockRepository, _ *usecaseMock.MockRoleChecker, projectAuthorizer *usecaseMock.MockProjectAuthorizer) {
+				projectAuthorizer.On("Authorize", mock.Anything, 1, membershipDomain.PermissionView).Return(nil)
+				repo.On("ListEntries", mock.Anything, domain.Filter{
+					EntityType: domain.EntityProject,
+					EntityID: 1,
+					ActorID: 2,
+					From: from,
+					To: to,
+				}).Return([]domain.Entry{{ID: 1}}, nil)
+			},
+		},
+		{
+			name: "project history for a user outside the project",
+			input: usecase.ListEntriesRequest{EntityType: domain.EntityProject, EntityID: 1},
+			mockSetup: func(_ *portsMock.MockRepository, _ *usecaseMock.MockRoleChecker, projectAuthorizer *usecaseMock.MockProjectAuthorizer) {
+				projectAuthorizer.On("Authorize", mock.Anything, 1, membershipDomain.PermissionView).Return(errForbidden)
+			},
+			expectError: true,
+			expectedError: errForbidden,
+		},
+		{
+			name: "every entry for an admin",
+			input: usecase.ListEntriesRequest{ActorID: 2},
+			mockSetup: func(repo *portsMock.MockRepository, roleChecker *usecaseMock.MockRoleChecker, _ *usecaseMock.MockProjectAuthorizer) {
+				roleChecker.On("RequireRole", mock.Anything, userDomain.RoleAdmin).Return(nil)
+				repo.On("ListEntries", mock.Anything, domain.Filter{ActorID: 2}).Return([]domain.Entry{{ID: 1}}, nil)
+			},
+		},
+		{
+			name: "every entry for anyone else",
+			input: usecase.ListEntriesRequest{EntityType: domain.EntityUser},
+			mockSetup: func(_ *portsMock.MockRepository, roleChecker *usecaseMock.MockRoleChecker, _ *usecaseMock.MockProjectAuthorizer) {
+				roleChecker.On("RequireRole", mock.Anything, userDomain.RoleAdmin).Return(errForbidden)
+			},
+			expectError: true,
+			expectedError: errForbidden,
+		},
+		{
+			name: "range ends before it starts",
+			input: usecase.ListEntriesRequest{From: to, To: from},
+			mockSetup: func(_ *portsMock.MockRepository, _ *usecaseMock.MockRoleChecker, _ *usecaseMock.MockProjectAuthorizer) {},
+			expectError: true,
+			expectedError: usecase.ErrInvalidTimeRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			roleCheckerMock := usecaseMock.NewMockRoleChecker(t)
+			projectAuthorizerMock := usecaseMock.NewMockProjectAuthorizer(t)
+			service := usecase.New(repoMock, roleCheckerMock, projectAuthorizerMock)
+
+			tt.mockSetup(repoMock, roleCheckerMock, projectAuthorizerMock)
+
+			entries, err := service.ListEntries(context.Background(), tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, entries, 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Package scoped confines a budget line item repository to the tenant carried
+// in the request context, through the project each budget line item belongs to.
+// Budget line items of projects in other tenants are reported as not found.
+// Platform calls see every tenant, and requests carrying neither see no budget
+// line items at all.
+package scoped
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/budget/domain"
+	"github.com/captainhbb/tbs-backend/internal/budget/ports"
+	projectScoped "github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+)
+
+type repository struct {
+	next			ports.Repository
+	projectRepo		projectPorts.Repository
+}
+
+// New wraps next so that every query is confined to the projects of the
+// tenant in ctx, which projectRepo looks up.
+func New(next ports.Repository, projectRepo projectPorts.Repository) ports.Repository {
+	return &repository{next: next, projectRepo: projectRepo}
+}
+
+func(r *repository) CreateLineItem(ctx context.Context, item domain.LineItem) (domain.LineItem, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, item.ProjectID)
+	if err != nil {
+		return domain.LineItem{}, err
+	}
+	return r.next.CreateLineItem(ctx, item)
+}
+
+func(r *repository) GetLineItem(ctx context.Context, id int) (domain.LineItem, error) {
+	item, err := r.next.GetLineItem(ctx, id)
+	if err != nil {
+		return domain.LineItem{}, err
+	}
+	err = projectScoped.CheckProject(ctx, r.projectRepo, item.ProjectID)
+	switch err {
+	case nil:
+	case projectPorts.ErrProjectNotFound:
+		return domain.LineItem{}, ports.ErrLineItemNotFound
+	default:
+		return domain.LineItem{}, err
+	}
+	return item, nil
+}
+
+// UpdateLineItem keeps the budget line item in the project it belongs to.
+func(r *repository) UpdateLineItem(ctx context.Context, item domain.LineItem) (domain.LineItem, error) {
+	existingLineItem, err := r.GetLineItem(ctx, item.ID)
+	if err != nil {
+		return domain.LineItem{}, err
+	}
+	item.ProjectID = existingLineItem.ProjectID
+	return r.next.UpdateLineItem(ctx, item)
+}
+
+func(r *repository) DeleteLineItem(ctx context.Context, id int) error {
+	_, err := r.GetLineItem(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeleteLineItem(ctx, id)
+}
+
+func(r *repository) ListLineItems(ctx context.Context, projectID int) ([]domain.LineItem, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListLineItems(ctx, projectID)
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+const (
+	CategoryLabor			= "labor"
+	CategoryHardware		= "hardware"
+	CategoryTravel			= "travel"
+	CategoryLicenses		= "licenses"
+	CategoryOther			= "other"
+)
+
+var categories = map[string]bool{
+	CategoryLabor: true,
+	CategoryHardware: true,
+	CategoryTravel: true,
+	CategoryLicenses: true,
+	CategoryOther: true,
+}
+
+// LineItem is a planned slice of a project's proposed budget.
+type LineItem struct {
+	ID 					int
+	ProjectID			int
+	Category			string
+	Planned				money.Money
+	Notes				string
+}
+
+// Reconciliation compares the sum of a project's line items with its proposed
+// budget. Difference is ProposedBudget minus Allocated, so a negative
+// difference means the line items ask for more than the project has.
+type Reconciliation struct {
+	ProjectID			int
+	ProposedBudget		money.Money
+	Allocated			money.Money
+	Difference			money.Money
+	ByCategory			map[string]money.Money
+	Balanced			bool
+}
+
+func IsValidCategory(category string) bool {
+	return categories[category]
+}
@@ -0,0 +1,7 @@
+package ports
+
+import "errors"
+
+var (
+	ErrLineItemNotFound			= errors.New("budget line item not found")
+)
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/budget/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	CreateLineItem(ctx context.Context, item domain.LineItem) (domain.LineItem, error)
+	GetLineItem(ctx context.Context, id int) (domain.LineItem, error)
+	UpdateLineItem(ctx context.Context, item domain.LineItem) (domain.LineItem, error)
+	DeleteLineItem(ctx context.Context, id int) error
+	ListLineItems(ctx context.Context, projectID int) ([]domain.LineItem, error)
+}
@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+type CreateLineItemRequest struct {
+	ProjectID		int
+	Category		string
+	Planned			money.Money
+	Notes			string
+}
+
+type UpdateLineItemRequest struct {
+	ID				int
+	Category		string
+	Planned			money.Money
+	Notes			string
+}
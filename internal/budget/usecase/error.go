@@ -0,0 +1,12 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrLineItemNotFound			= errors.New("budget line item not found")
+	ErrProjectNotFound			= errors.New("project not found")
+	ErrInvalidCategory			= errors.New("invalid budget category")
+	ErrInvalidAmount			= errors.New("planned amount must not be negative")
+	ErrCurrencyMismatch			= errors.New("line item currency must match the project budget currency")
+	ErrExceedsProposedBudget	= errors.New("line items would exceed the project's proposed budget")
+)
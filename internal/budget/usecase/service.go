@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/budget/domain"
+	"github.com/captainhbb/tbs-backend/internal/budget/ports"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+//go:generate mockery --dir . --name BudgetService --structname MockBudgetService --filename mock_budget_service.go --output ./mock --outpkg mock
+type BudgetService interface {
+	CreateLineItem(ctx context.Context, item CreateLineItemRequest) (domain.LineItem, error)
+	GetLineItem(ctx context.Context, id int) (domain.LineItem, error)
+	UpdateLineItem(ctx context.Context, item UpdateLineItemRequest) (domain.LineItem, error)
+	DeleteLineItem(ctx context.Context, id int) error
+	ListLineItems(ctx context.Context, projectID int) ([]domain.LineItem, error)
+	// Reconcile compares the project's line items with its proposed budget.
+	Reconcile(ctx context.Context, projectID int) (domain.Reconciliation, error)
+}
+
+type budgetService struct {
+	repo ports.Repository
+	projectRepo projectPorts.Repository
+	membershipService membershipUseCase.MembershipService
+}
+
+func New(repo ports.Repository, projectRepo projectPorts.Repository, membershipService membershipUseCase.MembershipService) BudgetService {
+	return &budgetService{
+		repo: repo,
+		projectRepo: projectRepo,
+		membershipService: membershipService,
+	}
+}
+
+func(s *budgetService) CreateLineItem(ctx context.Context, createLineItemRequest CreateLineItemRequest) (domain.LineItem, error) {
+	item := domain.LineItem{
+		ProjectID: createLineItemRequest.ProjectID,
+		Category: createLineItemRequest.Category,
+		Planned: createLineItemRequest.Planned,
+		Notes: createLineItemRequest.Notes,
+	}
+
+	err := s.membershipService.Authorize(ctx, item.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.LineItem{}, err
+	}
+
+	err = s.checkFits(ctx, item)
+	if err != nil {
+		return domain.LineItem{}, err
+	}
+	return s.repo.CreateLineItem(ctx, item)
+}
+
+func(s *budgetService) GetLineItem(ctx context.Context, id int) (domain.LineItem, error) {
+	item, err := s.getLineItem(ctx, id)
+	if err != nil {
+		return domain.LineItem{}, err
+	}
+
+	err = s.membershipService.Authorize(ctx, item.ProjectID, membershipDomain.PermissionView)
+	if err != nil {
+		return domain.LineItem{}, err
+	}
+	return item, nil
+}
+
+func(s *budgetService) UpdateLineItem(ctx context.Context, updateLineItemRequest UpdateLineItemRequest) (domain.LineItem, error) {
+	existingItem, err := s.getLineItem(ctx, updateLineItemRequest.ID)
+	if err != nil {
+		return domain.LineItem{}, err
+	}
+
+	err = s.membershipService.Authorize(ctx, existingItem.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.LineItem{}, err
+	}
+
+	item := domain.LineItem{
+		ID: existingItem.ID,
+		ProjectID: existingItem.ProjectID,
+		Category: updateLineItemRequest.Category,
+		Planned: updateLineItemRequest.Planned,
+		Notes: updateLineItemRequest.Notes,
+	}
+	err = s.checkFits(ctx, item)
+	if err != nil {
+		return domain.LineItem{}, err
+	}
+
+	updatedItem, err := s.repo.UpdateLineItem(ctx, item)
+	switch err {
+	case ports.ErrLineItemNotFound:
+		return domain.LineItem{}, ErrLineItemNotFound
+	}
+	return updatedItem, err
+}
+
+func(s *budgetService) DeleteLineItem(ctx context.Context, id int) error {
+	item, err := s.getLineItem(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.membershipService.Authorize(ctx, item.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteLineItem(ctx, id)
+	switch err {
+	case ports.ErrLineItemNotFound:
+		return ErrLineItemNotFound
+	}
+	return err
+}
+
+func(s *budgetService) ListLineItems(ctx context.Context, projectID int) ([]domain.LineItem, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListLineItems(ctx, projectID)
+}
+
+func(s *budgetService) Reconcile(ctx context.Context, projectID int) (domain.Reconciliation, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return domain.Reconciliation{}, err
+	}
+
+	project, err := s.getProject(ctx, projectID)
+	if err != nil {
+		return domain.Reconciliation{}, err
+	}
+	items, err := s.repo.ListLineItems(ctx, projectID)
+	if err != nil {
+		return domain.Reconciliation{}, err
+	}
+	return reconcile(project, items)
+}
+
+// checkFits validates item and makes sure that, together with the project's
+// other line items, it stays within the proposed budget.
+func(s *budgetService) checkFits(ctx context.Context, item domain.LineItem) error {
+	if !domain.IsValidCategory(item.Category) {
+		return ErrInvalidCategory
+	}
+	if item.Planned.IsNegative() {
+		return ErrInvalidAmount
+	}
+
+	project, err := s.getProject(ctx, item.ProjectID)
+	if err != nil {
+		return err
+	}
+	if item.Planned.Currency() != project.ProposedBudget.Currency() {
+		return ErrCurrencyMismatch
+	}
+
+	items, err := s.repo.ListLineItems(ctx, item.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	others := make([]domain.LineItem, 0, len(items) + 1)
+	for _, other := range items {
+		if other.ID != item.ID {
+			others = append(others, other)
+		}
+	}
+	reconciliation, err := reconcile(project, append(others, item))
+	if err != nil {
+		return err
+	}
+	if reconciliation.Difference.IsNegative() {
+		return ErrExceedsProposedBudget
+	}
+	return nil
+}
+
+func(s *budgetService) getLineItem(ctx context.Context, id int) (domain.LineItem, error) {
+	item, err := s.repo.GetLineItem(ctx, id)
+	switch err {
+	case ports.ErrLineItemNotFound:
+		return domain.LineItem{}, ErrLineItemNotFound
+	}
+	return item, err
+}
+
+func(s *budgetService) getProject(ctx context.Context, projectID int) (projectDomain.Project, error) {
+	project, err := s.projectRepo.GetProject(ctx, projectID)
+	switch err {
+	case projectPorts.ErrProjectNotFound:
+		return projectDomain.Project{}, ErrProjectNotFound
+	}
+	return project, err
+}
+
+func reconcile(project projectDomain.Project, items []domain.LineItem) (domain.Reconciliation, error) {
+	allocated, err := money.Zero(project.ProposedBudget.Currency())
+	if err != nil {
+		return domain.Reconciliation{}, err
+	}
+
+	byCategory := make(map[string]money.Money)
+	for _, item := range items {
+		allocated, err = allocated.Add(item.Planned)
+		if err != nil {
+			return domain.Reconciliation{}, ErrCurrencyMismatch
+		}
+
+		categoryTotal, ok := byCategory[item.Category]
+		if !ok {
+			categoryTotal = money.MustNew(0, allocated.Currency())
+		}
+		byCategory[item.Category], err = categoryTotal.Add(item.Planned)
+		if err != nil {
+			return domain.Reconciliation{}, ErrCurrencyMismatch
+		}
+	}
+
+	difference, err := project.ProposedBudget.Sub(allocated)
+	if err != nil {
+		return domain.Reconciliation{}, err
+	}
+	return domain.Reconciliation{
+		ProjectID: project.ID,
+		ProposedBudget: project.ProposedBudget,
+		Allocated: allocated,
+		Difference: difference,
+		ByCategory: byCategory,
+		Balanced: difference.IsZero(),
+	}, nil
+}
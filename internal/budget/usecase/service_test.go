@@ -0,0 +1,188 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/captainhbb/tbs-backend/internal/budget/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/budget/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/budget/usecase"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateLineItem(t *testing.T) {
+	t.Parallel()
+
+	project := projectDomain.Project{ID: 1, ProposedBudget: money.MustNew(100000, "USD")}
+
+	tests := []struct {
+		name string
+		input usecase.CreateLineItemRequest
+		mockSetup func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, membershipService *membershipUseCaseMock.MockMembershipService)
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "success",
+			input: usecase.CreateLineItemRequest{ProjectID: 1, Category: domain.CategoryLabor, Planned: money.MustNew(60000, "USD")},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, membershipService *membershipUseCaseMock.MockMembershipService) {
+				membershipService.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+				projectRepo.On("GetProject", mock.Anything, 1).Return(project, nil)
+				repo.On("ListLineItems", mock.Anything, 1).Return([]domain.LineItem{
+					{ID: 1, ProjectID: 1, Category: domain.CategoryTravel, Planned: money.MustNew(40000, "USD")},
+				}, nil)
+				repo.On("CreateLineItem", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.LineItem)
+					require.Equal(t, domain.CategoryLabor, capturedArg.Category)
+				}).Return(domain.LineItem{ID: 2, ProjectID: 1, Category: domain.CategoryLabor, Planned: money.MustNew(60000, "USD")}, nil)
+			},
+		},
+		{
+			name: "invalid category",
+			input: usecase.CreateLineItemRequest{ProjectID: 1, Category: "snacks", Planned: money.MustNew(100, "USD")},
+			mockSetup: func(_ *portsMock.MockRepository, _ *projectPortsMock.MockRepository, membershipService *membershipUseCaseMock.MockMembershipService) {
+				membershipService.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrInvalidCategory,
+		},
+		{
+			name: "negative amount",
+			input: usecase.CreateLineItemRequest{ProjectID: 1, Category: domain.CategoryOther, Planned: money.MustNew(-100, "USD")},
+			mockSetup: func(_ *portsMock.MockRepository, _ *projectPortsMock.MockRepository, membershipService *membershipUseCaseMock.MockMembershipService) {
+				membershipService.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrInvalidAmount,
+		},
+		{
+			name: "currency mismatch",
+			input: usecase.CreateLineItemRequest{ProjectID: 1, Category: domain.CategoryOther, Planned: money.MustNew(100, "EUR")},
+			mockSetup: func(_ *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, membershipService *membershipUseCaseMock.MockMembershipService) {
+				membershipService.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+				projectRepo.On("GetProject", mock.Anything, 1).Return(project, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrCurrencyMismatch,
+		},
+		{
+			name: "exceeds proposed budget",
+			input: usecase.CreateLineItemRequest{ProjectID: 1, Category: domain.CategoryHardware, Planned: money.MustNew(60001, "USD")},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, membershipService *membershipUseCaseMock.MockMembershipService) {
+				membershipService.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+				projectRepo.On("GetProject", mock.Anything, 1).Return(project, nil)
+				repo.On("ListLineItems", mock.Anything, 1).Return([]domain.LineItem{
+					{ID: 1, ProjectID: 1, Category: domain.CategoryTravel, Planned: money.MustNew(40000, "USD")},
+				}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrExceedsProposedBudget,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			service := usecase.New(repoMock, projectRepoMock, membershipServiceMock)
+
+			ctx := context.Background()
+
+			tt.mockSetup(repoMock, projectRepoMock, membershipServiceMock)
+
+			item, err := service.CreateLineItem(ctx, tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.input.Category, item.Category)
+				require.Equal(t, tt.input.Planned, item.Planned)
+			}
+		})
+	}
+}
+
+func TestUpdateLineItemExcludesItselfFromAllocation(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	projectRepoMock := projectPortsMock.NewMockRepository(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	service := usecase.New(repoMock, projectRepoMock, membershipServiceMock)
+
+	existing := domain.LineItem{ID: 1, ProjectID: 1, Category: domain.CategoryLabor, Planned: money.MustNew(100000, "USD")}
+	repoMock.On("GetLineItem", mock.Anything, 1).Return(existing, nil)
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+	projectRepoMock.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, ProposedBudget: money.MustNew(100000, "USD")}, nil)
+	repoMock.On("ListLineItems", mock.Anything, 1).Return([]domain.LineItem{existing}, nil)
+	repoMock.On("UpdateLineItem", mock.Anything, mock.Anything).Return(domain.LineItem{ID: 1, ProjectID: 1, Category: domain.CategoryLabor, Planned: money.MustNew(90000, "USD")}, nil)
+
+	item, err := service.UpdateLineItem(context.Background(), usecase.UpdateLineItemRequest{
+		ID: 1,
+		Category: domain.CategoryLabor,
+		Planned: money.MustNew(90000, "USD"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, money.MustNew(90000, "USD"), item.Planned)
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		items []domain.LineItem
+		expectedDifference money.Money
+		expectedBalanced bool
+	}{
+		{
+			name: "balanced",
+			items: []domain.LineItem{
+				{ID: 1, Category: domain.CategoryLabor, Planned: money.MustNew(70000, "USD")},
+				{ID: 2, Category: domain.CategoryTravel, Planned: money.MustNew(30000, "USD")},
+			},
+			expectedDifference: money.MustNew(0, "USD"),
+			expectedBalanced: true,
+		},
+		{
+			name: "under-allocated",
+			items: []domain.LineItem{
+				{ID: 1, Category: domain.CategoryLabor, Planned: money.MustNew(70000, "USD")},
+			},
+			expectedDifference: money.MustNew(30000, "USD"),
+		},
+		{
+			name: "over-allocated",
+			items: []domain.LineItem{
+				{ID: 1, Category: domain.CategoryLabor, Planned: money.MustNew(70000, "USD")},
+				{ID: 2, Category: domain.CategoryLabor, Planned: money.MustNew(50000, "USD")},
+			},
+			expectedDifference: money.MustNew(-20000, "USD"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			service := usecase.New(repoMock, projectRepoMock, membershipServiceMock)
+
+			membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionView).Return(nil)
+			projectRepoMock.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, ProposedBudget: money.MustNew(100000, "USD")}, nil)
+			repoMock.On("ListLineItems", mock.Anything, 1).Return(tt.items, nil)
+
+			reconciliation, err := service.Reconcile(context.Background(), 1)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedDifference, reconciliation.Difference)
+			require.Equal(t, tt.expectedBalanced, reconciliation.Balanced)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+// Package scoped confines a capacity repository to the tenant carried in the
+// request context. Capacities and holidays of other tenants, and allocations
+// on their projects, are reported as not found. Platform calls see every
+// tenant, and requests carrying neither see nothing at all.
+package scoped
+
+import (
+	"context"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/capacity/domain"
+	"github.com/captainhbb/tbs-backend/internal/capacity/ports"
+	projectScoped "github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+)
+
+type repository struct {
+	next			ports.Repository
+	projectRepo		projectPorts.Repository
+}
+
+// New wraps next so that every query is filtered by the tenant in ctx and new
+// capacities and holidays are set within it, which fails with
+// tenant.ErrTenantRequired when ctx carries none. Allocations are confined to
+// the tenant's projects, which projectRepo looks up.
+func New(next ports.Repository, projectRepo projectPorts.Repository) ports.Repository {
+	return &repository{next: next, projectRepo: projectRepo}
+}
+
+func(r *repository) GetCapacity(ctx context.Context, userID int) (domain.Capacity, error) {
+	capacity, err := r.next.GetCapacity(ctx, userID)
+	if err != nil {
+		return domain.Capacity{}, err
+	}
+	if !tenant.Visible(ctx, capacity.TenantID) {
+		return domain.Capacity{}, ports.ErrCapacityNotFound
+	}
+	return capacity, nil
+}
+
+// SaveCapacity cannot replace the capacity of a user of another tenant.
+func(r *repository) SaveCapacity(ctx context.Context, capacity domain.Capacity) (domain.Capacity, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return domain.Capacity{}, err
+	}
+	existingCapacity, err := r.next.GetCapacity(ctx, capacity.UserID)
+	switch err {
+	case nil:
+		if existingCapacity.TenantID != tenantID {
+			return domain.Capacity{}, ports.ErrCapacityNotFound
+		}
+	case ports.ErrCapacityNotFound:
+	default:
+		return domain.Capacity{}, err
+	}
+	capacity.TenantID = tenantID
+	return r.next.SaveCapacity(ctx, capacity)
+}
+
+func(r *repository) ListCapacities(ctx context.Context) ([]domain.Capacity, error) {
+	capacities, err := r.next.ListCapacities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]domain.Capacity, 0, len(capacities))
+	for _, capacity := range capacities {
+		if tenant.Visible(ctx, capacity.TenantID) {
+			visible = append(visible, capacity)
+		}
+	}
+	return visible, nil
+}
+
+func(r *repository) CreateHoliday(ctx context.Context, holiday domain.Holiday) (domain.Holiday, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return domain.Holiday{}, err
+	}
+	holiday.TenantID = tenantID
+	return r.next.CreateHoliday(ctx, holiday)
+}
+
+func(r *repository) GetHoliday(ctx context.Context, id int) (domain.Holiday, error) {
+	holiday, err := r.next.GetHoliday(ctx, id)
+	if err != nil {
+		return domain.Holiday{}, err
+	}
+	if !tenant.Visible(ctx, holiday.TenantID) {
+		return domain.Holiday{}, ports.ErrHolidayNotFound
+	}
+	return holiday, nil
+}
+
+func(r *repository) DeleteHoliday(ctx context.Context, id int) error {
+	_, err := r.GetHoliday(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeleteHoliday(ctx, id)
+}
+
+func(r *repository) ListHolidays(ctx context.Context, from time.Time, to time.Time) ([]domain.Holiday, error) {
+	holidays, err := r.next.ListHolidays(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]domain.Holiday, 0, len(holidays))
+	for _, holiday := range holidays {
+		if tenant.Visible(ctx, holiday.TenantID) {
+			visible = append(visible, holiday)
+		}
+	}
+	return visible, nil
+}
+
+func(r *repository) CreateAllocation(ctx context.Context, allocation domain.Allocation) (domain.Allocation, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, allocation.ProjectID)
+	if err != nil {
+		return domain.Allocation{}, err
+	}
+	return r.next.CreateAllocation(ctx, allocation)
+}
+
+func(r *repository) GetAllocation(ctx context.Context, id int) (domain.Allocation, error) {
+	allocation, err := r.next.GetAllocation(ctx, id)
+	if err != nil {
+		return domain.Allocation{}, err
+	}
+	err = projectScoped.CheckProject(ctx, r.projectRepo, allocation.ProjectID)
+	switch err {
+	case nil:
+	case projectPorts.ErrProjectNotFound:
+		return domain.Allocation{}, ports.ErrAllocationNotFound
+	default:
+		return domain.Allocation{}, err
+	}
+	return allocation, nil
+}
+
+// UpdateAllocation keeps the allocation on the project it belongs to.
+func(r *repository) UpdateAllocation(ctx context.Context, allocation domain.Allocation) (domain.Allocation, error) {
+	existingAllocation, err := r.GetAllocation(ctx, allocation.ID)
+	if err != nil {
+		return domain.Allocation{}, err
+	}
+	allocation.ProjectID = existingAllocation.ProjectID
+	return r.next.UpdateAllocation(ctx, allocation)
+}
+
+func(r *repository) DeleteAllocation(ctx context.Context, id int) error {
+	_, err := r.GetAllocation(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeleteAllocation(ctx, id)
+}
+
+// ListAllocations checks the filter's project, or leaves out the allocations
+// on projects of other tenants when it names none.
+func(r *repository) ListAllocations(ctx context.Context, filter domain.AllocationFilter) ([]domain.Allocation, error) {
+	if filter.ProjectID != 0 {
+		err := projectScoped.CheckProject(ctx, r.projectRepo, filter.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		return r.next.ListAllocations(ctx, filter)
+	}
+
+	allocations, err := r.next.ListAllocations(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	visible := make([]domain.Allocation, 0, len(allocations))
+	checked := make(map[int]bool)
+	for _, allocation := range allocations {
+		projectVisible, ok := checked[allocation.ProjectID]
+		if !ok {
+			err := projectScoped.CheckProject(ctx, r.projectRepo, allocation.ProjectID)
+			switch err {
+			case nil:
+				projectVisible = true
+			case projectPorts.ErrProjectNotFound:
+			default:
+				return nil, err
+			}
+			checked[allocation.ProjectID] = projectVisible
+		}
+		if projectVisible {
+			visible = append(visible, allocation)
+		}
+	}
+	return visible, nil
+}
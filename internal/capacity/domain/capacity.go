@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	// DefaultWeeklyHours is the capacity of users who have none set.
+	DefaultWeeklyHours			= 40 * time.Hour
+	// WorkingDays is how many days, Monday to Friday, a week's capacity and
+	// allocations are spread over.
+	WorkingDays					= 5
+)
+
+const (
+	StatusOver					= "over"
+	StatusUnder					= "under"
+	StatusFull					= "full"
+)
+
+// Capacity is the time a user can work in a week without holidays.
+// PartTimePercent scales WeeklyHours, with 100 meaning full time.
+type Capacity struct {
+	// TenantID is the organization of the user.
+	TenantID			int
+	UserID				int
+	WeeklyHours			time.Duration
+	PartTimePercent		int
+}
+
+// DefaultCapacity is the full-time capacity of userID.
+func DefaultCapacity(userID int) Capacity {
+	return Capacity{UserID: userID, WeeklyHours: DefaultWeeklyHours, PartTimePercent: 100}
+}
+
+// Daily is the time the user can work on a working day.
+func (c Capacity) Daily() time.Duration {
+	return c.WeeklyHours * time.Duration(c.PartTimePercent) / (100 * WorkingDays)
+}
+
+// Holiday is a day off for one user, or for everyone when UserID is zero.
+type Holiday struct {
+	ID 					int
+	// TenantID is the organization that gave the day off.
+	TenantID			int
+	UserID				int
+	Date				time.Time
+	Name				string
+}
+
+// Allocation books a user on a project from From through To, both inclusive.
+// It takes either Percent of the user's capacity on the days they are not on
+// holiday, or HoursPerWeek whatever their holidays.
+type Allocation struct {
+	ID 					int
+	UserID				int
+	ProjectID			int
+	Percent				int
+	HoursPerWeek		time.Duration
+	From				time.Time
+	To					time.Time
+}
+
+// AllocationFilter narrows an allocation query. Zero-valued fields do not
+// filter; From and To select allocations overlapping that range.
+type AllocationFilter struct {
+	UserID				int
+	ProjectID			int
+	From				time.Time
+	To					time.Time
+}
+
+// CapacityReport sets each user's capacity against their allocations for
+// every week from the Monday on or before From through To.
+type CapacityReport struct {
+	From				time.Time
+	To					time.Time
+	Weeks				[]UserWeek
+}
+
+// UserWeek is one user's capacity and allocations in the week starting on
+// WeekStart.
+type UserWeek struct {
+	UserID				int
+	WeekStart			time.Time
+	Capacity			time.Duration
+	Allocated			time.Duration
+	// Status is over when Allocated exceeds Capacity, under when it falls
+	// short and full otherwise.
+	Status				string
+	Projects			[]ProjectAllocation
+}
+
+// ProjectAllocation is the time allocated to a project in a week. A zero
+// ProjectID stands for the projects the reader may not view, added up.
+type ProjectAllocation struct {
+	ProjectID			int
+	Allocated			time.Duration
+}
+
+// IsWorkingDay reports whether day falls Monday to Friday.
+func IsWorkingDay(day time.Time) bool {
+	return day.Weekday() != time.Saturday && day.Weekday() != time.Sunday
+}
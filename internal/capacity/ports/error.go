@@ -0,0 +1,9 @@
+package ports
+
+import "errors"
+
+var (
+	ErrCapacityNotFound			= errors.New("capacity not found")
+	ErrHolidayNotFound			= errors.New("holiday not found")
+	ErrAllocationNotFound		= errors.New("allocation not found")
+)
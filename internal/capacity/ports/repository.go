@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/capacity/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	// GetCapacity returns ErrCapacityNotFound for users who have none set.
+	GetCapacity(ctx context.Context, userID int) (domain.Capacity, error)
+	// SaveCapacity creates or replaces the capacity of its user.
+	SaveCapacity(ctx context.Context, capacity domain.Capacity) (domain.Capacity, error)
+	ListCapacities(ctx context.Context) ([]domain.Capacity, error)
+	CreateHoliday(ctx context.Context, holiday domain.Holiday) (domain.Holiday, error)
+	GetHoliday(ctx context.Context, id int) (domain.Holiday, error)
+	DeleteHoliday(ctx context.Context, id int) error
+	// ListHolidays returns the holidays from from through to ordered by date.
+	ListHolidays(ctx context.Context, from time.Time, to time.Time) ([]domain.Holiday, error)
+	CreateAllocation(ctx context.Context, allocation domain.Allocation) (domain.Allocation, error)
+	GetAllocation(ctx context.Context, id int) (domain.Allocation, error)
+	UpdateAllocation(ctx context.Context, allocation domain.Allocation) (domain.Allocation, error)
+	DeleteAllocation(ctx context.Context, id int) error
+	// ListAllocations returns the allocations matching filter ordered by From.
+	ListAllocations(ctx context.Context, filter domain.AllocationFilter) ([]domain.Allocation, error)
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"time"
+)
+
+// SetCapacityRequest sets UserID's weekly hours. PartTimePercent defaults to
+// 100.
+type SetCapacityRequest struct {
+	UserID 					int
+	WeeklyHours 			time.Duration
+	PartTimePercent 		int
+}
+
+// AddHolidayRequest gives UserID, or everyone when UserID is zero, Date off.
+type AddHolidayRequest struct {
+	UserID 					int
+	Date 					time.Time
+	Name 					string
+}
+
+// CreateAllocationRequest books UserID on ProjectID for either Percent of
+// their capacity or HoursPerWeek. From and To default to the project's start
+// and end dates.
+type CreateAllocationRequest struct {
+	ProjectID 				int
+	UserID 					int
+	Percent 				int
+	HoursPerWeek 			time.Duration
+	From 					time.Time
+	To 						time.Time
+}
+
+type UpdateAllocationRequest struct {
+	ID 						int
+	Percent 				int
+	HoursPerWeek 			time.Duration
+	From 					time.Time
+	To 						time.Time
+}
+
+// CapacityReportRequest covers the weeks from the one containing From through
+// the one containing To. A non-empty Status keeps only weeks with that status.
+type CapacityReportRequest struct {
+	From 					time.Time
+	To 						time.Time
+	Status 					string
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrHolidayNotFound			= errors.New("holiday not found")
+	ErrAllocationNotFound		= errors.New("allocation not found")
+	ErrProjectNotFound			= errors.New("project not found")
+	ErrUserNotFound				= errors.New("user not found")
+	ErrUserInactive				= errors.New("inactive users cannot be allocated")
+	ErrForbidden				= errors.New("only admins can manage capacity and holidays")
+	ErrReportForbidden			= errors.New("only admins and managers can report on capacity")
+	ErrCapacityForbidden		= errors.New("only admins, managers and the user themselves can see a user's capacity")
+	ErrActorRequired			= errors.New("capacity and holidays are only shown to signed-in users")
+	ErrInvalidCapacity			= errors.New("weekly hours must be positive and at most a week, and part time between 1 and 100 percent")
+	ErrInvalidAllocation		= errors.New("an allocation takes either a percent between 1 and 100 or positive hours per week")
+	ErrInvalidDateRange			= errors.New("a date range needs a start on or before its end")
+	ErrOutsideProject			= errors.New("allocations must fall within the project's start and end dates")
+	ErrInvalidStatus			= errors.New("status must be over, under or full")
+)
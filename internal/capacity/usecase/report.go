@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/capacity/domain"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	timesheetDomain "github.com/captainhbb/tbs-backend/internal/timesheet/domain"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+)
+
+var statuses = map[string]bool{
+	domain.StatusOver: true,
+	domain.StatusUnder: true,
+	domain.StatusFull: true,
+}
+
+func(s *capacityService) ReportCapacity(ctx context.Context, reportRequest CapacityReportRequest) (domain.CapacityReport, error) {
+	if reportRequest.From.IsZero() || reportRequest.To.Before(reportRequest.From) {
+		return domain.CapacityReport{}, ErrInvalidDateRange
+	}
+	if reportRequest.Status != "" && !statuses[reportRequest.Status] {
+		return domain.CapacityReport{}, ErrInvalidStatus
+	}
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin, userDomain.RoleManager)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return domain.CapacityReport{}, ErrReportForbidden
+	default:
+		return domain.CapacityReport{}, err
+	}
+
+	first := timesheetDomain.WeekStart(reportRequest.From)
+	last := timesheetDomain.WeekStart(reportRequest.To).AddDate(0, 0, 6)
+
+	allocations, err := s.repo.ListAllocations(ctx, domain.AllocationFilter{From: first, To: last})
+	if err != nil {
+		return domain.CapacityReport{}, err
+	}
+	hidden, err := s.hiddenProjects(ctx, allocations)
+	if err != nil {
+		return domain.CapacityReport{}, err
+	}
+	holidays, err := s.repo.ListHolidays(ctx, first, last)
+	if err != nil {
+		return domain.CapacityReport{}, err
+	}
+	capacities, err := s.repo.ListCapacities(ctx)
+	if err != nil {
+		return domain.CapacityReport{}, err
+	}
+
+	byUser := make(map[int]domain.Capacity)
+	for _, capacity := range capacities {
+		byUser[capacity.UserID] = capacity
+	}
+	for _, allocation := range allocations {
+		if _, ok := byUser[allocation.UserID]; !ok {
+			byUser[allocation.UserID] = domain.DefaultCapacity(allocation.UserID)
+		}
+	}
+	userIDs := make([]int, 0, len(byUser))
+	for userID := range byUser {
+		_, err := s.getUser(ctx, userID)
+		switch err {
+		case nil:
+			userIDs = append(userIDs, userID)
+		case ErrUserNotFound:
+		default:
+			return domain.CapacityReport{}, err
+		}
+	}
+	sort.Ints(userIDs)
+
+	report := domain.CapacityReport{
+		From: reportRequest.From,
+		To: reportRequest.To,
+	}
+	for _, userID := range userIDs {
+		for weekStart := first; weekStart.Before(last); weekStart = weekStart.AddDate(0, 0, 7) {
+			userWeek := hideProjects(measureWeek(byUser[userID], weekStart, allocations, holidays), hidden)
+			if reportRequest.Status == "" || userWeek.Status == reportRequest.Status {
+				report.Weeks = append(report.Weeks, userWeek)
+			}
+		}
+	}
+	return report, nil
+}
+
+// hiddenProjects returns the projects of allocations the acting user may not
+// view.
+func(s *capacityService) hiddenProjects(ctx context.Context, allocations []domain.Allocation) (map[int]bool, error) {
+	hidden := make(map[int]bool)
+	checked := make(map[int]bool)
+	for _, allocation := range allocations {
+		if checked[allocation.ProjectID] {
+			continue
+		}
+		checked[allocation.ProjectID] = true
+
+		err := s.membershipService.Authorize(ctx, allocation.ProjectID, membershipDomain.PermissionView)
+		switch err {
+		case nil:
+		case membershipUseCase.ErrForbidden, membershipUseCase.ErrProjectNotFound:
+			hidden[allocation.ProjectID] = true
+		default:
+			return nil, err
+		}
+	}
+	return hidden, nil
+}
+
+// hideProjects folds the allocations to hidden projects into one entry without
+// a project, leaving the week's totals as they are.
+func hideProjects(userWeek domain.UserWeek, hidden map[int]bool) domain.UserWeek {
+	projects := make([]domain.ProjectAllocation, 0, len(userWeek.Projects))
+	var hiddenAllocated time.Duration
+	for _, project := range userWeek.Projects {
+		if hidden[project.ProjectID] {
+			hiddenAllocated += project.Allocated
+			continue
+		}
+		projects = append(projects, project)
+	}
+	if len(projects) == len(userWeek.Projects) {
+		return userWeek
+	}
+	userWeek.Projects = append([]domain.ProjectAllocation{{Allocated: hiddenAllocated}}, projects...)
+	return userWeek
+}
+
+// measureWeek adds up a user's capacity and allocations over the working days
+// of the week starting on weekStart.
+func measureWeek(capacity domain.Capacity, weekStart time.Time, allocations []domain.Allocation, holidays []domain.Holiday) domain.UserWeek {
+	userWeek := domain.UserWeek{
+		UserID: capacity.UserID,
+		WeekStart: weekStart,
+	}
+	daily := capacity.Daily()
+
+	byProject := make(map[int]time.Duration)
+	var projectIDs []int
+	for day := weekStart; day.Before(weekStart.AddDate(0, 0, 7)); day = day.AddDate(0, 0, 1) {
+		if !domain.IsWorkingDay(day) {
+			continue
+		}
+		onHoliday := isHoliday(capacity.UserID, day, holidays)
+		if !onHoliday {
+			userWeek.Capacity += daily
+		}
+
+		for _, allocation := range allocations {
+			if allocation.UserID != capacity.UserID || day.Before(allocation.From) || day.After(allocation.To) {
+				continue
+			}
+
+			var allocated time.Duration
+			switch {
+			case allocation.HoursPerWeek != 0:
+				allocated = allocation.HoursPerWeek / domain.WorkingDays
+			case !onHoliday:
+				allocated = daily * time.Duration(allocation.Percent) / 100
+			}
+			if _, ok := byProject[allocation.ProjectID]; !ok {
+				projectIDs = append(projectIDs, allocation.ProjectID)
+			}
+			byProject[allocation.ProjectID] += allocated
+			userWeek.Allocated += allocated
+		}
+	}
+
+	sort.Ints(projectIDs)
+	for _, projectID := range projectIDs {
+		userWeek.Projects = append(userWeek.Projects, domain.ProjectAllocation{ProjectID: projectID, Allocated: byProject[projectID]})
+	}
+
+	switch {
+	case userWeek.Allocated > userWeek.Capacity:
+		userWeek.Status = domain.StatusOver
+	case userWeek.Allocated < userWeek.Capacity:
+		userWeek.Status = domain.StatusUnder
+	default:
+		userWeek.Status = domain.StatusFull
+	}
+	return userWeek
+}
+
+func isHoliday(userID int, day time.Time, holidays []domain.Holiday) bool {
+	for _, holiday := range holidays {
+		if (holiday.UserID == 0 || holiday.UserID == userID) && timesheetDomain.Day(holiday.Date).Equal(day) {
+			return true
+		}
+	}
+	return false
+}
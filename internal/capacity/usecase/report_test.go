@@ -0,0 +1,144 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/capacity/domain"
+	"github.com/captainhbb/tbs-backend/internal/capacity/usecase"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportCapacity(t *testing.T) {
+	t.Parallel()
+
+	// Two weeks starting on Monday March 4th, with Friday the 15th off for
+	// everyone and Tuesday the 5th off for user 3, who works half time.
+	weekOne := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	weekTwo := weekOne.AddDate(0, 0, 7)
+	capacities := []domain.Capacity{
+		{UserID: 2, WeeklyHours: 40 * time.Hour, PartTimePercent: 100},
+		{UserID: 3, WeeklyHours: 40 * time.Hour, PartTimePercent: 50},
+	}
+	holidays := []domain.Holiday{
+		{ID: 1, UserID: 3, Date: weekOne.AddDate(0, 0, 1)},
+		{ID: 2, Date: weekTwo.AddDate(0, 0, 4)},
+	}
+	allocations := []domain.Allocation{
+		{ID: 1, UserID: 2, ProjectID: 1, Percent: 60, From: weekOne, To: weekTwo.AddDate(0, 0, 6)},
+		{ID: 2, UserID: 3, ProjectID: 1, Percent: 100, From: weekOne, To: weekOne.AddDate(0, 0, 4)},
+		{ID: 3, UserID: 4, ProjectID: 2, HoursPerWeek: 40 * time.Hour, From: weekOne, To: weekTwo.AddDate(0, 0, 4)},
+		{ID: 4, UserID: 2, ProjectID: 2, HoursPerWeek: 20 * time.Hour, From: weekTwo, To: weekTwo.AddDate(0, 0, 4)},
+	}
+
+	userTwoWeekTwo := domain.UserWeek{UserID: 2, WeekStart: weekTwo, Capacity: 32 * time.Hour, Allocated: 39 * time.Hour + 12 * time.Minute, Status: domain.StatusOver, Projects: []domain.ProjectAllocation{
+		{ProjectID: 1, Allocated: 19 * time.Hour + 12 * time.Minute},
+		{ProjectID: 2, Allocated: 20 * time.Hour},
+	}}
+	userFourWeekTwo := domain.UserWeek{UserID: 4, WeekStart: weekTwo, Capacity: 32 * time.Hour, Allocated: 40 * time.Hour, Status: domain.StatusOver, Projects: []domain.ProjectAllocation{
+		{ProjectID: 2, Allocated: 40 * time.Hour},
+	}}
+
+	tests := []struct {
+		name string
+		actorRole string
+		hiddenProjectID int
+		hiddenUserID int
+		input usecase.CapacityReportRequest
+		expectedWeeks []domain.UserWeek
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "every week",
+			actorRole: userDomain.RoleManager,
+			input: usecase.CapacityReportRequest{From: weekOne.AddDate(0, 0, 2), To: weekTwo.AddDate(0, 0, 1)},
+			expectedWeeks: []domain.UserWeek{
+				{UserID: 2, WeekStart: weekOne, Capacity: 40 * time.Hour, Allocated: 24 * time.Hour, Status: domain.StatusUnder, Projects: []domain.ProjectAllocation{{ProjectID: 1, Allocated: 24 * time.Hour}}},
+				userTwoWeekTwo,
+				{UserID: 3, WeekStart: weekOne, Capacity: 16 * time.Hour, Allocated: 16 * time.Hour, Status: domain.StatusFull, Projects: []domain.ProjectAllocation{{ProjectID: 1, Allocated: 16 * time.Hour}}},
+				{UserID: 3, WeekStart: weekTwo, Capacity: 16 * time.Hour, Status: domain.StatusUnder},
+				{UserID: 4, WeekStart: weekOne, Capacity: 40 * time.Hour, Allocated: 40 * time.Hour, Status: domain.StatusFull, Projects: []domain.ProjectAllocation{{ProjectID: 2, Allocated: 40 * time.Hour}}},
+				userFourWeekTwo,
+			},
+		},
+		{
+			name: "over-allocated weeks",
+			actorRole: userDomain.RoleAdmin,
+			input: usecase.CapacityReportRequest{From: weekOne, To: weekTwo, Status: domain.StatusOver},
+			expectedWeeks: []domain.UserWeek{userTwoWeekTwo, userFourWeekTwo},
+		},
+		{
+			name: "projects and users the manager cannot see",
+			actorRole: userDomain.RoleManager,
+			hiddenProjectID: 2,
+			hiddenUserID: 3,
+			input: usecase.CapacityReportRequest{From: weekOne, To: weekTwo},
+			expectedWeeks: []domain.UserWeek{
+				{UserID: 2, WeekStart: weekOne, Capacity: 40 * time.Hour, Allocated: 24 * time.Hour, Status: domain.StatusUnder, Projects: []domain.ProjectAllocation{{ProjectID: 1, Allocated: 24 * time.Hour}}},
+				{UserID: 2, WeekStart: weekTwo, Capacity: 32 * time.Hour, Allocated: 39 * time.Hour + 12 * time.Minute, Status: domain.StatusOver, Projects: []domain.ProjectAllocation{
+					{Allocated: 20 * time.Hour},
+					{ProjectID: 1, Allocated: 19 * time.Hour + 12 * time.Minute},
+				}},
+				{UserID: 4, WeekStart: weekOne, Capacity: 40 * time.Hour, Allocated: 40 * time.Hour, Status: domain.StatusFull, Projects: []domain.ProjectAllocation{{Allocated: 40 * time.Hour}}},
+				{UserID: 4, WeekStart: weekTwo, Capacity: 32 * time.Hour, Allocated: 40 * time.Hour, Status: domain.StatusOver, Projects: []domain.ProjectAllocation{{Allocated: 40 * time.Hour}}},
+			},
+		},
+		{
+			name: "not a manager",
+			input: usecase.CapacityReportRequest{From: weekOne, To: weekTwo},
+			expectError: true,
+			expectedError: usecase.ErrReportForbidden,
+		},
+		{
+			name: "unknown status",
+			actorRole: userDomain.RoleAdmin,
+			input: usecase.CapacityReportRequest{From: weekOne, To: weekTwo, Status: "busy"},
+			expectError: true,
+			expectedError: usecase.ErrInvalidStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newServiceWithoutAccess(t)
+			m.membershipService.On("Authorize", mock.Anything, mock.Anything, membershipDomain.PermissionView).Return(func(_ context.Context, projectID int, _ string) error {
+				if projectID == tt.hiddenProjectID {
+					return membershipUseCase.ErrForbidden
+				}
+				return nil
+			}).Maybe()
+			m.userService.On("GetUser", mock.Anything, mock.Anything).Return(func(_ context.Context, userID int) (userDomain.User, error) {
+				if userID == tt.hiddenUserID {
+					return userDomain.User{}, userUseCase.ErrUserNotFound
+				}
+				return userDomain.User{ID: userID}, nil
+			}).Maybe()
+			m.userService.On("RequireRole", mock.Anything, userDomain.RoleAdmin, userDomain.RoleManager).Return(func(_ context.Context, roles ...string) error {
+				if !slices.Contains(roles, tt.actorRole) {
+					return userUseCase.ErrForbidden
+				}
+				return nil
+			}).Maybe()
+			m.repo.On("ListAllocations", mock.Anything, domain.AllocationFilter{From: weekOne, To: weekTwo.AddDate(0, 0, 6)}).Return(allocations, nil).Maybe()
+			m.repo.On("ListHolidays", mock.Anything, weekOne, weekTwo.AddDate(0, 0, 6)).Return(holidays, nil).Maybe()
+			m.repo.On("ListCapacities", mock.Anything).Return(capacities, nil).Maybe()
+
+			report, err := service.ReportCapacity(actor.WithID(context.Background(), 1), tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedWeeks, report.Weeks)
+			}
+		})
+	}
+}
@@ -0,0 +1,352 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/capacity/domain"
+	"github.com/captainhbb/tbs-backend/internal/capacity/ports"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	timesheetDomain "github.com/captainhbb/tbs-backend/internal/timesheet/domain"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+)
+
+const week = 7 * 24 * time.Hour
+
+//go:generate mockery --dir . --name CapacityService --structname MockCapacityService --filename mock_capacity_service.go --output ./mock --outpkg mock
+type CapacityService interface {
+	// SetCapacity, AddHoliday and DeleteHoliday are restricted to global
+	// admins.
+	SetCapacity(ctx context.Context, capacity SetCapacityRequest) (domain.Capacity, error)
+	// GetCapacity falls back to the default full-time capacity for users who
+	// have none set. Users see their own capacity, global admins and managers
+	// everyone's.
+	GetCapacity(ctx context.Context, userID int) (domain.Capacity, error)
+	AddHoliday(ctx context.Context, holiday AddHolidayRequest) (domain.Holiday, error)
+	DeleteHoliday(ctx context.Context, id int) error
+	// ListHolidays shows signed-in users the holidays of everyone and their
+	// own, and global admins and managers every user's.
+	ListHolidays(ctx context.Context, from time.Time, to time.Time) ([]domain.Holiday, error)
+	CreateAllocation(ctx context.Context, allocation CreateAllocationRequest) (domain.Allocation, error)
+	UpdateAllocation(ctx context.Context, allocation UpdateAllocationRequest) (domain.Allocation, error)
+	DeleteAllocation(ctx context.Context, id int) error
+	ListAllocations(ctx context.Context, projectID int) ([]domain.Allocation, error)
+	// ReportCapacity sets every user with a capacity or an allocation against
+	// all their allocations, week by week. It is restricted to global admins
+	// and managers; the projects they cannot view count towards each user's
+	// load but are shown without their IDs.
+	ReportCapacity(ctx context.Context, report CapacityReportRequest) (domain.CapacityReport, error)
+}
+
+type capacityService struct {
+	repo ports.Repository
+	projectRepo projectPorts.Repository
+	userService userUseCase.UserService
+	membershipService membershipUseCase.MembershipService
+}
+
+func New(repo ports.Repository, projectRepo projectPorts.Repository, userService userUseCase.UserService, membershipService membershipUseCase.MembershipService) CapacityService {
+	return &capacityService{
+		repo: repo,
+		projectRepo: projectRepo,
+		userService: userService,
+		membershipService: membershipService,
+	}
+}
+
+func(s *capacityService) SetCapacity(ctx context.Context, setCapacityRequest SetCapacityRequest) (domain.Capacity, error) {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return domain.Capacity{}, ErrForbidden
+	default:
+		return domain.Capacity{}, err
+	}
+
+	capacity := domain.Capacity{
+		UserID: setCapacityRequest.UserID,
+		WeeklyHours: setCapacityRequest.WeeklyHours,
+		PartTimePercent: setCapacityRequest.PartTimePercent,
+	}
+	if capacity.PartTimePercent == 0 {
+		capacity.PartTimePercent = 100
+	}
+	if capacity.WeeklyHours <= 0 || capacity.WeeklyHours > week || capacity.PartTimePercent < 0 || capacity.PartTimePercent > 100 {
+		return domain.Capacity{}, ErrInvalidCapacity
+	}
+
+	_, err = s.getUser(ctx, capacity.UserID)
+	if err != nil {
+		return domain.Capacity{}, err
+	}
+	return s.repo.SaveCapacity(ctx, capacity)
+}
+
+func(s *capacityService) GetCapacity(ctx context.Context, userID int) (domain.Capacity, error) {
+	actorID, err := s.requireActor(ctx)
+	if err != nil {
+		return domain.Capacity{}, err
+	}
+	if actorID != userID {
+		err = s.userService.RequireRole(ctx, userDomain.RoleAdmin, userDomain.RoleManager)
+		switch err {
+		case nil:
+		case userUseCase.ErrForbidden:
+			return domain.Capacity{}, ErrCapacityForbidden
+		default:
+			return domain.Capacity{}, err
+		}
+	}
+	_, err = s.getUser(ctx, userID)
+	if err != nil {
+		return domain.Capacity{}, err
+	}
+
+	capacity, err := s.repo.GetCapacity(ctx, userID)
+	switch err {
+	case ports.ErrCapacityNotFound:
+		return domain.DefaultCapacity(userID), nil
+	}
+	return capacity, err
+}
+
+func(s *capacityService) AddHoliday(ctx context.Context, addHolidayRequest AddHolidayRequest) (domain.Holiday, error) {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return domain.Holiday{}, ErrForbidden
+	default:
+		return domain.Holiday{}, err
+	}
+
+	if addHolidayRequest.UserID != 0 {
+		_, err = s.getUser(ctx, addHolidayRequest.UserID)
+		if err != nil {
+			return domain.Holiday{}, err
+		}
+	}
+	return s.repo.CreateHoliday(ctx, domain.Holiday{
+		UserID: addHolidayRequest.UserID,
+		Date: timesheetDomain.Day(addHolidayRequest.Date),
+		Name: addHolidayRequest.Name,
+	})
+}
+
+func(s *capacityService) DeleteHoliday(ctx context.Context, id int) error {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return ErrForbidden
+	default:
+		return err
+	}
+
+	err = s.repo.DeleteHoliday(ctx, id)
+	switch err {
+	case ports.ErrHolidayNotFound:
+		return ErrHolidayNotFound
+	}
+	return err
+}
+
+func(s *capacityService) ListHolidays(ctx context.Context, from time.Time, to time.Time) ([]domain.Holiday, error) {
+	if to.Before(from) {
+		return nil, ErrInvalidDateRange
+	}
+	actorID, err := s.requireActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	holidays, err := s.repo.ListHolidays(ctx, timesheetDomain.Day(from), timesheetDomain.Day(to))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.userService.RequireRole(ctx, userDomain.RoleAdmin, userDomain.RoleManager)
+	switch err {
+	case nil:
+		return holidays, nil
+	case userUseCase.ErrForbidden:
+	default:
+		return nil, err
+	}
+	ownHolidays := make([]domain.Holiday, 0, len(holidays))
+	for _, holiday := range holidays {
+		if holiday.UserID == 0 || holiday.UserID == actorID {
+			ownHolidays = append(ownHolidays, holiday)
+		}
+	}
+	return ownHolidays, nil
+}
+
+func(s *capacityService) CreateAllocation(ctx context.Context, createAllocationRequest CreateAllocationRequest) (domain.Allocation, error) {
+	err := s.membershipService.Authorize(ctx, createAllocationRequest.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Allocation{}, err
+	}
+
+	user, err := s.getUser(ctx, createAllocationRequest.UserID)
+	if err != nil {
+		return domain.Allocation{}, err
+	}
+	if !user.Active {
+		return domain.Allocation{}, ErrUserInactive
+	}
+
+	allocation := domain.Allocation{
+		UserID: createAllocationRequest.UserID,
+		ProjectID: createAllocationRequest.ProjectID,
+		Percent: createAllocationRequest.Percent,
+		HoursPerWeek: createAllocationRequest.HoursPerWeek,
+		From: createAllocationRequest.From,
+		To: createAllocationRequest.To,
+	}
+	allocation, err = s.validate(ctx, allocation)
+	if err != nil {
+		return domain.Allocation{}, err
+	}
+	return s.repo.CreateAllocation(ctx, allocation)
+}
+
+func(s *capacityService) UpdateAllocation(ctx context.Context, updateAllocationRequest UpdateAllocationRequest) (domain.Allocation, error) {
+	allocation, err := s.getAllocation(ctx, updateAllocationRequest.ID)
+	if err != nil {
+		return domain.Allocation{}, err
+	}
+	err = s.membershipService.Authorize(ctx, allocation.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Allocation{}, err
+	}
+
+	allocation.Percent = updateAllocationRequest.Percent
+	allocation.HoursPerWeek = updateAllocationRequest.HoursPerWeek
+	allocation.From = updateAllocationRequest.From
+	allocation.To = updateAllocationRequest.To
+	allocation, err = s.validate(ctx, allocation)
+	if err != nil {
+		return domain.Allocation{}, err
+	}
+
+	updatedAllocation, err := s.repo.UpdateAllocation(ctx, allocation)
+	switch err {
+	case ports.ErrAllocationNotFound:
+		return domain.Allocation{}, ErrAllocationNotFound
+	}
+	return updatedAllocation, err
+}
+
+func(s *capacityService) DeleteAllocation(ctx context.Context, id int) error {
+	allocation, err := s.getAllocation(ctx, id)
+	if err != nil {
+		return err
+	}
+	err = s.membershipService.Authorize(ctx, allocation.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteAllocation(ctx, id)
+	switch err {
+	case ports.ErrAllocationNotFound:
+		return ErrAllocationNotFound
+	}
+	return err
+}
+
+func(s *capacityService) ListAllocations(ctx context.Context, projectID int) ([]domain.Allocation, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListAllocations(ctx, domain.AllocationFilter{ProjectID: projectID})
+}
+
+// validate checks the allocation's share and defaults its dates to the
+// project's, which it must stay within.
+func(s *capacityService) validate(ctx context.Context, allocation domain.Allocation) (domain.Allocation, error) {
+	byPercent := allocation.Percent != 0
+	byHours := allocation.HoursPerWeek != 0
+	if byPercent == byHours || allocation.Percent < 0 || allocation.Percent > 100 || allocation.HoursPerWeek < 0 || allocation.HoursPerWeek > week {
+		return domain.Allocation{}, ErrInvalidAllocation
+	}
+
+	project, err := s.getProject(ctx, allocation.ProjectID)
+	if err != nil {
+		return domain.Allocation{}, err
+	}
+	if allocation.From.IsZero() {
+		allocation.From = project.StartDate
+	}
+	if allocation.To.IsZero() {
+		allocation.To = project.EndDate
+	}
+	if allocation.From.IsZero() || allocation.To.IsZero() {
+		return domain.Allocation{}, ErrInvalidDateRange
+	}
+	allocation.From = timesheetDomain.Day(allocation.From)
+	allocation.To = timesheetDomain.Day(allocation.To)
+	if allocation.To.Before(allocation.From) {
+		return domain.Allocation{}, ErrInvalidDateRange
+	}
+
+	if !project.StartDate.IsZero() && allocation.From.Before(timesheetDomain.Day(project.StartDate)) {
+		return domain.Allocation{}, ErrOutsideProject
+	}
+	if !project.EndDate.IsZero() && allocation.To.After(timesheetDomain.Day(project.EndDate)) {
+		return domain.Allocation{}, ErrOutsideProject
+	}
+	return allocation, nil
+}
+
+// requireActor returns the acting user, who must be one the tenant knows, or
+// zero for the system.
+func(s *capacityService) requireActor(ctx context.Context) (int, error) {
+	if actor.IsSystem(ctx) {
+		return 0, nil
+	}
+	actorID, ok := actor.IDFromContext(ctx)
+	if !ok {
+		return 0, ErrActorRequired
+	}
+	_, err := s.getUser(ctx, actorID)
+	switch err {
+	case ErrUserNotFound:
+		return 0, ErrActorRequired
+	}
+	return actorID, err
+}
+
+func(s *capacityService) getUser(ctx context.Context, userID int) (userDomain.User, error) {
+	user, err := s.userService.GetUser(ctx, userID)
+	switch err {
+	case userUseCase.ErrUserNotFound:
+		return userDomain.User{}, ErrUserNotFound
+	}
+	return user, err
+}
+
+func(s *capacityService) getProject(ctx context.Context, projectID int) (projectDomain.Project, error) {
+	project, err := s.projectRepo.GetProject(ctx, projectID)
+	switch err {
+	case projectPorts.ErrProjectNotFound:
+		return projectDomain.Project{}, ErrProjectNotFound
+	}
+	return project, err
+}
+
+func(s *capacityService) getAllocation(ctx context.Context, id int) (domain.Allocation, error) {
+	allocation, err := s.repo.GetAllocation(ctx, id)
+	switch err {
+	case ports.ErrAllocationNotFound:
+		return domain.Allocation{}, ErrAllocationNotFound
+	}
+	return allocation, err
+}
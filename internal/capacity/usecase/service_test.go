@@ -0,0 +1,284 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/capacity/domain"
+	"github.com/captainhbb/tbs-backend/internal/capacity/ports"
+	portsMock "github.com/captainhbb/tbs-backend/internal/capacity/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/capacity/usecase"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var march = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+type mocks struct {
+	repo *portsMock.MockRepository
+	projectRepo *projectPortsMock.MockRepository
+	userService *userUseCaseMock.MockUserService
+	membershipService *membershipUseCaseMock.MockMembershipService
+}
+
+// newService returns a service whose actor may view and edit every project.
+func newService(t *testing.T) (usecase.CapacityService, mocks) {
+	service, m := newServiceWithoutAccess(t)
+	m.membershipService.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return service, m
+}
+
+func newServiceWithoutAccess(t *testing.T) (usecase.CapacityService, mocks) {
+	m := mocks{
+		repo: portsMock.NewMockRepository(t),
+		projectRepo: projectPortsMock.NewMockRepository(t),
+		userService: userUseCaseMock.NewMockUserService(t),
+		membershipService: membershipUseCaseMock.NewMockMembershipService(t),
+	}
+
+	service := usecase.New(m.repo, m.projectRepo, m.userService, m.membershipService)
+	return service, m
+}
+
+func TestSetCapacity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		actorRole string
+		input usecase.SetCapacityRequest
+		expectedCapacity domain.Capacity
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "part time",
+			actorRole: userDomain.RoleAdmin,
+			input: usecase.SetCapacityRequest{UserID: 2, WeeklyHours: 40 * time.Hour, PartTimePercent: 60},
+			expectedCapacity: domain.Capacity{UserID: 2, WeeklyHours: 40 * time.Hour, PartTimePercent: 60},
+		},
+		{
+			name: "full time by default",
+			actorRole: userDomain.RoleAdmin,
+			input: usecase.SetCapacityRequest{UserID: 2, WeeklyHours: 35 * time.Hour},
+			expectedCapacity: domain.Capacity{UserID: 2, WeeklyHours: 35 * time.Hour, PartTimePercent: 100},
+		},
+		{
+			name: "more than a week",
+			actorRole: userDomain.RoleAdmin,
+			input: usecase.SetCapacityRequest{UserID: 2, WeeklyHours: 169 * time.Hour},
+			expectError: true,
+			expectedError: usecase.ErrInvalidCapacity,
+		},
+		{
+			name: "not an admin",
+			actorRole: userDomain.RoleManager,
+			input: usecase.SetCapacityRequest{UserID: 2, WeeklyHours: 40 * time.Hour},
+			expectError: true,
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "unknown user",
+			actorRole: userDomain.RoleAdmin,
+			input: usecase.SetCapacityRequest{UserID: 9, WeeklyHours: 40 * time.Hour},
+			expectError: true,
+			expectedError: usecase.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newService(t)
+			m.userService.On("RequireRole", mock.Anything, userDomain.RoleAdmin).Return(func(_ context.Context, roles ...string) error {
+				if !slices.Contains(roles, tt.actorRole) {
+					return userUseCase.ErrForbidden
+				}
+				return nil
+			})
+			m.userService.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, Active: true}, nil).Maybe()
+			m.userService.On("GetUser", mock.Anything, 9).Return(userDomain.User{}, userUseCase.ErrUserNotFound).Maybe()
+			m.repo.On("SaveCapacity", mock.Anything, mock.Anything).Return(func(_ context.Context, capacity domain.Capacity) (domain.Capacity, error) {
+				return capacity, nil
+			}).Maybe()
+
+			capacity, err := service.SetCapacity(actor.WithID(context.Background(), 1), tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedCapacity, capacity)
+			}
+		})
+	}
+}
+
+func TestGetCapacityDefaultsToFullTime(t *testing.T) {
+	t.Parallel()
+
+	service, m := newService(t)
+	m.userService.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2}, nil)
+	m.repo.On("GetCapacity", mock.Anything, 2).Return(domain.Capacity{}, ports.ErrCapacityNotFound)
+
+	capacity, err := service.GetCapacity(actor.WithID(context.Background(), 2), 2)
+	require.NoError(t, err)
+	require.Equal(t, domain.DefaultCapacity(2), capacity)
+	require.Equal(t, 8 * time.Hour, capacity.Daily())
+}
+
+func TestGetCapacityChecksAccess(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		ctx context.Context
+		actorRole string
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "manager",
+			ctx: actor.WithID(context.Background(), 1),
+			actorRole: userDomain.RoleManager,
+		},
+		{
+			name: "another user",
+			ctx: actor.WithID(context.Background(), 1),
+			expectError: true,
+			expectedError: usecase.ErrCapacityForbidden,
+		},
+		{
+			name: "user of another tenant",
+			ctx: actor.WithID(context.Background(), 9),
+			expectError: true,
+			expectedError: usecase.ErrActorRequired,
+		},
+		{
+			name: "no actor",
+			ctx: context.Background(),
+			expectError: true,
+			expectedError: usecase.ErrActorRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newService(t)
+			m.userService.On("GetUser", mock.Anything, 1).Return(userDomain.User{ID: 1, Role: tt.actorRole}, nil).Maybe()
+			m.userService.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2}, nil).Maybe()
+			m.userService.On("GetUser", mock.Anything, 9).Return(userDomain.User{}, userUseCase.ErrUserNotFound).Maybe()
+			m.userService.On("RequireRole", mock.Anything, userDomain.RoleAdmin, userDomain.RoleManager).Return(func(_ context.Context, roles ...string) error {
+				if !slices.Contains(roles, tt.actorRole) {
+					return userUseCase.ErrForbidden
+				}
+				return nil
+			}).Maybe()
+			m.repo.On("GetCapacity", mock.Anything, 2).Return(domain.Capacity{UserID: 2, WeeklyHours: 20 * time.Hour, PartTimePercent: 100}, nil).Maybe()
+
+			capacity, err := service.GetCapacity(tt.ctx, 2)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 20 * time.Hour, capacity.WeeklyHours)
+			}
+		})
+	}
+}
+
+func TestListHolidaysShowsUsersTheirOwn(t *testing.T) {
+	t.Parallel()
+
+	service, m := newService(t)
+	holidays := []domain.Holiday{
+		{ID: 1, Date: march, Name: "Founders' day"},
+		{ID: 2, UserID: 2, Date: march.AddDate(0, 0, 1)},
+		{ID: 3, UserID: 3, Date: march.AddDate(0, 0, 2)},
+	}
+	m.userService.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2}, nil)
+	m.userService.On("RequireRole", mock.Anything, userDomain.RoleAdmin, userDomain.RoleManager).Return(userUseCase.ErrForbidden)
+	m.repo.On("ListHolidays", mock.Anything, march, march.AddDate(0, 0, 6)).Return(holidays, nil)
+
+	ownHolidays, err := service.ListHolidays(actor.WithID(context.Background(), 2), march, march.AddDate(0, 0, 6))
+	require.NoError(t, err)
+	require.Equal(t, holidays[:2], ownHolidays)
+
+	_, err = service.ListHolidays(context.Background(), march, march.AddDate(0, 0, 6))
+	require.ErrorIs(t, err, usecase.ErrActorRequired)
+}
+
+func TestCreateAllocation(t *testing.T) {
+	t.Parallel()
+
+	project := projectDomain.Project{ID: 1, StartDate: march, EndDate: march.AddDate(0, 3, 0)}
+
+	tests := []struct {
+		name string
+		input usecase.CreateAllocationRequest
+		user userDomain.User
+		expectedAllocation domain.Allocation
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "percent for the whole project",
+			input: usecase.CreateAllocationRequest{ProjectID: 1, UserID: 2, Percent: 50},
+			user: userDomain.User{ID: 2, Active: true},
+			expectedAllocation: domain.Allocation{UserID: 2, ProjectID: 1, Percent: 50, From: project.StartDate, To: project.EndDate},
+		},
+		{
+			name: "hours for a month",
+			input: usecase.CreateAllocationRequest{ProjectID: 1, UserID: 2, HoursPerWeek: 16 * time.Hour, From: march.AddDate(0, 1, 0), To: march.AddDate(0, 2, -1)},
+			user: userDomain.User{ID: 2, Active: true},
+			expectedAllocation: domain.Allocation{UserID: 2, ProjectID: 1, HoursPerWeek: 16 * time.Hour, From: march.AddDate(0, 1, 0), To: march.AddDate(0, 2, -1)},
+		},
+		{
+			name: "percent and hours",
+			input: usecase.CreateAllocationRequest{ProjectID: 1, UserID: 2, Percent: 50, HoursPerWeek: 16 * time.Hour},
+			user: userDomain.User{ID: 2, Active: true},
+			expectError: true,
+			expectedError: usecase.ErrInvalidAllocation,
+		},
+		{
+			name: "beyond the project end",
+			input: usecase.CreateAllocationRequest{ProjectID: 1, UserID: 2, Percent: 50, To: march.AddDate(0, 4, 0)},
+			user: userDomain.User{ID: 2, Active: true},
+			expectError: true,
+			expectedError: usecase.ErrOutsideProject,
+		},
+		{
+			name: "inactive user",
+			input: usecase.CreateAllocationRequest{ProjectID: 1, UserID: 2, Percent: 50},
+			user: userDomain.User{ID: 2},
+			expectError: true,
+			expectedError: usecase.ErrUserInactive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newService(t)
+			m.userService.On("GetUser", mock.Anything, 2).Return(tt.user, nil)
+			m.projectRepo.On("GetProject", mock.Anything, 1).Return(project, nil).Maybe()
+			m.repo.On("CreateAllocation", mock.Anything, mock.Anything).Return(func(_ context.Context, allocation domain.Allocation) (domain.Allocation, error) {
+				return allocation, nil
+			}).Maybe()
+
+			allocation, err := service.CreateAllocation(context.Background(), tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedAllocation, allocation)
+			}
+		})
+	}
+}
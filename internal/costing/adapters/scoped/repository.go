@@ -0,0 +1,92 @@
+// Package scoped confines a rate repository to the tenant carried in the
+// request context. Rates of other tenants are reported as not found. Platform
+// calls see every tenant, and requests carrying neither see no rates at all.
+package scoped
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/costing/domain"
+	"github.com/captainhbb/tbs-backend/internal/costing/ports"
+	projectScoped "github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+)
+
+type repository struct {
+	next			ports.Repository
+	projectRepo		projectPorts.Repository
+}
+
+// New wraps next so that every query is filtered by the tenant in ctx and new
+// rates are set within it, which fails with tenant.ErrTenantRequired when ctx
+// carries none. Rates limited to a project must name one of the tenant's
+// projects, which projectRepo looks up.
+func New(next ports.Repository, projectRepo projectPorts.Repository) ports.Repository {
+	return &repository{next: next, projectRepo: projectRepo}
+}
+
+func(r *repository) CreateRate(ctx context.Context, rate domain.Rate) (domain.Rate, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return domain.Rate{}, err
+	}
+	if rate.ProjectID != 0 {
+		err = projectScoped.CheckProject(ctx, r.projectRepo, rate.ProjectID)
+		if err != nil {
+			return domain.Rate{}, err
+		}
+	}
+	rate.TenantID = tenantID
+	return r.next.CreateRate(ctx, rate)
+}
+
+func(r *repository) GetRate(ctx context.Context, id int) (domain.Rate, error) {
+	rate, err := r.next.GetRate(ctx, id)
+	if err != nil {
+		return domain.Rate{}, err
+	}
+	if !tenant.Visible(ctx, rate.TenantID) {
+		return domain.Rate{}, ports.ErrRateNotFound
+	}
+	return rate, nil
+}
+
+// UpdateRate keeps the rate in the tenant it belongs to.
+func(r *repository) UpdateRate(ctx context.Context, rate domain.Rate) (domain.Rate, error) {
+	existingRate, err := r.GetRate(ctx, rate.ID)
+	if err != nil {
+		return domain.Rate{}, err
+	}
+	if rate.ProjectID != 0 {
+		err = projectScoped.CheckProject(ctx, r.projectRepo, rate.ProjectID)
+		if err != nil {
+			return domain.Rate{}, err
+		}
+	}
+	rate.TenantID = existingRate.TenantID
+	return r.next.UpdateRate(ctx, rate)
+}
+
+func(r *repository) DeleteRate(ctx context.Context, id int) error {
+	_, err := r.GetRate(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeleteRate(ctx, id)
+}
+
+func(r *repository) ListRates(ctx context.Context, filter domain.RateFilter) ([]domain.Rate, error) {
+	rates, err := r.next.ListRates(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]domain.Rate, 0, len(rates))
+	for _, rate := range rates {
+		if tenant.Visible(ctx, rate.TenantID) {
+			visible = append(visible, rate)
+		}
+	}
+	return visible, nil
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+const (
+	PeriodWeek					= "week"
+	PeriodMonth					= "month"
+)
+
+var periods = map[string]bool{
+	PeriodWeek: true,
+	PeriodMonth: true,
+}
+
+// LaborCost prices the approved time logged on a project between From and To.
+// Cost applies the cost rate to all time and Billable applies the billing rate
+// to billable time only. Amounts are in the currency of the project's proposed
+// budget.
+type LaborCost struct {
+	ProjectID			int
+	From				time.Time
+	To					time.Time
+	Period				string
+	Duration			time.Duration
+	BillableDuration	time.Duration
+	Cost				money.Money
+	Billable			money.Money
+	ByUser				[]UserLaborCost
+	ByPeriod			[]PeriodLaborCost
+	// Unrated lists the IDs of approved entries no rate applied to. They count
+	// towards Duration but not towards any amount. UnratedBillable holds the
+	// billable ones among them.
+	Unrated				[]int
+	UnratedBillable		[]int
+}
+
+// ActualCost is what a project has cost before To: its expenses, by category,
+// and the cost of its approved time. Amounts are in the currency of the
+// project's proposed budget, expenses converted at the rate of the day they
+// were incurred.
+type ActualCost struct {
+	ProjectID			int
+	To					time.Time
+	Expenses			map[string]money.Money
+	Labor				money.Money
+	Total				money.Money
+}
+
+type UserLaborCost struct {
+	UserID				int
+	Duration			time.Duration
+	BillableDuration	time.Duration
+	Cost				money.Money
+	Billable			money.Money
+}
+
+// PeriodLaborCost is the share of a LaborCost for the week or month starting
+// on Start.
+type PeriodLaborCost struct {
+	Start				time.Time
+	Duration			time.Duration
+	BillableDuration	time.Duration
+	Cost				money.Money
+	Billable			money.Money
+}
+
+func IsValidPeriod(period string) bool {
+	return periods[period]
+}
+
+// PeriodStart returns the first day of the week (Monday) or month containing
+// day.
+func PeriodStart(period string, day time.Time) time.Time {
+	year, month, date := day.Date()
+	if period == PeriodMonth {
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	}
+	start := time.Date(year, month, date, 0, 0, 0, 0, time.UTC)
+	return start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+// Rate is the hourly cost and billing rate of either a user or a project role
+// over an effective-date range. Exactly one of UserID and Role is set.
+// ProjectID limits the rate to one project and is zero for rates that apply to
+// every project.
+type Rate struct {
+	ID 					int
+	// TenantID is the organization the rate is set within.
+	TenantID			int
+	UserID				int
+	Role				string
+	ProjectID			int
+	CostRate			money.Money
+	BillingRate			money.Money
+	// EffectiveFrom is inclusive and EffectiveTo is exclusive. A zero
+	// EffectiveTo leaves the rate open-ended.
+	EffectiveFrom		time.Time
+	EffectiveTo			time.Time
+}
+
+// Covers reports whether the rate is effective on day.
+func (r Rate) Covers(day time.Time) bool {
+	if day.Before(r.EffectiveFrom) {
+		return false
+	}
+	return r.EffectiveTo.IsZero() || day.Before(r.EffectiveTo)
+}
+
+// Overlaps reports whether other applies to the same user or role on the same
+// project as r for at least one day.
+func (r Rate) Overlaps(other Rate) bool {
+	if r.UserID != other.UserID || r.Role != other.Role || r.ProjectID != other.ProjectID {
+		return false
+	}
+	if !r.EffectiveTo.IsZero() && !other.EffectiveFrom.Before(r.EffectiveTo) {
+		return false
+	}
+	if !other.EffectiveTo.IsZero() && !r.EffectiveFrom.Before(other.EffectiveTo) {
+		return false
+	}
+	return true
+}
+
+// precedence ranks how specific a rate is: user rates win over role rates and,
+// within each, project rates win over rates that apply everywhere.
+func (r Rate) precedence() int {
+	rank := 0
+	if r.UserID != 0 {
+		rank += 2
+	}
+	if r.ProjectID != 0 {
+		rank++
+	}
+	return rank
+}
+
+// RateFilter narrows a rate query. Zero-valued fields do not filter.
+type RateFilter struct {
+	UserID				int
+	Role				string
+	ProjectID			int
+}
+
+// Matches reports whether rate satisfies every non-zero field of the filter.
+func (f RateFilter) Matches(rate Rate) bool {
+	if f.UserID != 0 && f.UserID != rate.UserID {
+		return false
+	}
+	if f.Role != "" && f.Role != rate.Role {
+		return false
+	}
+	if f.ProjectID != 0 && f.ProjectID != rate.ProjectID {
+		return false
+	}
+	return true
+}
+
+// Resolve picks the rate that prices time userID logged on projectID on day,
+// where role is the user's role on the project and may be empty. The most
+// specific effective rate wins; ok is false when none applies.
+func Resolve(rates []Rate, projectID int, userID int, role string, day time.Time) (rate Rate, ok bool) {
+	for _, candidate := range rates {
+		if candidate.UserID != 0 && candidate.UserID != userID {
+			continue
+		}
+		if candidate.UserID == 0 && (role == "" || candidate.Role != role) {
+			continue
+		}
+		if candidate.ProjectID != 0 && candidate.ProjectID != projectID {
+			continue
+		}
+		if !candidate.Covers(day) {
+			continue
+		}
+		if !ok || candidate.precedence() > rate.precedence() {
+			rate, ok = candidate, true
+		}
+	}
+	return rate, ok
+}
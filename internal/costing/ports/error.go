@@ -0,0 +1,7 @@
+package ports
+
+import "errors"
+
+var (
+	ErrRateNotFound				= errors.New("rate not found")
+)
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/costing/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	CreateRate(ctx context.Context, rate domain.Rate) (domain.Rate, error)
+	GetRate(ctx context.Context, id int) (domain.Rate, error)
+	UpdateRate(ctx context.Context, rate domain.Rate) (domain.Rate, error)
+	DeleteRate(ctx context.Context, id int) error
+	// ListRates returns the rates matching filter ordered by EffectiveFrom.
+	ListRates(ctx context.Context, filter domain.RateFilter) ([]domain.Rate, error)
+}
@@ -0,0 +1,282 @@
+package usecase
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/costing/domain"
+	exchangeUseCase "github.com/captainhbb/tbs-backend/internal/exchange/usecase"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	timesheetDomain "github.com/captainhbb/tbs-backend/internal/timesheet/domain"
+	timesheetPorts "github.com/captainhbb/tbs-backend/internal/timesheet/ports"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+// tally accumulates the time and amounts of one slice of a LaborCost.
+type tally struct {
+	duration time.Duration
+	billableDuration time.Duration
+	cost money.Money
+	billable money.Money
+}
+
+func(t *tally) add(entry timesheetDomain.TimeEntry, cost money.Money, billable money.Money) error {
+	var err error
+	t.duration += entry.Duration
+	if entry.Billable {
+		t.billableDuration += entry.Duration
+	}
+	t.cost, err = t.cost.Add(cost)
+	if err != nil {
+		return err
+	}
+	t.billable, err = t.billable.Add(billable)
+	return err
+}
+
+type week struct {
+	userID int
+	start time.Time
+}
+
+func(s *costingService) ProjectLaborCost(ctx context.Context, laborCostRequest LaborCostRequest) (domain.LaborCost, error) {
+	period := laborCostRequest.Period
+	if period == "" {
+		period = domain.PeriodMonth
+	}
+	if !domain.IsValidPeriod(period) {
+		return domain.LaborCost{}, ErrInvalidPeriod
+	}
+
+	err := s.membershipService.Authorize(ctx, laborCostRequest.ProjectID, membershipDomain.PermissionView)
+	if err != nil {
+		return domain.LaborCost{}, err
+	}
+	project, err := s.getProject(ctx, laborCostRequest.ProjectID)
+	if err != nil {
+		return domain.LaborCost{}, err
+	}
+	currency := project.ProposedBudget.Currency()
+	zero, err := money.Zero(currency)
+	if err != nil {
+		return domain.LaborCost{}, err
+	}
+
+	rates, err := s.repo.ListRates(ctx, domain.RateFilter{})
+	if err != nil {
+		return domain.LaborCost{}, err
+	}
+	roles, err := s.projectRoles(ctx, project.ID, project.OwnerID)
+	if err != nil {
+		return domain.LaborCost{}, err
+	}
+	entries, err := s.timesheetRepo.ListEntries(ctx, timesheetDomain.EntryFilter{
+		ProjectID: project.ID,
+		From: laborCostRequest.From,
+		To: laborCostRequest.To,
+	})
+	if err != nil {
+		return domain.LaborCost{}, err
+	}
+
+	laborCost := domain.LaborCost{
+		ProjectID: project.ID,
+		From: laborCostRequest.From,
+		To: laborCostRequest.To,
+		Period: period,
+	}
+	total := &tally{cost: zero, billable: zero}
+	byUser := make(map[int]*tally)
+	byPeriod := make(map[time.Time]*tally)
+	approved := make(map[week]bool)
+	for _, entry := range entries {
+		key := week{userID: entry.UserID, start: timesheetDomain.WeekStart(entry.Date)}
+		isApproved, seen := approved[key]
+		if !seen {
+			isApproved, err = s.weekApproved(ctx, entry.ProjectID, key)
+			if err != nil {
+				return domain.LaborCost{}, err
+			}
+			approved[key] = isApproved
+		}
+		if !isApproved {
+			continue
+		}
+
+		cost, billable := zero, zero
+		rate, ok := domain.Resolve(rates, project.ID, entry.UserID, roles[entry.UserID], entry.Date)
+		if ok {
+			cost, billable, err = s.price(ctx, rate, entry, currency)
+			if err != nil {
+				return domain.LaborCost{}, err
+			}
+		} else {
+			laborCost.Unrated = append(laborCost.Unrated, entry.ID)
+			if entry.Billable {
+				laborCost.UnratedBillable = append(laborCost.UnratedBillable, entry.ID)
+			}
+		}
+
+		start := domain.PeriodStart(period, entry.Date)
+		if byUser[entry.UserID] == nil {
+			byUser[entry.UserID] = &tally{cost: zero, billable: zero}
+		}
+		if byPeriod[start] == nil {
+			byPeriod[start] = &tally{cost: zero, billable: zero}
+		}
+		for _, slice := range []*tally{total, byUser[entry.UserID], byPeriod[start]} {
+			err = slice.add(entry, cost, billable)
+			if err != nil {
+				return domain.LaborCost{}, err
+			}
+		}
+	}
+
+	laborCost.Duration = total.duration
+	laborCost.BillableDuration = total.billableDuration
+	laborCost.Cost = total.cost
+	laborCost.Billable = total.billable
+	for userID, slice := range byUser {
+		laborCost.ByUser = append(laborCost.ByUser, domain.UserLaborCost{
+			UserID: userID,
+			Duration: slice.duration,
+			BillableDuration: slice.billableDuration,
+			Cost: slice.cost,
+			Billable: slice.billable,
+		})
+	}
+	sort.Slice(laborCost.ByUser, func(i, j int) bool {
+		return laborCost.ByUser[i].UserID < laborCost.ByUser[j].UserID
+	})
+	for start, slice := range byPeriod {
+		laborCost.ByPeriod = append(laborCost.ByPeriod, domain.PeriodLaborCost{
+			Start: start,
+			Duration: slice.duration,
+			BillableDuration: slice.billableDuration,
+			Cost: slice.cost,
+			Billable: slice.billable,
+		})
+	}
+	sort.Slice(laborCost.ByPeriod, func(i, j int) bool {
+		return laborCost.ByPeriod[i].Start.Before(laborCost.ByPeriod[j].Start)
+	})
+	return laborCost, nil
+}
+
+func(s *costingService) ProjectActualCost(ctx context.Context, actualCostRequest ActualCostRequest) (domain.ActualCost, error) {
+	labor, err := s.ProjectLaborCost(ctx, LaborCostRequest{
+		ProjectID: actualCostRequest.ProjectID,
+		To: actualCostRequest.To,
+	})
+	if err != nil {
+		return domain.ActualCost{}, err
+	}
+	expenses, err := s.expenseRepo.ListExpenses(ctx, actualCostRequest.ProjectID)
+	if err != nil {
+		return domain.ActualCost{}, err
+	}
+
+	actualCost := domain.ActualCost{
+		ProjectID: actualCostRequest.ProjectID,
+		To: actualCostRequest.To,
+		Expenses: make(map[string]money.Money),
+		Labor: labor.Cost,
+		Total: labor.Cost,
+	}
+	currency := labor.Cost.Currency()
+	for _, expense := range expenses {
+		if !actualCostRequest.To.IsZero() && !expense.Date.Before(actualCostRequest.To) {
+			continue
+		}
+
+		amount, err := s.convert(ctx, expense.Amount, currency, expense.Date)
+		if err != nil {
+			return domain.ActualCost{}, err
+		}
+		actualCost.Total, err = actualCost.Total.Add(amount)
+		if err != nil {
+			return domain.ActualCost{}, err
+		}
+		spent, ok := actualCost.Expenses[expense.Category]
+		if ok {
+			amount, err = spent.Add(amount)
+			if err != nil {
+				return domain.ActualCost{}, err
+			}
+		}
+		actualCost.Expenses[expense.Category] = amount
+	}
+	return actualCost, nil
+}
+
+// price applies rate to entry and converts the result into currency at the
+// entry date. Only billable entries have a billable amount.
+func(s *costingService) price(ctx context.Context, rate domain.Rate, entry timesheetDomain.TimeEntry, currency string) (money.Money, money.Money, error) {
+	hours := big.NewRat(int64(entry.Duration), int64(time.Hour))
+
+	cost, err := rate.CostRate.MulRat(hours)
+	if err != nil {
+		return money.Money{}, money.Money{}, err
+	}
+	cost, err = s.convert(ctx, cost, currency, entry.Date)
+	if err != nil {
+		return money.Money{}, money.Money{}, err
+	}
+
+	billable := money.MustNew(0, currency)
+	if entry.Billable {
+		billable, err = rate.BillingRate.MulRat(hours)
+		if err != nil {
+			return money.Money{}, money.Money{}, err
+		}
+		billable, err = s.convert(ctx, billable, currency, entry.Date)
+		if err != nil {
+			return money.Money{}, money.Money{}, err
+		}
+	}
+	return cost, billable, nil
+}
+
+func(s *costingService) convert(ctx context.Context, amount money.Money, currency string, on time.Time) (money.Money, error) {
+	if amount.Currency() == currency {
+		return amount, nil
+	}
+	conversion, err := s.exchangeService.Convert(ctx, exchangeUseCase.ConvertRequest{
+		Amount: amount,
+		Currency: currency,
+		On: on,
+	})
+	if err != nil {
+		return money.Money{}, err
+	}
+	return conversion.Converted, nil
+}
+
+// projectRoles maps the users working on a project to the role their rates
+// are looked up by. The owner has no membership and is rated as a manager.
+func(s *costingService) projectRoles(ctx context.Context, projectID int, ownerID int) (map[int]string, error) {
+	members, err := s.memberRepo.ListMembers(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := map[int]string{ownerID: membershipDomain.RoleManager}
+	for _, member := range members {
+		roles[member.UserID] = member.Role
+	}
+	return roles, nil
+}
+
+func(s *costingService) weekApproved(ctx context.Context, projectID int, key week) (bool, error) {
+	timesheet, err := s.timesheetRepo.GetTimesheet(ctx, key.userID, projectID, key.start)
+	switch err {
+	case nil:
+	case timesheetPorts.ErrTimesheetNotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+	return timesheet.Status == timesheetDomain.StatusApproved, nil
+}
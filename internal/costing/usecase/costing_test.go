@@ -0,0 +1,153 @@
+package usecase_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	budgetDomain "github.com/captainhbb/tbs-backend/internal/budget/domain"
+	"github.com/captainhbb/tbs-backend/internal/costing/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/costing/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/costing/usecase"
+	exchangeDomain "github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	exchangeUseCase "github.com/captainhbb/tbs-backend/internal/exchange/usecase"
+	exchangeUseCaseMock "github.com/captainhbb/tbs-backend/internal/exchange/usecase/mock"
+	expenseDomain "github.com/captainhbb/tbs-backend/internal/expense/domain"
+	expensePortsMock "github.com/captainhbb/tbs-backend/internal/expense/ports/mock"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipPortsMock "github.com/captainhbb/tbs-backend/internal/membership/ports/mock"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	timesheetDomain "github.com/captainhbb/tbs-backend/internal/timesheet/domain"
+	timesheetPorts "github.com/captainhbb/tbs-backend/internal/timesheet/ports"
+	timesheetPortsMock "github.com/captainhbb/tbs-backend/internal/timesheet/ports/mock"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var project = projectDomain.Project{
+	ID: 1,
+	OwnerID: 9,
+	ProposedBudget: money.MustNew(10000000, "USD"),
+}
+
+func TestProjectLaborCost(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	timesheetRepoMock := timesheetPortsMock.NewMockRepository(t)
+	projectRepoMock := projectPortsMock.NewMockRepository(t)
+	memberRepoMock := membershipPortsMock.NewMockRepository(t)
+	exchangeServiceMock := exchangeUseCaseMock.NewMockExchangeService(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	service := usecase.New(repoMock, timesheetRepoMock, expensePortsMock.NewMockRepository(t), projectRepoMock, memberRepoMock, userUseCaseMock.NewMockUserService(t), exchangeServiceMock, membershipServiceMock)
+
+	// Week of Monday 2024-03-04.
+	week := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionView).Return(nil)
+	projectRepoMock.On("GetProject", mock.Anything, 1).Return(project, nil)
+	repoMock.On("ListRates", mock.Anything, domain.RateFilter{}).Return([]domain.Rate{
+		{ID: 1, Role: membershipDomain.RoleContributor, CostRate: money.MustNew(5000, "USD"), BillingRate: money.MustNew(8000, "USD"), EffectiveFrom: march.AddDate(-1, 0, 0)},
+		{ID: 2, UserID: 2, ProjectID: 1, CostRate: money.MustNew(6000, "USD"), BillingRate: money.MustNew(10000, "USD"), EffectiveFrom: march},
+		{ID: 3, UserID: 3, ProjectID: 2, CostRate: money.MustNew(9900, "USD"), BillingRate: money.MustNew(9900, "USD"), EffectiveFrom: march},
+		{ID: 4, Role: membershipDomain.RoleManager, CostRate: money.MustNew(4000, "EUR"), BillingRate: money.MustNew(6000, "EUR"), EffectiveFrom: march},
+	}, nil)
+	memberRepoMock.On("ListMembers", mock.Anything, 1).Return([]membershipDomain.Member{
+		{ProjectID: 1, UserID: 2, Role: membershipDomain.RoleContributor},
+		{ProjectID: 1, UserID: 3, Role: membershipDomain.RoleContributor},
+	}, nil)
+	timesheetRepoMock.On("ListEntries", mock.Anything, timesheetDomain.EntryFilter{ProjectID: 1}).Return([]timesheetDomain.TimeEntry{
+		{ID: 1, UserID: 2, ProjectID: 1, Date: week.AddDate(0, 0, 2), Duration: 2 * time.Hour, Billable: true},
+		{ID: 2, UserID: 3, ProjectID: 1, Date: week.AddDate(0, 0, 2), Duration: 90 * time.Minute},
+		{ID: 3, UserID: 9, ProjectID: 1, Date: week.AddDate(0, 0, 3), Duration: time.Hour, Billable: true},
+		{ID: 4, UserID: 3, ProjectID: 1, Date: week.AddDate(0, 0, 8), Duration: time.Hour},
+		{ID: 5, UserID: 4, ProjectID: 1, Date: april.AddDate(0, 0, 1), Duration: time.Hour},
+	}, nil)
+	for _, userID := range []int{2, 3, 9} {
+		timesheetRepoMock.On("GetTimesheet", mock.Anything, userID, 1, week).Return(timesheetDomain.Timesheet{Status: timesheetDomain.StatusApproved}, nil).Once()
+	}
+	timesheetRepoMock.On("GetTimesheet", mock.Anything, 3, 1, week.AddDate(0, 0, 7)).Return(timesheetDomain.Timesheet{}, timesheetPorts.ErrTimesheetNotFound)
+	timesheetRepoMock.On("GetTimesheet", mock.Anything, 4, 1, april).Return(timesheetDomain.Timesheet{Status: timesheetDomain.StatusApproved}, nil)
+	exchangeServiceMock.On("Convert", mock.Anything, mock.Anything).Return(func(_ context.Context, convert exchangeUseCase.ConvertRequest) (exchangeDomain.Conversion, error) {
+		converted, err := convert.Amount.Mul(5, 4)
+		return exchangeDomain.Conversion{Original: convert.Amount, Converted: money.MustNew(converted.Amount(), "USD"), Rate: big.NewRat(5, 4), RateDate: convert.On}, err
+	})
+
+	laborCost, err := service.ProjectLaborCost(context.Background(), usecase.LaborCostRequest{ProjectID: 1})
+	require.NoError(t, err)
+	require.Equal(t, domain.PeriodMonth, laborCost.Period)
+	require.Equal(t, 5*time.Hour + 30*time.Minute, laborCost.Duration)
+	require.Equal(t, 3 * time.Hour, laborCost.BillableDuration)
+	require.Equal(t, money.MustNew(24500, "USD"), laborCost.Cost)
+	require.Equal(t, money.MustNew(27500, "USD"), laborCost.Billable)
+	require.Equal(t, []int{5}, laborCost.Unrated)
+	require.Empty(t, laborCost.UnratedBillable)
+
+	require.Equal(t, []domain.UserLaborCost{
+		{UserID: 2, Duration: 2 * time.Hour, BillableDuration: 2 * time.Hour, Cost: money.MustNew(12000, "USD"), Billable: money.MustNew(20000, "USD")},
+		{UserID: 3, Duration: 90 * time.Minute, Cost: money.MustNew(7500, "USD"), Billable: money.MustNew(0, "USD")},
+		{UserID: 4, Duration: time.Hour, Cost: money.MustNew(0, "USD"), Billable: money.MustNew(0, "USD")},
+		{UserID: 9, Duration: time.Hour, BillableDuration: time.Hour, Cost: money.MustNew(5000, "USD"), Billable: money.MustNew(7500, "USD")},
+	}, laborCost.ByUser)
+	require.Equal(t, []domain.PeriodLaborCost{
+		{Start: march, Duration: 4*time.Hour + 30*time.Minute, BillableDuration: 3 * time.Hour, Cost: money.MustNew(24500, "USD"), Billable: money.MustNew(27500, "USD")},
+		{Start: april, Duration: time.Hour, Cost: money.MustNew(0, "USD"), Billable: money.MustNew(0, "USD")},
+	}, laborCost.ByPeriod)
+}
+
+func TestProjectLaborCostInvalidPeriod(t *testing.T) {
+	t.Parallel()
+
+	service := usecase.New(portsMock.NewMockRepository(t), timesheetPortsMock.NewMockRepository(t), expensePortsMock.NewMockRepository(t), projectPortsMock.NewMockRepository(t), membershipPortsMock.NewMockRepository(t), userUseCaseMock.NewMockUserService(t), exchangeUseCaseMock.NewMockExchangeService(t), membershipUseCaseMock.NewMockMembershipService(t))
+
+	_, err := service.ProjectLaborCost(context.Background(), usecase.LaborCostRequest{ProjectID: 1, Period: "quarter"})
+	require.ErrorIs(t, err, usecase.ErrInvalidPeriod)
+}
+
+func TestProjectActualCost(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	timesheetRepoMock := timesheetPortsMock.NewMockRepository(t)
+	expenseRepoMock := expensePortsMock.NewMockRepository(t)
+	projectRepoMock := projectPortsMock.NewMockRepository(t)
+	memberRepoMock := membershipPortsMock.NewMockRepository(t)
+	exchangeServiceMock := exchangeUseCaseMock.NewMockExchangeService(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	service := usecase.New(repoMock, timesheetRepoMock, expenseRepoMock, projectRepoMock, memberRepoMock, userUseCaseMock.NewMockUserService(t), exchangeServiceMock, membershipServiceMock)
+
+	week := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := week.AddDate(0, 0, 7)
+
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionView).Return(nil)
+	projectRepoMock.On("GetProject", mock.Anything, 1).Return(project, nil)
+	repoMock.On("ListRates", mock.Anything, domain.RateFilter{}).Return([]domain.Rate{
+		{ID: 1, Role: membershipDomain.RoleContributor, CostRate: money.MustNew(5000, "USD"), BillingRate: money.MustNew(8000, "USD"), EffectiveFrom: march},
+	}, nil)
+	memberRepoMock.On("ListMembers", mock.Anything, 1).Return([]membershipDomain.Member{
+		{ProjectID: 1, UserID: 2, Role: membershipDomain.RoleContributor},
+	}, nil)
+	timesheetRepoMock.On("ListEntries", mock.Anything, timesheetDomain.EntryFilter{ProjectID: 1, To: to}).Return([]timesheetDomain.TimeEntry{
+		{ID: 1, UserID: 2, ProjectID: 1, Date: week.AddDate(0, 0, 2), Duration: 2 * time.Hour},
+	}, nil)
+	timesheetRepoMock.On("GetTimesheet", mock.Anything, 2, 1, week).Return(timesheetDomain.Timesheet{Status: timesheetDomain.StatusApproved}, nil)
+	expenseRepoMock.On("ListExpenses", mock.Anything, 1).Return([]expenseDomain.Expense{
+		{ID: 1, ProjectID: 1, Category: budgetDomain.CategoryTravel, Amount: money.MustNew(15000, "USD"), Date: week},
+		{ID: 2, ProjectID: 1, Category: budgetDomain.CategoryTravel, Amount: money.MustNew(4000, "EUR"), Date: week.AddDate(0, 0, 1)},
+		{ID: 3, ProjectID: 1, Category: budgetDomain.CategoryHardware, Amount: money.MustNew(99999, "USD"), Date: to},
+	}, nil)
+	exchangeServiceMock.On("Convert", mock.Anything, exchangeUseCase.ConvertRequest{Amount: money.MustNew(4000, "EUR"), Currency: "USD", On: week.AddDate(0, 0, 1)}).
+		Return(exchangeDomain.Conversion{Original: money.MustNew(4000, "EUR"), Converted: money.MustNew(5000, "USD"), Rate: big.NewRat(5, 4), RateDate: week.AddDate(0, 0, 1)}, nil)
+
+	actualCost, err := service.ProjectActualCost(context.Background(), usecase.ActualCostRequest{ProjectID: 1, To: to})
+	require.NoError(t, err)
+	require.Equal(t, money.MustNew(10000, "USD"), actualCost.Labor)
+	require.Equal(t, map[string]money.Money{budgetDomain.CategoryTravel: money.MustNew(20000, "USD")}, actualCost.Expenses)
+	require.Equal(t, money.MustNew(30000, "USD"), actualCost.Total)
+}
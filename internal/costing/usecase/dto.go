@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+type CreateRateRequest struct {
+	UserID 					int
+	Role 					string
+	ProjectID 				int
+	CostRate 				money.Money
+	BillingRate 			money.Money
+	EffectiveFrom 			time.Time
+	EffectiveTo 			time.Time
+}
+
+type UpdateRateRequest struct {
+	ID 						int
+	UserID 					int
+	Role 					string
+	ProjectID 				int
+	CostRate 				money.Money
+	BillingRate 			money.Money
+	EffectiveFrom 			time.Time
+	EffectiveTo 			time.Time
+}
+
+// LaborCostRequest prices the approved time logged on ProjectID from From
+// (inclusive) to To (exclusive); zero bounds are open. Period defaults to
+// month.
+type LaborCostRequest struct {
+	ProjectID 				int
+	From 					time.Time
+	To 						time.Time
+	Period 					string
+}
+
+// ActualCostRequest totals what ProjectID has cost before To (exclusive); a
+// zero To is open.
+type ActualCostRequest struct {
+	ProjectID 				int
+	To 						time.Time
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrRateNotFound				= errors.New("rate not found")
+	ErrProjectNotFound			= errors.New("project not found")
+	ErrUserNotFound				= errors.New("user not found")
+	ErrForbidden				= errors.New("only admins can manage rates")
+	ErrInvalidScope				= errors.New("a rate applies to either a user or a project role")
+	ErrInvalidRole				= errors.New("invalid project role")
+	ErrInvalidRate				= errors.New("cost and billing rates must share a currency and not be negative")
+	ErrInvalidEffectiveRange	= errors.New("a rate needs a start date before its end date")
+	ErrOverlappingRate			= errors.New("another rate for the same user or role is effective in this range")
+	ErrInvalidPeriod			= errors.New("period must be week or month")
+)
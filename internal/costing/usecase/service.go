@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/costing/domain"
+	"github.com/captainhbb/tbs-backend/internal/costing/ports"
+	exchangeUseCase "github.com/captainhbb/tbs-backend/internal/exchange/usecase"
+	expensePorts "github.com/captainhbb/tbs-backend/internal/expense/ports"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipPorts "github.com/captainhbb/tbs-backend/internal/membership/ports"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	timesheetPorts "github.com/captainhbb/tbs-backend/internal/timesheet/ports"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+//go:generate mockery --dir . --name CostingService --structname MockCostingService --filename mock_costing_service.go --output ./mock --outpkg mock
+type CostingService interface {
+	// CreateRate, UpdateRate, DeleteRate and ListRates are restricted to global
+	// admins.
+	CreateRate(ctx context.Context, rate CreateRateRequest) (domain.Rate, error)
+	UpdateRate(ctx context.Context, rate UpdateRateRequest) (domain.Rate, error)
+	DeleteRate(ctx context.Context, id int) error
+	ListRates(ctx context.Context, filter domain.RateFilter) ([]domain.Rate, error)
+	// ProjectLaborCost prices the project's time entries whose weekly
+	// timesheets are approved.
+	ProjectLaborCost(ctx context.Context, laborCost LaborCostRequest) (domain.LaborCost, error)
+	// ProjectActualCost adds the project's expenses to its labor cost. It is
+	// the one measure of actual spend shared by reports and alerts.
+	ProjectActualCost(ctx context.Context, actualCost ActualCostRequest) (domain.ActualCost, error)
+}
+
+type costingService struct {
+	repo ports.Repository
+	timesheetRepo timesheetPorts.Repository
+	expenseRepo expensePorts.Repository
+	projectRepo projectPorts.Repository
+	memberRepo membershipPorts.Repository
+	userService userUseCase.UserService
+	exchangeService exchangeUseCase.ExchangeService
+	membershipService membershipUseCase.MembershipService
+}
+
+func New(repo ports.Repository, timesheetRepo timesheetPorts.Repository, expenseRepo expensePorts.Repository, projectRepo projectPorts.Repository, memberRepo membershipPorts.Repository, userService userUseCase.UserService, exchangeService exchangeUseCase.ExchangeService, membershipService membershipUseCase.MembershipService) CostingService {
+	return &costingService{
+		repo: repo,
+		timesheetRepo: timesheetRepo,
+		expenseRepo: expenseRepo,
+		projectRepo: projectRepo,
+		memberRepo: memberRepo,
+		userService: userService,
+		exchangeService: exchangeService,
+		membershipService: membershipService,
+	}
+}
+
+func(s *costingService) CreateRate(ctx context.Context, createRateRequest CreateRateRequest) (domain.Rate, error) {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return domain.Rate{}, ErrForbidden
+	default:
+		return domain.Rate{}, err
+	}
+
+	rate := domain.Rate{
+		UserID: createRateRequest.UserID,
+		Role: createRateRequest.Role,
+		ProjectID: createRateRequest.ProjectID,
+		CostRate: createRateRequest.CostRate,
+		BillingRate: createRateRequest.BillingRate,
+		EffectiveFrom: createRateRequest.EffectiveFrom,
+		EffectiveTo: createRateRequest.EffectiveTo,
+	}
+	err = s.validate(ctx, rate)
+	if err != nil {
+		return domain.Rate{}, err
+	}
+	return s.repo.CreateRate(ctx, rate)
+}
+
+func(s *costingService) UpdateRate(ctx context.Context, updateRateRequest UpdateRateRequest) (domain.Rate, error) {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return domain.Rate{}, ErrForbidden
+	default:
+		return domain.Rate{}, err
+	}
+
+	_, err = s.repo.GetRate(ctx, updateRateRequest.ID)
+	switch err {
+	case nil:
+	case ports.ErrRateNotFound:
+		return domain.Rate{}, ErrRateNotFound
+	default:
+		return domain.Rate{}, err
+	}
+
+	rate := domain.Rate{
+		ID: updateRateRequest.ID,
+		UserID: updateRateRequest.UserID,
+		Role: updateRateRequest.Role,
+		ProjectID: updateRateRequest.ProjectID,
+		CostRate: updateRateRequest.CostRate,
+		BillingRate: updateRateRequest.BillingRate,
+		EffectiveFrom: updateRateRequest.EffectiveFrom,
+		EffectiveTo: updateRateRequest.EffectiveTo,
+	}
+	err = s.validate(ctx, rate)
+	if err != nil {
+		return domain.Rate{}, err
+	}
+
+	updatedRate, err := s.repo.UpdateRate(ctx, rate)
+	switch err {
+	case ports.ErrRateNotFound:
+		return domain.Rate{}, ErrRateNotFound
+	}
+	return updatedRate, err
+}
+
+func(s *costingService) DeleteRate(ctx context.Context, id int) error {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return ErrForbidden
+	default:
+		return err
+	}
+
+	err = s.repo.DeleteRate(ctx, id)
+	switch err {
+	case ports.ErrRateNotFound:
+		return ErrRateNotFound
+	}
+	return err
+}
+
+func(s *costingService) ListRates(ctx context.Context, filter domain.RateFilter) ([]domain.Rate, error) {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return nil, ErrForbidden
+	default:
+		return nil, err
+	}
+	return s.repo.ListRates(ctx, filter)
+}
+
+// validate checks the scope, amounts and range of rate and that it does not
+// overlap another rate of the same scope.
+func(s *costingService) validate(ctx context.Context, rate domain.Rate) error {
+	if (rate.UserID == 0) == (rate.Role == "") {
+		return ErrInvalidScope
+	}
+	if rate.Role != "" && !membershipDomain.IsValidRole(rate.Role) {
+		return ErrInvalidRole
+	}
+	if !isValidRate(rate.CostRate) || !isValidRate(rate.BillingRate) || rate.CostRate.Currency() != rate.BillingRate.Currency() {
+		return ErrInvalidRate
+	}
+	if rate.EffectiveFrom.IsZero() || (!rate.EffectiveTo.IsZero() && !rate.EffectiveFrom.Before(rate.EffectiveTo)) {
+		return ErrInvalidEffectiveRange
+	}
+
+	if rate.UserID != 0 {
+		_, err := s.userService.GetUser(ctx, rate.UserID)
+		switch err {
+		case nil:
+		case userUseCase.ErrUserNotFound:
+			return ErrUserNotFound
+		default:
+			return err
+		}
+	}
+	if rate.ProjectID != 0 {
+		_, err := s.getProject(ctx, rate.ProjectID)
+		if err != nil {
+			return err
+		}
+	}
+
+	existingRates, err := s.repo.ListRates(ctx, domain.RateFilter{
+		UserID: rate.UserID,
+		Role: rate.Role,
+		ProjectID: rate.ProjectID,
+	})
+	if err != nil {
+		return err
+	}
+	for _, existingRate := range existingRates {
+		if existingRate.ID != rate.ID && existingRate.Overlaps(rate) {
+			return ErrOverlappingRate
+		}
+	}
+	return nil
+}
+
+func(s *costingService) getProject(ctx context.Context, projectID int) (projectDomain.Project, error) {
+	project, err := s.projectRepo.GetProject(ctx, projectID)
+	switch err {
+	case projectPorts.ErrProjectNotFound:
+		return projectDomain.Project{}, ErrProjectNotFound
+	}
+	return project, err
+}
+
+func isValidRate(rate money.Money) bool {
+	return rate.Currency() != "" && !rate.IsNegative()
+}
@@ -0,0 +1,128 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/costing/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/costing/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/costing/usecase"
+	exchangeUseCaseMock "github.com/captainhbb/tbs-backend/internal/exchange/usecase/mock"
+	expensePortsMock "github.com/captainhbb/tbs-backend/internal/expense/ports/mock"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipPortsMock "github.com/captainhbb/tbs-backend/internal/membership/ports/mock"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	timesheetPortsMock "github.com/captainhbb/tbs-backend/internal/timesheet/ports/mock"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var march = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func TestCreateRate(t *testing.T) {
+	t.Parallel()
+
+	existingRates := []domain.Rate{
+		{ID: 1, Role: membershipDomain.RoleContributor, CostRate: money.MustNew(5000, "USD"), BillingRate: money.MustNew(8000, "USD"), EffectiveFrom: march, EffectiveTo: march.AddDate(0, 1, 0)},
+	}
+
+	tests := []struct {
+		name string
+		actorID int
+		input usecase.CreateRateRequest
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "role rate after the existing one",
+			actorID: 1,
+			input: usecase.CreateRateRequest{Role: membershipDomain.RoleContributor, CostRate: money.MustNew(5500, "USD"), BillingRate: money.MustNew(9000, "USD"), EffectiveFrom: march.AddDate(0, 1, 0)},
+		},
+		{
+			name: "user rate",
+			actorID: 1,
+			input: usecase.CreateRateRequest{UserID: 2, ProjectID: 1, CostRate: money.MustNew(6000, "USD"), BillingRate: money.MustNew(10000, "USD"), EffectiveFrom: march},
+		},
+		{
+			name: "not an admin",
+			actorID: 2,
+			input: usecase.CreateRateRequest{UserID: 2, CostRate: money.MustNew(6000, "USD"), BillingRate: money.MustNew(10000, "USD"), EffectiveFrom: march},
+			expectError: true,
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "user and role",
+			actorID: 1,
+			input: usecase.CreateRateRequest{UserID: 2, Role: membershipDomain.RoleViewer, CostRate: money.MustNew(6000, "USD"), BillingRate: money.MustNew(10000, "USD"), EffectiveFrom: march},
+			expectError: true,
+			expectedError: usecase.ErrInvalidScope,
+		},
+		{
+			name: "mixed currencies",
+			actorID: 1,
+			input: usecase.CreateRateRequest{Role: membershipDomain.RoleViewer, CostRate: money.MustNew(6000, "USD"), BillingRate: money.MustNew(10000, "EUR"), EffectiveFrom: march},
+			expectError: true,
+			expectedError: usecase.ErrInvalidRate,
+		},
+		{
+			name: "ends before it starts",
+			actorID: 1,
+			input: usecase.CreateRateRequest{Role: membershipDomain.RoleViewer, CostRate: money.MustNew(6000, "USD"), BillingRate: money.MustNew(10000, "USD"), EffectiveFrom: march, EffectiveTo: march},
+			expectError: true,
+			expectedError: usecase.ErrInvalidEffectiveRange,
+		},
+		{
+			name: "overlaps the existing rate",
+			actorID: 1,
+			input: usecase.CreateRateRequest{Role: membershipDomain.RoleContributor, CostRate: money.MustNew(5500, "USD"), BillingRate: money.MustNew(9000, "USD"), EffectiveFrom: march.AddDate(0, 0, 14)},
+			expectError: true,
+			expectedError: usecase.ErrOverlappingRate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			userServiceMock := userUseCaseMock.NewMockUserService(t)
+			service := usecase.New(repoMock, timesheetPortsMock.NewMockRepository(t), expensePortsMock.NewMockRepository(t), projectRepoMock, membershipPortsMock.NewMockRepository(t), userServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), membershipUseCaseMock.NewMockMembershipService(t))
+
+			userServiceMock.On("RequireRole", mock.Anything, userDomain.RoleAdmin).Return(func(ctx context.Context, _ ...string) error {
+				if actorID, _ := actor.IDFromContext(ctx); actorID != 1 {
+					return userUseCase.ErrForbidden
+				}
+				return nil
+			})
+			userServiceMock.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, Role: userDomain.RoleManager, Active: true}, nil).Maybe()
+			projectRepoMock.On("GetProject", mock.Anything, 1).Return(project, nil).Maybe()
+			repoMock.On("ListRates", mock.Anything, mock.Anything).Return(func(_ context.Context, filter domain.RateFilter) ([]domain.Rate, error) {
+				var rates []domain.Rate
+				for _, rate := range existingRates {
+					if filter.Matches(rate) {
+						rates = append(rates, rate)
+					}
+				}
+				return rates, nil
+			}).Maybe()
+			repoMock.On("CreateRate", mock.Anything, mock.Anything).Return(func(_ context.Context, rate domain.Rate) (domain.Rate, error) {
+				rate.ID = 2
+				return rate, nil
+			}).Maybe()
+
+			rate, err := service.CreateRate(actor.WithID(context.Background(), tt.actorID), tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 2, rate.ID)
+			}
+		})
+	}
+}
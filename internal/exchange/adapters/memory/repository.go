@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	"github.com/captainhbb/tbs-backend/internal/exchange/ports"
+)
+
+type pair struct {
+	base		string
+	quote		string
+}
+
+type repository struct {
+	mu			sync.RWMutex
+	rates		map[pair][]domain.ExchangeRate
+}
+
+func New() ports.Repository {
+	return &repository{
+		rates: make(map[pair][]domain.ExchangeRate),
+	}
+}
+
+func(r *repository) SaveRates(ctx context.Context, rates []domain.ExchangeRate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, rate := range rates {
+		rate.Date = domain.Day(rate.Date)
+		key := pair{base: rate.Base, quote: rate.Quote}
+		series := r.rates[key]
+
+		i := sort.Search(len(series), func(i int) bool { return !series[i].Date.Before(rate.Date) })
+		if i < len(series) && series[i].Date.Equal(rate.Date) {
+			series[i] = rate
+			continue
+		}
+		series = append(series, domain.ExchangeRate{})
+		copy(series[i+1:], series[i:])
+		series[i] = rate
+		r.rates[key] = series
+	}
+	return nil
+}
+
+func(r *repository) FindRate(ctx context.Context, base string, quote string, on time.Time) (domain.ExchangeRate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	series := r.rates[pair{base: base, quote: quote}]
+	day := domain.Day(on)
+	i := sort.Search(len(series), func(i int) bool { return series[i].Date.After(day) })
+	if i == 0 {
+		return domain.ExchangeRate{}, ports.ErrRateNotFound
+	}
+	return series[i-1], nil
+}
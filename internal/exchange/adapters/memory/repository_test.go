@@ -0,0 +1,51 @@
+package memory_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/exchange/adapters/memory"
+	"github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	"github.com/captainhbb/tbs-backend/internal/exchange/ports"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindRate(t *testing.T) {
+	t.Parallel()
+
+	repo := memory.New()
+	ctx := context.Background()
+
+	err := repo.SaveRates(ctx, []domain.ExchangeRate{
+		{Base: "EUR", Quote: "USD", Date: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), Rate: big.NewRat(110, 100)},
+		{Base: "EUR", Quote: "USD", Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Rate: big.NewRat(108, 100)},
+		{Base: "EUR", Quote: "USD", Date: time.Date(2024, 1, 5, 15, 0, 0, 0, time.UTC), Rate: big.NewRat(111, 100)},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		on time.Time
+		expectedRate *big.Rat
+		expectError bool
+	}{
+		{name: "exact day", on: time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC), expectedRate: big.NewRat(108, 100)},
+		{name: "falls back to previous day", on: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), expectedRate: big.NewRat(108, 100)},
+		{name: "later save replaces same day", on: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), expectedRate: big.NewRat(111, 100)},
+		{name: "before first rate", on: time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := repo.FindRate(ctx, "EUR", "USD", tt.on)
+			if tt.expectError {
+				require.ErrorIs(t, err, ports.ErrRateNotFound)
+			} else {
+				require.NoError(t, err)
+				require.Zero(t, tt.expectedRate.Cmp(rate.Rate))
+			}
+		})
+	}
+}
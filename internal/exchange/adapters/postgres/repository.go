@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	"github.com/captainhbb/tbs-backend/internal/exchange/ports"
+)
+
+// Schema creates the table backing the repository. Rates are kept as exact
+// fractions ("1234/1000") so no precision is lost on the way in or out.
+const Schema = `
+CREATE TABLE IF NOT EXISTS exchange_rates (
+	base		CHAR(3) NOT NULL,
+	quote		CHAR(3) NOT NULL,
+	rate_date	DATE NOT NULL,
+	rate		TEXT NOT NULL,
+	source		TEXT NOT NULL,
+	PRIMARY KEY (base, quote, rate_date)
+);
+`
+
+type repository struct {
+	db *sql.DB
+}
+
+func New(db *sql.DB) ports.Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+func(r *repository) SaveRates(ctx context.Context, rates []domain.ExchangeRate) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, rate := range rates {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO exchange_rates (base, quote, rate_date, rate, source)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (base, quote, rate_date) DO UPDATE SET rate = EXCLUDED.rate, source = EXCLUDED.source`,
+			rate.Base, rate.Quote, domain.Day(rate.Date), rate.Rate.RatString(), rate.Source,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func(r *repository) FindRate(ctx context.Context, base string, quote string, on time.Time) (domain.ExchangeRate, error) {
+	rate := domain.ExchangeRate{Base: base, Quote: quote}
+	var rateText string
+	err := r.db.QueryRowContext(ctx,
+		`SELECT rate_date, rate, source FROM exchange_rates
+		WHERE base = $1 AND quote = $2 AND rate_date <= $3
+		ORDER BY rate_date DESC LIMIT 1`,
+		base, quote, domain.Day(on),
+	).Scan(&rate.Date, &rateText, &rate.Source)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ExchangeRate{}, ports.ErrRateNotFound
+	}
+	if err != nil {
+		return domain.ExchangeRate{}, err
+	}
+
+	value, ok := new(big.Rat).SetString(rateText)
+	if !ok {
+		return domain.ExchangeRate{}, errors.New("exchange rate: invalid stored rate " + rateText)
+	}
+	rate.Rate = value
+	return rate, nil
+}
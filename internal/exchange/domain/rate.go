@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+// PivotCurrency is used to cross two currencies that have no direct rate.
+// ECB reference rates are all quoted against it.
+const PivotCurrency = "EUR"
+
+// ExchangeRate says that on Date one unit of Base was worth Rate units of Quote.
+type ExchangeRate struct {
+	Base				string
+	Quote				string
+	Date				time.Time
+	Rate				*big.Rat
+	Source				string
+}
+
+// Day truncates t to the start of its calendar day in UTC. Rates are dated by
+// day only.
+func Day(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// Conversion is the result of expressing an amount in another currency.
+// RateDate is the date of the rate actually used, which may precede the
+// requested date when no rate was published that day.
+type Conversion struct {
+	Original			money.Money
+	Converted			money.Money
+	Rate				*big.Rat
+	RateDate			time.Time
+}
@@ -0,0 +1,124 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+const (
+	SourceCSV		= "csv"
+	SourceECB		= "ecb"
+)
+
+var ErrMalformedFile = errors.New("malformed exchange rate file")
+
+// ParseCSV reads rates from a CSV file with a header row and the columns
+// date (YYYY-MM-DD), base, quote and rate, in any order.
+func ParseCSV(r io.Reader) ([]domain.ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedFile, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"date", "base", "quote", "rate"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: missing %q column", ErrMalformedFile, name)
+		}
+	}
+
+	var rates []domain.ExchangeRate
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMalformedFile, err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		rate, err := newRate(record[columns["date"]], record[columns["base"]], record[columns["quote"]], record[columns["rate"]], SourceCSV)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrMalformedFile, line, err)
+		}
+		rates = append(rates, rate)
+	}
+	return rates, nil
+}
+
+type ecbEnvelope struct {
+	Days []struct {
+		Time	string	`xml:"time,attr"`
+		Rates	[]struct {
+			Currency	string	`xml:"currency,attr"`
+			Rate		string	`xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// ParseECBXML reads the European Central Bank reference rate format, where
+// every rate is the price of one euro:
+//
+//	<Cube><Cube time="2024-01-02"><Cube currency="USD" rate="1.0956"/></Cube></Cube>
+func ParseECBXML(r io.Reader) ([]domain.ExchangeRate, error) {
+	var envelope ecbEnvelope
+	err := xml.NewDecoder(r).Decode(&envelope)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedFile, err)
+	}
+
+	var rates []domain.ExchangeRate
+	for _, day := range envelope.Days {
+		for _, quoted := range day.Rates {
+			rate, err := newRate(day.Time, domain.PivotCurrency, quoted.Currency, quoted.Rate, SourceECB)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s %s: %v", ErrMalformedFile, day.Time, quoted.Currency, err)
+			}
+			rates = append(rates, rate)
+		}
+	}
+	return rates, nil
+}
+
+func newRate(date string, base string, quote string, rate string, source string) (domain.ExchangeRate, error) {
+	day, err := time.Parse(time.DateOnly, strings.TrimSpace(date))
+	if err != nil {
+		return domain.ExchangeRate{}, err
+	}
+
+	base = strings.ToUpper(strings.TrimSpace(base))
+	quote = strings.ToUpper(strings.TrimSpace(quote))
+	for _, code := range []string{base, quote} {
+		if !money.IsKnownCurrency(code) {
+			return domain.ExchangeRate{}, fmt.Errorf("unknown currency %q", code)
+		}
+	}
+
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+	if !ok || value.Sign() <= 0 {
+		return domain.ExchangeRate{}, fmt.Errorf("invalid rate %q", rate)
+	}
+
+	return domain.ExchangeRate{
+		Base: base,
+		Quote: quote,
+		Date: day,
+		Rate: value,
+		Source: source,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package importer_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/exchange/importer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCSV(t *testing.T) {
+	t.Parallel()
+
+	rates, err := importer.ParseCSV(strings.NewReader("date,base,quote,rate\n2024-01-02,usd,JPY,141.25\n2024-01-03, GBP, USD, 1.2650\n"))
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+	require.Equal(t, "USD", rates[0].Base)
+	require.Equal(t, "JPY", rates[0].Quote)
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), rates[0].Date)
+	require.Zero(t, big.NewRat(14125, 100).Cmp(rates[0].Rate))
+	require.Equal(t, importer.SourceCSV, rates[0].Source)
+
+	_, err = importer.ParseCSV(strings.NewReader("date,base,rate\n2024-01-02,USD,1\n"))
+	require.ErrorIs(t, err, importer.ErrMalformedFile)
+
+	_, err = importer.ParseCSV(strings.NewReader("date,base,quote,rate\n2024-01-02,USD,JPY,-1\n"))
+	require.ErrorIs(t, err, importer.ErrMalformedFile)
+}
+
+func TestParseECBXML(t *testing.T) {
+	t.Parallel()
+
+	document := `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2024-01-03">
+			<Cube currency="USD" rate="1.0919"/>
+			<Cube currency="JPY" rate="155.52"/>
+		</Cube>
+		<Cube time="2024-01-02">
+			<Cube currency="USD" rate="1.0956"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+	rates, err := importer.ParseECBXML(strings.NewReader(document))
+	require.NoError(t, err)
+	require.Len(t, rates, 3)
+	require.Equal(t, "EUR", rates[0].Base)
+	require.Equal(t, "USD", rates[0].Quote)
+	require.Equal(t, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), rates[0].Date)
+	require.Zero(t, big.NewRat(10919, 10000).Cmp(rates[0].Rate))
+	require.Equal(t, "JPY", rates[1].Quote)
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), rates[2].Date)
+}
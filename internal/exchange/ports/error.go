@@ -0,0 +1,7 @@
+package ports
+
+import "errors"
+
+var (
+	ErrRateNotFound			= errors.New("exchange rate not found")
+)
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/exchange/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	// SaveRates inserts the rates, replacing any existing rate for the same
+	// base, quote and date.
+	SaveRates(ctx context.Context, rates []domain.ExchangeRate) error
+	// FindRate returns the most recent base/quote rate dated on or before on.
+	FindRate(ctx context.Context, base string, quote string, on time.Time) (domain.ExchangeRate, error)
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+const (
+	FormatCSV			= "csv"
+	FormatECBXML		= "ecb-xml"
+)
+
+type ImportFileRequest struct {
+	Path				string
+	// Format is FormatCSV or FormatECBXML. When empty it is inferred from the
+	// file extension.
+	Format				string
+}
+
+type ConvertRequest struct {
+	Amount				money.Money
+	Currency			string
+	On					time.Time
+}
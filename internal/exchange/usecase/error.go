@@ -0,0 +1,9 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrRateNotFound				= errors.New("no exchange rate available for the requested date")
+	ErrUnknownCurrency			= errors.New("unknown currency")
+	ErrUnsupportedFormat		= errors.New("unsupported exchange rate file format")
+)
@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/exchange/importer"
+	"github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	"github.com/captainhbb/tbs-backend/internal/exchange/ports"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+//go:generate mockery --dir . --name ExchangeService --structname MockExchangeService --filename mock_exchange_service.go --output ./mock --outpkg mock
+type ExchangeService interface {
+	// ImportFile loads every rate in a local rate file and returns how many were stored.
+	ImportFile(ctx context.Context, importRequest ImportFileRequest) (int, error)
+	ImportRates(ctx context.Context, format string, r io.Reader) (int, error)
+	Convert(ctx context.Context, convertRequest ConvertRequest) (domain.Conversion, error)
+}
+
+type exchangeService struct {
+	repo ports.Repository
+}
+
+func New(repo ports.Repository) ExchangeService {
+	return &exchangeService{
+		repo: repo,
+	}
+}
+
+func(s *exchangeService) ImportFile(ctx context.Context, importRequest ImportFileRequest) (int, error) {
+	format := importRequest.Format
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(importRequest.Path)) {
+		case ".csv":
+			format = FormatCSV
+		case ".xml":
+			format = FormatECBXML
+		default:
+			return 0, ErrUnsupportedFormat
+		}
+	}
+
+	f, err := os.Open(importRequest.Path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	return s.ImportRates(ctx, format, f)
+}
+
+func(s *exchangeService) ImportRates(ctx context.Context, format string, r io.Reader) (int, error) {
+	var rates []domain.ExchangeRate
+	var err error
+	switch format {
+	case FormatCSV:
+		rates, err = importer.ParseCSV(r)
+	case FormatECBXML:
+		rates, err = importer.ParseECBXML(r)
+	default:
+		return 0, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.repo.SaveRates(ctx, rates)
+	if err != nil {
+		return 0, err
+	}
+	return len(rates), nil
+}
+
+// Convert expresses an amount in another currency using the latest rate dated
+// on or before the requested day. The result is rounded half to even to the
+// target currency's minor unit.
+func(s *exchangeService) Convert(ctx context.Context, convertRequest ConvertRequest) (domain.Conversion, error) {
+	amount := convertRequest.Amount
+	if !money.IsKnownCurrency(amount.Currency()) || !money.IsKnownCurrency(convertRequest.Currency) {
+		return domain.Conversion{}, ErrUnknownCurrency
+	}
+
+	if amount.Currency() == convertRequest.Currency {
+		return domain.Conversion{
+			Original: amount,
+			Converted: amount,
+			Rate: big.NewRat(1, 1),
+			RateDate: domain.Day(convertRequest.On),
+		}, nil
+	}
+
+	rate, rateDate, err := s.rate(ctx, amount.Currency(), convertRequest.Currency, convertRequest.On)
+	if err != nil {
+		return domain.Conversion{}, err
+	}
+
+	// Rates are per major unit, so shift for currencies with a different
+	// number of decimals (e.g. USD cents to whole yen).
+	factor := new(big.Rat).Set(rate)
+	shift := money.MinorUnits(convertRequest.Currency) - money.MinorUnits(amount.Currency())
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(shift))), nil))
+	if shift >= 0 {
+		factor.Mul(factor, scale)
+	} else {
+		factor.Quo(factor, scale)
+	}
+
+	converted, err := money.MustNew(amount.Amount(), convertRequest.Currency).MulRat(factor)
+	if err != nil {
+		return domain.Conversion{}, err
+	}
+	return domain.Conversion{
+		Original: amount,
+		Converted: converted,
+		Rate: rate,
+		RateDate: rateDate,
+	}, nil
+}
+
+// rate finds the base/quote rate, trying the direct pair, its inverse and
+// finally a cross through the pivot currency. When two rates are combined the
+// older of their dates is reported.
+func(s *exchangeService) rate(ctx context.Context, base string, quote string, on time.Time) (*big.Rat, time.Time, error) {
+	rate, date, err := s.directRate(ctx, base, quote, on)
+	if err != ErrRateNotFound || base == domain.PivotCurrency || quote == domain.PivotCurrency {
+		return rate, date, err
+	}
+
+	toPivot, toPivotDate, err := s.directRate(ctx, base, domain.PivotCurrency, on)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	fromPivot, fromPivotDate, err := s.directRate(ctx, domain.PivotCurrency, quote, on)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	date = toPivotDate
+	if fromPivotDate.Before(date) {
+		date = fromPivotDate
+	}
+	return new(big.Rat).Mul(toPivot, fromPivot), date, nil
+}
+
+func(s *exchangeService) directRate(ctx context.Context, base string, quote string, on time.Time) (*big.Rat, time.Time, error) {
+	rate, err := s.repo.FindRate(ctx, base, quote, on)
+	switch err {
+	case nil:
+		return rate.Rate, rate.Date, nil
+	case ports.ErrRateNotFound:
+	default:
+		return nil, time.Time{}, err
+	}
+
+	inverse, err := s.repo.FindRate(ctx, quote, base, on)
+	switch err {
+	case nil:
+		return new(big.Rat).Inv(inverse.Rate), inverse.Date, nil
+	case ports.ErrRateNotFound:
+		return nil, time.Time{}, ErrRateNotFound
+	}
+	return nil, time.Time{}, err
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
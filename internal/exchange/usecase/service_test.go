@@ -0,0 +1,125 @@
+package usecase_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	"github.com/captainhbb/tbs-backend/internal/exchange/ports"
+	portsMock "github.com/captainhbb/tbs-backend/internal/exchange/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/exchange/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	on := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	rateDate := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		input usecase.ConvertRequest
+		mockSetup func(repo *portsMock.MockRepository)
+		expected money.Money
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "same currency",
+			input: usecase.ConvertRequest{Amount: money.MustNew(12345, "USD"), Currency: "USD", On: on},
+			mockSetup: func(_ *portsMock.MockRepository) {},
+			expected: money.MustNew(12345, "USD"),
+		},
+		{
+			name: "direct rate",
+			input: usecase.ConvertRequest{Amount: money.MustNew(10000, "EUR"), Currency: "USD", On: on},
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("FindRate", mock.Anything, "EUR", "USD", on).Return(domain.ExchangeRate{Base: "EUR", Quote: "USD", Date: rateDate, Rate: big.NewRat(10956, 10000)}, nil)
+			},
+			expected: money.MustNew(10956, "USD"),
+		},
+		{
+			name: "inverse rate rounds half to even",
+			input: usecase.ConvertRequest{Amount: money.MustNew(100, "USD"), Currency: "EUR", On: on},
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("FindRate", mock.Anything, "USD", "EUR", on).Return(domain.ExchangeRate{}, ports.ErrRateNotFound)
+				repo.On("FindRate", mock.Anything, "EUR", "USD", on).Return(domain.ExchangeRate{Base: "EUR", Quote: "USD", Date: rateDate, Rate: big.NewRat(8, 1)}, nil)
+			},
+			expected: money.MustNew(12, "EUR"),
+		},
+		{
+			name: "cross through pivot into zero decimal currency",
+			input: usecase.ConvertRequest{Amount: money.MustNew(10956, "USD"), Currency: "JPY", On: on},
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("FindRate", mock.Anything, "USD", "JPY", on).Return(domain.ExchangeRate{}, ports.ErrRateNotFound)
+				repo.On("FindRate", mock.Anything, "JPY", "USD", on).Return(domain.ExchangeRate{}, ports.ErrRateNotFound)
+				repo.On("FindRate", mock.Anything, "USD", "EUR", on).Return(domain.ExchangeRate{}, ports.ErrRateNotFound)
+				repo.On("FindRate", mock.Anything, "EUR", "USD", on).Return(domain.ExchangeRate{Date: rateDate, Rate: big.NewRat(10956, 10000)}, nil)
+				repo.On("FindRate", mock.Anything, "EUR", "JPY", on).Return(domain.ExchangeRate{Date: on, Rate: big.NewRat(15552, 100)}, nil)
+			},
+			expected: money.MustNew(15552, "JPY"),
+		},
+		{
+			name: "no rate",
+			input: usecase.ConvertRequest{Amount: money.MustNew(100, "EUR"), Currency: "USD", On: on},
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("FindRate", mock.Anything, mock.Anything, mock.Anything, on).Return(domain.ExchangeRate{}, ports.ErrRateNotFound)
+			},
+			expectError: true,
+			expectedError: usecase.ErrRateNotFound,
+		},
+		{
+			name: "unknown currency",
+			input: usecase.ConvertRequest{Amount: money.MustNew(100, "EUR"), Currency: "XYZ", On: on},
+			mockSetup: func(_ *portsMock.MockRepository) {},
+			expectError: true,
+			expectedError: usecase.ErrUnknownCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			service := usecase.New(repoMock)
+
+			tt.mockSetup(repoMock)
+
+			conversion, err := service.Convert(context.Background(), tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, conversion.Converted)
+				require.Equal(t, tt.input.Amount, conversion.Original)
+			}
+		})
+	}
+}
+
+func TestImportRates(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	service := usecase.New(repoMock)
+
+	repoMock.On("SaveRates", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		capturedArg := args.Get(1).([]domain.ExchangeRate)
+		require.Len(t, capturedArg, 2)
+	}).Return(nil)
+
+	count, err := service.ImportRates(context.Background(), usecase.FormatCSV, strings.NewReader("date,base,quote,rate\n2024-01-02,EUR,USD,1.0956\n2024-01-02,EUR,JPY,155.52\n"))
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	_, err = service.ImportRates(context.Background(), "yaml", strings.NewReader(""))
+	require.ErrorIs(t, err, usecase.ErrUnsupportedFormat)
+
+	_, err = service.ImportFile(context.Background(), usecase.ImportFileRequest{Path: "rates.json"})
+	require.ErrorIs(t, err, usecase.ErrUnsupportedFormat)
+}
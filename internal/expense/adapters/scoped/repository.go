@@ -0,0 +1,75 @@
+// Package scoped confines an expense repository to the tenant carried in the
+// request context, through the project each expense belongs to. Expenses of
+// projects in other tenants are reported as not found. Platform calls see every
+// tenant, and requests carrying neither see no expenses at all.
+package scoped
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/expense/domain"
+	"github.com/captainhbb/tbs-backend/internal/expense/ports"
+	projectScoped "github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+)
+
+type repository struct {
+	next			ports.Repository
+	projectRepo		projectPorts.Repository
+}
+
+// New wraps next so that every query is confined to the projects of the
+// tenant in ctx, which projectRepo looks up.
+func New(next ports.Repository, projectRepo projectPorts.Repository) ports.Repository {
+	return &repository{next: next, projectRepo: projectRepo}
+}
+
+func(r *repository) CreateExpense(ctx context.Context, expense domain.Expense) (domain.Expense, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, expense.ProjectID)
+	if err != nil {
+		return domain.Expense{}, err
+	}
+	return r.next.CreateExpense(ctx, expense)
+}
+
+func(r *repository) GetExpense(ctx context.Context, id int) (domain.Expense, error) {
+	expense, err := r.next.GetExpense(ctx, id)
+	if err != nil {
+		return domain.Expense{}, err
+	}
+	err = projectScoped.CheckProject(ctx, r.projectRepo, expense.ProjectID)
+	switch err {
+	case nil:
+	case projectPorts.ErrProjectNotFound:
+		return domain.Expense{}, ports.ErrExpenseNotFound
+	default:
+		return domain.Expense{}, err
+	}
+	return expense, nil
+}
+
+// UpdateExpense keeps the expense in the project it belongs to.
+func(r *repository) UpdateExpense(ctx context.Context, expense domain.Expense) (domain.Expense, error) {
+	existingExpense, err := r.GetExpense(ctx, expense.ID)
+	if err != nil {
+		return domain.Expense{}, err
+	}
+	expense.ProjectID = existingExpense.ProjectID
+	return r.next.UpdateExpense(ctx, expense)
+}
+
+func(r *repository) DeleteExpense(ctx context.Context, id int) error {
+	_, err := r.GetExpense(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeleteExpense(ctx, id)
+}
+
+func(r *repository) ListExpenses(ctx context.Context, projectID int) ([]domain.Expense, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListExpenses(ctx, projectID)
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+// Expense is money actually spent on a project. Category is one of the budget
+// line item categories so that spend can be compared with the plan. Billable
+// expenses are passed on to the client on the project's invoices.
+type Expense struct {
+	ID 					int
+	ProjectID			int
+	Amount				money.Money
+	Date				time.Time
+	Category			string
+	SubmitterID			int
+	ReceiptRef			string
+	Billable			bool
+}
@@ -0,0 +1,7 @@
+package ports
+
+import "errors"
+
+var (
+	ErrExpenseNotFound			= errors.New("expense not found")
+)
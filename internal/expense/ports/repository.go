@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/expense/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	CreateExpense(ctx context.Context, expense domain.Expense) (domain.Expense, error)
+	GetExpense(ctx context.Context, id int) (domain.Expense, error)
+	UpdateExpense(ctx context.Context, expense domain.Expense) (domain.Expense, error)
+	DeleteExpense(ctx context.Context, id int) error
+	ListExpenses(ctx context.Context, projectID int) ([]domain.Expense, error)
+}
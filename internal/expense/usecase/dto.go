@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+type RecordExpenseRequest struct {
+	ProjectID 				int
+	Amount 					money.Money
+	Date 					time.Time
+	Category 				string
+	// SubmitterID defaults to the acting user when zero.
+	SubmitterID 			int
+	ReceiptRef 				string
+	Billable 				bool
+}
+
+type UpdateExpenseRequest struct {
+	ID 						int
+	Amount 					money.Money
+	Date 					time.Time
+	Category 				string
+	ReceiptRef 				string
+	Billable 				bool
+}
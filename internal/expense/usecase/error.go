@@ -0,0 +1,12 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrExpenseNotFound			= errors.New("expense not found")
+	ErrProjectNotFound			= errors.New("project not found")
+	ErrInvalidCategory			= errors.New("invalid expense category")
+	ErrInvalidAmount			= errors.New("expense amount must be positive")
+	ErrDateRequired				= errors.New("expense date is required")
+	ErrSubmitterRequired		= errors.New("expense submitter is required")
+)
@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	alertUseCase "github.com/captainhbb/tbs-backend/internal/alert/usecase"
+	budgetDomain "github.com/captainhbb/tbs-backend/internal/budget/domain"
+	"github.com/captainhbb/tbs-backend/internal/expense/domain"
+	"github.com/captainhbb/tbs-backend/internal/expense/ports"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+)
+
+//go:generate mockery --dir . --name ExpenseService --structname MockExpenseService --filename mock_expense_service.go --output ./mock --outpkg mock
+type ExpenseService interface {
+	RecordExpense(ctx context.Context, expense RecordExpenseRequest) (domain.Expense, error)
+	GetExpense(ctx context.Context, id int) (domain.Expense, error)
+	UpdateExpense(ctx context.Context, expense UpdateExpenseRequest) (domain.Expense, error)
+	DeleteExpense(ctx context.Context, id int) error
+	ListExpenses(ctx context.Context, projectID int) ([]domain.Expense, error)
+}
+
+type expenseService struct {
+	repo ports.Repository
+	projectRepo projectPorts.Repository
+	membershipService membershipUseCase.MembershipService
+	alertService alertUseCase.AlertService
+}
+
+func New(repo ports.Repository, projectRepo projectPorts.Repository, membershipService membershipUseCase.MembershipService, alertService alertUseCase.AlertService) ExpenseService {
+	return &expenseService{
+		repo: repo,
+		projectRepo: projectRepo,
+		membershipService: membershipService,
+		alertService: alertService,
+	}
+}
+
+func(s *expenseService) RecordExpense(ctx context.Context, recordExpenseRequest RecordExpenseRequest) (domain.Expense, error) {
+	expense := domain.Expense{
+		ProjectID: recordExpenseRequest.ProjectID,
+		Amount: recordExpenseRequest.Amount,
+		Date: recordExpenseRequest.Date,
+		Category: recordExpenseRequest.Category,
+		SubmitterID: recordExpenseRequest.SubmitterID,
+		ReceiptRef: recordExpenseRequest.ReceiptRef,
+		Billable: recordExpenseRequest.Billable,
+	}
+	if expense.SubmitterID == 0 {
+		expense.SubmitterID, _ = actor.IDFromContext(ctx)
+	}
+	if expense.SubmitterID == 0 {
+		return domain.Expense{}, ErrSubmitterRequired
+	}
+
+	err := validate(expense)
+	if err != nil {
+		return domain.Expense{}, err
+	}
+
+	err = s.membershipService.Authorize(ctx, expense.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Expense{}, err
+	}
+
+	_, err = s.projectRepo.GetProject(ctx, expense.ProjectID)
+	switch err {
+	case projectPorts.ErrProjectNotFound:
+		return domain.Expense{}, ErrProjectNotFound
+	}
+	if err != nil {
+		return domain.Expense{}, err
+	}
+
+	createdExpense, err := s.repo.CreateExpense(ctx, expense)
+	if err != nil {
+		return domain.Expense{}, err
+	}
+
+	s.evaluateAlerts(ctx, createdExpense.ProjectID)
+	return createdExpense, nil
+}
+
+func(s *expenseService) GetExpense(ctx context.Context, id int) (domain.Expense, error) {
+	expense, err := s.getExpense(ctx, id)
+	if err != nil {
+		return domain.Expense{}, err
+	}
+
+	err = s.membershipService.Authorize(ctx, expense.ProjectID, membershipDomain.PermissionView)
+	if err != nil {
+		return domain.Expense{}, err
+	}
+	return expense, nil
+}
+
+func(s *expenseService) UpdateExpense(ctx context.Context, updateExpenseRequest UpdateExpenseRequest) (domain.Expense, error) {
+	existingExpense, err := s.getExpense(ctx, updateExpenseRequest.ID)
+	if err != nil {
+		return domain.Expense{}, err
+	}
+
+	expense := existingExpense
+	expense.Amount = updateExpenseRequest.Amount
+	expense.Date = updateExpenseRequest.Date
+	expense.Category = updateExpenseRequest.Category
+	expense.ReceiptRef = updateExpenseRequest.ReceiptRef
+	expense.Billable = updateExpenseRequest.Billable
+
+	err = validate(expense)
+	if err != nil {
+		return domain.Expense{}, err
+	}
+
+	err = s.membershipService.Authorize(ctx, expense.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Expense{}, err
+	}
+
+	updatedExpense, err := s.repo.UpdateExpense(ctx, expense)
+	switch err {
+	case nil:
+	case ports.ErrExpenseNotFound:
+		return domain.Expense{}, ErrExpenseNotFound
+	default:
+		return domain.Expense{}, err
+	}
+
+	s.evaluateAlerts(ctx, updatedExpense.ProjectID)
+	return updatedExpense, nil
+}
+
+func(s *expenseService) DeleteExpense(ctx context.Context, id int) error {
+	expense, err := s.getExpense(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.membershipService.Authorize(ctx, expense.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteExpense(ctx, id)
+	switch err {
+	case nil:
+	case ports.ErrExpenseNotFound:
+		return ErrExpenseNotFound
+	default:
+		return err
+	}
+
+	s.evaluateAlerts(ctx, expense.ProjectID)
+	return nil
+}
+
+func(s *expenseService) ListExpenses(ctx context.Context, projectID int) ([]domain.Expense, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListExpenses(ctx, projectID)
+}
+
+func(s *expenseService) getExpense(ctx context.Context, id int) (domain.Expense, error) {
+	expense, err := s.repo.GetExpense(ctx, id)
+	switch err {
+	case ports.ErrExpenseNotFound:
+		return domain.Expense{}, ErrExpenseNotFound
+	}
+	return expense, err
+}
+
+func validate(expense domain.Expense) error {
+	if !budgetDomain.IsValidCategory(expense.Category) {
+		return ErrInvalidCategory
+	}
+	if expense.Amount.Currency() == "" || expense.Amount.IsNegative() || expense.Amount.IsZero() {
+		return ErrInvalidAmount
+	}
+	if expense.Date.IsZero() {
+		return ErrDateRequired
+	}
+	return nil
+}
+
+// evaluateAlerts re-checks the project's alert rules after an expense change.
+// The change is already stored by then, so a failed evaluation is logged
+// rather than reported to the caller; the rules are checked again on the
+// project's next expense.
+func(s *expenseService) evaluateAlerts(ctx context.Context, projectID int) {
+	_, err := s.alertService.Evaluate(ctx, projectID)
+	if err != nil {
+		slog.ErrorContext(ctx, "evaluating budget alerts", "project_id", projectID, "error", err)
+	}
+}
@@ -0,0 +1,161 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	alertDomain "github.com/captainhbb/tbs-backend/internal/alert/domain"
+	alertUseCaseMock "github.com/captainhbb/tbs-backend/internal/alert/usecase/mock"
+	budgetDomain "github.com/captainhbb/tbs-backend/internal/budget/domain"
+	"github.com/captainhbb/tbs-backend/internal/expense/domain"
+	"github.com/captainhbb/tbs-backend/internal/expense/ports"
+	portsMock "github.com/captainhbb/tbs-backend/internal/expense/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/expense/usecase"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordExpense(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		input usecase.RecordExpenseRequest
+		mockSetup func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, membershipService *membershipUseCaseMock.MockMembershipService)
+		expectedSubmitterID int
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "submitter defaults to actor",
+			input: usecase.RecordExpenseRequest{ProjectID: 1, Amount: money.MustNew(1250, "USD"), Date: date, Category: budgetDomain.CategoryTravel, ReceiptRef: "R-1"},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, membershipService *membershipUseCaseMock.MockMembershipService) {
+				membershipService.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+				projectRepo.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1}, nil)
+				repo.On("CreateExpense", mock.Anything, mock.Anything).Return(func(_ context.Context, expense domain.Expense) (domain.Expense, error) {
+					expense.ID = 1
+					return expense, nil
+				})
+			},
+			expectedSubmitterID: 7,
+		},
+		{
+			name: "explicit submitter",
+			input: usecase.RecordExpenseRequest{ProjectID: 1, Amount: money.MustNew(1250, "USD"), Date: date, Category: budgetDomain.CategoryTravel, SubmitterID: 9},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, membershipService *membershipUseCaseMock.MockMembershipService) {
+				membershipService.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+				projectRepo.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1}, nil)
+				repo.On("CreateExpense", mock.Anything, mock.Anything).Return(func(_ context.Context, expense domain.Expense) (domain.Expense, error) {
+					return expense, nil
+				})
+			},
+			expectedSubmitterID: 9,
+		},
+		{
+			name: "invalid category",
+			input: usecase.RecordExpenseRequest{ProjectID: 1, Amount: money.MustNew(1250, "USD"), Date: date, Category: "snacks"},
+			mockSetup: func(_ *portsMock.MockRepository, _ *projectPortsMock.MockRepository, _ *membershipUseCaseMock.MockMembershipService) {},
+			expectError: true,
+			expectedError: usecase.ErrInvalidCategory,
+		},
+		{
+			name: "zero amount",
+			input: usecase.RecordExpenseRequest{ProjectID: 1, Amount: money.MustNew(0, "USD"), Date: date, Category: budgetDomain.CategoryTravel},
+			mockSetup: func(_ *portsMock.MockRepository, _ *projectPortsMock.MockRepository, _ *membershipUseCaseMock.MockMembershipService) {},
+			expectError: true,
+			expectedError: usecase.ErrInvalidAmount,
+		},
+		{
+			name: "missing date",
+			input: usecase.RecordExpenseRequest{ProjectID: 1, Amount: money.MustNew(1250, "USD"), Category: budgetDomain.CategoryTravel},
+			mockSetup: func(_ *portsMock.MockRepository, _ *projectPortsMock.MockRepository, _ *membershipUseCaseMock.MockMembershipService) {},
+			expectError: true,
+			expectedError: usecase.ErrDateRequired,
+		},
+		{
+			name: "project not found",
+			input: usecase.RecordExpenseRequest{ProjectID: 2, Amount: money.MustNew(1250, "USD"), Date: date, Category: budgetDomain.CategoryTravel},
+			mockSetup: func(_ *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, membershipService *membershipUseCaseMock.MockMembershipService) {
+				membershipService.On("Authorize", mock.Anything, 2, membershipDomain.PermissionEdit).Return(nil)
+				projectRepo.On("GetProject", mock.Anything, 2).Return(projectDomain.Project{}, projectPorts.ErrProjectNotFound)
+			},
+			expectError: true,
+			expectedError: usecase.ErrProjectNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			alertServiceMock := alertUseCaseMock.NewMockAlertService(t)
+			service := usecase.New(repoMock, projectRepoMock, membershipServiceMock, alertServiceMock)
+
+			ctx := actor.WithID(context.Background(), 7)
+
+			alertServiceMock.On("Evaluate", mock.Anything, tt.input.ProjectID).Return([]alertDomain.Alert{}, nil).Maybe()
+			tt.mockSetup(repoMock, projectRepoMock, membershipServiceMock)
+
+			expense, err := service.RecordExpense(ctx, tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				alertServiceMock.AssertCalled(t, "Evaluate", mock.Anything, tt.input.ProjectID)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedSubmitterID, expense.SubmitterID)
+				require.Equal(t, tt.input.Amount, expense.Amount)
+			}
+		})
+	}
+}
+
+func TestRecordExpenseKeepsExpenseWhenAlertsFail(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	projectRepoMock := projectPortsMock.NewMockRepository(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	alertServiceMock := alertUseCaseMock.NewMockAlertService(t)
+	service := usecase.New(repoMock, projectRepoMock, membershipServiceMock, alertServiceMock)
+
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+	projectRepoMock.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1}, nil)
+	repoMock.On("CreateExpense", mock.Anything, mock.Anything).Return(func(_ context.Context, expense domain.Expense) (domain.Expense, error) {
+		expense.ID = 1
+		return expense, nil
+	})
+	alertServiceMock.On("Evaluate", mock.Anything, 1).Return(nil, errors.New("connection reset"))
+
+	expense, err := service.RecordExpense(actor.WithID(context.Background(), 7), usecase.RecordExpenseRequest{
+		ProjectID: 1,
+		Amount: money.MustNew(1250, "USD"),
+		Date: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Category: budgetDomain.CategoryTravel,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, expense.ID)
+}
+
+func TestDeleteExpenseNotFound(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	service := usecase.New(repoMock, projectPortsMock.NewMockRepository(t), membershipUseCaseMock.NewMockMembershipService(t), alertUseCaseMock.NewMockAlertService(t))
+
+	repoMock.On("GetExpense", mock.Anything, 5).Return(domain.Expense{}, ports.ErrExpenseNotFound)
+
+	err := service.DeleteExpense(context.Background(), 5)
+	require.ErrorIs(t, err, usecase.ErrExpenseNotFound)
+}
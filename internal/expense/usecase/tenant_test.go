@@ -0,0 +1,131 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	alertUseCaseMock "github.com/captainhbb/tbs-backend/internal/alert/usecase/mock"
+	budgetDomain "github.com/captainhbb/tbs-backend/internal/budget/domain"
+	"github.com/captainhbb/tbs-backend/internal/expense/adapters/scoped"
+	"github.com/captainhbb/tbs-backend/internal/expense/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/expense/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/expense/usecase"
+	membershipScoped "github.com/captainhbb/tbs-backend/internal/membership/adapters/scoped"
+	membershipPortsMock "github.com/captainhbb/tbs-backend/internal/membership/ports/mock"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	portfolioScoped "github.com/captainhbb/tbs-backend/internal/portfolio/adapters/scoped"
+	portfolioPortsMock "github.com/captainhbb/tbs-backend/internal/portfolio/ports/mock"
+	projectScoped "github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	teamScoped "github.com/captainhbb/tbs-backend/internal/team/adapters/scoped"
+	teamPortsMock "github.com/captainhbb/tbs-backend/internal/team/ports/mock"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTenantService wires the expense service to scoped repositories and the
+// real membership service. Project 1 and expense 10 belong to tenant 1,
+// project 2 and expense 20 to tenant 2, and user 8 administers tenant 1.
+func newTenantService(t *testing.T) usecase.ExpenseService {
+	repoMock := portsMock.NewMockRepository(t)
+	projectRepoMock := projectPortsMock.NewMockRepository(t)
+	userServiceMock := userUseCaseMock.NewMockUserService(t)
+
+	projectRepoMock.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, TenantID: 1, OwnerID: 3}, nil).Maybe()
+	projectRepoMock.On("GetProject", mock.Anything, 2).Return(projectDomain.Project{ID: 2, TenantID: 2, OwnerID: 4}, nil).Maybe()
+	repoMock.On("GetExpense", mock.Anything, 10).Return(domain.Expense{ID: 10, ProjectID: 1}, nil).Maybe()
+	repoMock.On("GetExpense", mock.Anything, 20).Return(domain.Expense{ID: 20, ProjectID: 2}, nil).Maybe()
+	repoMock.On("ListExpenses", mock.Anything, 1).Return([]domain.Expense{{ID: 10, ProjectID: 1}}, nil).Maybe()
+	userServiceMock.On("GetUser", mock.Anything, 8).Return(userDomain.User{ID: 8, TenantID: 1, Role: userDomain.RoleAdmin}, nil).Maybe()
+
+	projectRepo := projectScoped.New(projectRepoMock)
+	membershipService := membershipUseCase.New(
+		membershipScoped.New(membershipPortsMock.NewMockRepository(t), projectRepoMock),
+		projectRepo,
+		userServiceMock,
+		portfolioScoped.New(portfolioPortsMock.NewMockRepository(t)),
+		teamScoped.New(teamPortsMock.NewMockRepository(t), projectRepoMock),
+	)
+	return usecase.New(scoped.New(repoMock, projectRepoMock), projectRepo, membershipService, alertUseCaseMock.NewMockAlertService(t))
+}
+
+func TestGetExpenseAcrossTenants(t *testing.T) {
+	t.Parallel()
+
+	tenantAdmin := tenant.WithID(actor.WithID(context.Background(), 8), 1)
+
+	tests := []struct {
+		name string
+		ctx context.Context
+		id int
+		expectedError error
+	}{
+		{
+			name: "expense of the actor's tenant",
+			ctx: tenantAdmin,
+			id: 10,
+		},
+		{
+			name: "expense of another tenant",
+			ctx: tenantAdmin,
+			id: 20,
+			expectedError: usecase.ErrExpenseNotFound,
+		},
+		{
+			name: "request within no tenant",
+			ctx: actor.WithID(context.Background(), 8),
+			id: 10,
+			expectedError: usecase.ErrExpenseNotFound,
+		},
+		{
+			name: "platform call",
+			ctx: tenant.AsPlatform(actor.AsSystem(context.Background())),
+			id: 20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := newTenantService(t)
+
+			expense, err := service.GetExpense(tt.ctx, tt.id)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.id, expense.ID)
+		})
+	}
+}
+
+func TestExpensesOfAnotherTenantsProject(t *testing.T) {
+	t.Parallel()
+
+	service := newTenantService(t)
+	ctx := tenant.WithID(actor.WithID(context.Background(), 8), 1)
+
+	expenses, err := service.ListExpenses(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, expenses, 1)
+
+	_, err = service.ListExpenses(ctx, 2)
+	require.ErrorIs(t, err, membershipUseCase.ErrProjectNotFound)
+
+	_, err = service.RecordExpense(ctx, usecase.RecordExpenseRequest{
+		ProjectID: 2,
+		Amount: money.MustNew(1250, "USD"),
+		Date: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Category: budgetDomain.CategoryTravel,
+	})
+	require.ErrorIs(t, err, membershipUseCase.ErrProjectNotFound)
+}
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+const (
+	ModelLinear					= "linear"
+	ModelMovingAverage			= "moving_average"
+	ModelExponential			= "exponential_smoothing"
+)
+
+// Models lists every forecast model in the order they are reported.
+var Models = []string{ModelLinear, ModelMovingAverage, ModelExponential}
+
+const (
+	// MovingAverageDays is the trailing window the moving average burn is
+	// taken over.
+	MovingAverageDays			= 28
+	// SmoothingPercent is the weight exponential smoothing gives the latest
+	// week against the smoothed weeks before it.
+	SmoothingPercent			= 50
+)
+
+func IsValidModel(model string) bool {
+	for _, known := range Models {
+		if model == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Forecast projects a project's spend at its end date from what it spent up
+// to AsOf. Amounts are in the currency of the project's proposed budget.
+type Forecast struct {
+	ProjectID			int
+	Model				string
+	AsOf				time.Time
+	Budget				money.Money
+	Spent				money.Money
+	// DailyBurn is the spend per day the model expects after AsOf.
+	DailyBurn			money.Money
+	ProjectedSpend		money.Money
+	// Variance is Budget less ProjectedSpend, negative for an overrun and
+	// positive for an underrun.
+	Variance			money.Money
+	// ExhaustionDate is the day spend reaches the budget, or zero when the
+	// projection stays within budget.
+	ExhaustionDate		time.Time
+}
+
+// Backtest is a forecast made part way through a completed project, set
+// against what the project went on to spend.
+type Backtest struct {
+	ProjectID			int
+	Checkpoint			time.Time
+	ProjectedSpend		money.Money
+	ActualSpend			money.Money
+	// PercentageError is how far the projection missed, signed and relative
+	// to ActualSpend. It is zero when the project spent nothing.
+	PercentageError		float64
+}
+
+// ModelAccuracy summarises how one model's backtests went.
+type ModelAccuracy struct {
+	Model				string
+	Backtests			[]Backtest
+	// MeanAbsolutePercentageError averages the size of the percentage errors
+	// of the backtests on projects that spent anything.
+	MeanAbsolutePercentageError	float64
+}
+
+// DailyBurn estimates the spend per day after the history window. history
+// holds the spend of each elapsed day, oldest first, in exact minor units.
+func DailyBurn(model string, history []*big.Rat) *big.Rat {
+	if len(history) == 0 {
+		return new(big.Rat)
+	}
+
+	switch model {
+	case ModelMovingAverage:
+		if len(history) > MovingAverageDays {
+			history = history[len(history) - MovingAverageDays:]
+		}
+		return mean(history)
+	case ModelExponential:
+		// Smooth whole weeks counted back from the latest day; the oldest week
+		// may be short and is smoothed at its own daily rate.
+		first := len(history) % 7
+		if first == 0 {
+			first = 7
+		}
+		latestWeight := big.NewRat(SmoothingPercent, 100)
+		earlierWeight := big.NewRat(100 - SmoothingPercent, 100)
+		level := mean(history[:first])
+		for start := first; start < len(history); start += 7 {
+			latest := new(big.Rat).Mul(latestWeight, mean(history[start:start + 7]))
+			level = latest.Add(latest, level.Mul(earlierWeight, level))
+		}
+		return level
+	default:
+		return mean(history)
+	}
+}
+
+// Sum adds up values exactly.
+func Sum(values []*big.Rat) *big.Rat {
+	total := new(big.Rat)
+	for _, value := range values {
+		total.Add(total, value)
+	}
+	return total
+}
+
+func mean(values []*big.Rat) *big.Rat {
+	total := Sum(values)
+	return total.Quo(total, new(big.Rat).SetInt64(int64(len(values))))
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import "time"
+
+// ForecastRequest projects ProjectID's spend from its history up to AsOf,
+// which defaults to today and is capped at the project end. Model picks one
+// model; empty means all of them.
+type ForecastRequest struct {
+	ProjectID 				int
+	AsOf 					time.Time
+	Model 					string
+}
+
+// CompareModelsRequest backtests every model on completed projects, each
+// forecast from the spend up to CheckpointPercent of the project's schedule
+// (default 50).
+type CompareModelsRequest struct {
+	ProjectIDs 				[]int
+	CheckpointPercent 		int
+}
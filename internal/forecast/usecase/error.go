@@ -0,0 +1,13 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrProjectNotFound			= errors.New("project not found")
+	ErrScheduleRequired			= errors.New("forecasting needs the project's start and end dates")
+	ErrProjectNotStarted		= errors.New("the project has no spend history before its start date")
+	ErrProjectNotCompleted		= errors.New("only projects that have ended can be backtested")
+	ErrInvalidModel				= errors.New("model must be linear, moving_average or exponential_smoothing")
+	ErrInvalidCheckpoint		= errors.New("checkpoint must be between 1 and 99 percent")
+	ErrNoProjects				= errors.New("at least one project is needed to compare models")
+)
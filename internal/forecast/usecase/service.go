@@ -0,0 +1,347 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"sort"
+	"time"
+
+	costingDomain "github.com/captainhbb/tbs-backend/internal/costing/domain"
+	costingUseCase "github.com/captainhbb/tbs-backend/internal/costing/usecase"
+	exchangeDomain "github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	exchangeUseCase "github.com/captainhbb/tbs-backend/internal/exchange/usecase"
+	expenseUseCase "github.com/captainhbb/tbs-backend/internal/expense/usecase"
+	"github.com/captainhbb/tbs-backend/internal/forecast/domain"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+const (
+	day = 24 * time.Hour
+	defaultCheckpointPercent = 50
+)
+
+//go:generate mockery --dir . --name ForecastService --structname MockForecastService --filename mock_forecast_service.go --output ./mock --outpkg mock
+type ForecastService interface {
+	// ForecastProject projects the spend at the project end from its
+	// expenses and approved labor cost so far, once per model.
+	ForecastProject(ctx context.Context, forecast ForecastRequest) ([]domain.Forecast, error)
+	// CompareModels backtests the models on completed projects and returns
+	// them most accurate first.
+	CompareModels(ctx context.Context, comparison CompareModelsRequest) ([]domain.ModelAccuracy, error)
+}
+
+type forecastService struct {
+	projectRepo projectPorts.Repository
+	expenseService expenseUseCase.ExpenseService
+	costingService costingUseCase.CostingService
+	exchangeService exchangeUseCase.ExchangeService
+	membershipService membershipUseCase.MembershipService
+}
+
+func New(projectRepo projectPorts.Repository, expenseService expenseUseCase.ExpenseService, costingService costingUseCase.CostingService, exchangeService exchangeUseCase.ExchangeService, membershipService membershipUseCase.MembershipService) ForecastService {
+	return &forecastService{
+		projectRepo: projectRepo,
+		expenseService: expenseService,
+		costingService: costingService,
+		exchangeService: exchangeService,
+		membershipService: membershipService,
+	}
+}
+
+func(s *forecastService) ForecastProject(ctx context.Context, forecastRequest ForecastRequest) ([]domain.Forecast, error) {
+	models := domain.Models
+	if forecastRequest.Model != "" {
+		if !domain.IsValidModel(forecastRequest.Model) {
+			return nil, ErrInvalidModel
+		}
+		models = []string{forecastRequest.Model}
+	}
+
+	project, err := s.getProject(ctx, forecastRequest.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	asOf := forecastRequest.AsOf
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+	through := exchangeDomain.Day(asOf)
+	end := exchangeDomain.Day(project.EndDate)
+	if end.Before(through) {
+		through = end
+	}
+	if through.Before(exchangeDomain.Day(project.StartDate)) {
+		return nil, ErrProjectNotStarted
+	}
+
+	history, err := s.spendHistory(ctx, project, through)
+	if err != nil {
+		return nil, err
+	}
+
+	forecasts := make([]domain.Forecast, 0, len(models))
+	for _, model := range models {
+		forecast, err := forecastSpend(project, model, history)
+		if err != nil {
+			return nil, err
+		}
+		forecasts = append(forecasts, forecast)
+	}
+	return forecasts, nil
+}
+
+func(s *forecastService) CompareModels(ctx context.Context, comparisonRequest CompareModelsRequest) ([]domain.ModelAccuracy, error) {
+	checkpoint := comparisonRequest.CheckpointPercent
+	if checkpoint == 0 {
+		checkpoint = defaultCheckpointPercent
+	}
+	if checkpoint < 1 || checkpoint > 99 {
+		return nil, ErrInvalidCheckpoint
+	}
+	if len(comparisonRequest.ProjectIDs) == 0 {
+		return nil, ErrNoProjects
+	}
+
+	accuracies := make([]domain.ModelAccuracy, len(domain.Models))
+	for i, model := range domain.Models {
+		accuracies[i].Model = model
+	}
+
+	today := exchangeDomain.Day(time.Now())
+	for _, projectID := range comparisonRequest.ProjectIDs {
+		project, err := s.getProject(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		end := exchangeDomain.Day(project.EndDate)
+		if !end.Before(today) {
+			return nil, ErrProjectNotCompleted
+		}
+
+		history, err := s.spendHistory(ctx, project, end)
+		if err != nil {
+			return nil, err
+		}
+		actual, err := toMoney(domain.Sum(history), project.ProposedBudget.Currency())
+		if err != nil {
+			return nil, err
+		}
+		// Round the checkpoint up so that every backtest sees at least one day.
+		elapsed := (len(history) * checkpoint + 99) / 100
+
+		for i, model := range domain.Models {
+			forecast, err := forecastSpend(project, model, history[:elapsed])
+			if err != nil {
+				return nil, err
+			}
+
+			backtest := domain.Backtest{
+				ProjectID: project.ID,
+				Checkpoint: forecast.AsOf,
+				ProjectedSpend: forecast.ProjectedSpend,
+				ActualSpend: actual,
+			}
+			if !actual.IsZero() {
+				backtest.PercentageError = float64(forecast.ProjectedSpend.Amount() - actual.Amount()) * 100 / float64(actual.Amount())
+			}
+			accuracies[i].Backtests = append(accuracies[i].Backtests, backtest)
+		}
+	}
+
+	for i := range accuracies {
+		var total float64
+		var measured int
+		for _, backtest := range accuracies[i].Backtests {
+			if backtest.ActualSpend.IsZero() {
+				continue
+			}
+			total += math.Abs(backtest.PercentageError)
+			measured++
+		}
+		if measured > 0 {
+			accuracies[i].MeanAbsolutePercentageError = total / float64(measured)
+		}
+	}
+	sort.SliceStable(accuracies, func(i, j int) bool {
+		return accuracies[i].MeanAbsolutePercentageError < accuracies[j].MeanAbsolutePercentageError
+	})
+	return accuracies, nil
+}
+
+// getProject loads a project the acting user may view and that has the dates
+// a forecast runs between.
+func(s *forecastService) getProject(ctx context.Context, projectID int) (projectDomain.Project, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return projectDomain.Project{}, err
+	}
+	project, err := s.projectRepo.GetProject(ctx, projectID)
+	switch err {
+	case nil:
+	case projectPorts.ErrProjectNotFound:
+		return projectDomain.Project{}, ErrProjectNotFound
+	default:
+		return projectDomain.Project{}, err
+	}
+	if project.StartDate.IsZero() || project.EndDate.IsZero() || project.EndDate.Before(project.StartDate) {
+		return projectDomain.Project{}, ErrScheduleRequired
+	}
+	return project, nil
+}
+
+// spendHistory returns what the project spent on each day from its start
+// through through, in exact minor units of its budget currency. Expenses from
+// before the start count on the first day, and each week's approved labor cost
+// is spread evenly over its days.
+func(s *forecastService) spendHistory(ctx context.Context, project projectDomain.Project, through time.Time) ([]*big.Rat, error) {
+	start := exchangeDomain.Day(project.StartDate)
+	currency := project.ProposedBudget.Currency()
+	history := make([]*big.Rat, dayIndex(start, through) + 1)
+	for i := range history {
+		history[i] = new(big.Rat)
+	}
+
+	expenses, err := s.expenseService.ListExpenses(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, expense := range expenses {
+		if exchangeDomain.Day(expense.Date).After(through) {
+			continue
+		}
+
+		amount := expense.Amount
+		if amount.Currency() != currency {
+			conversion, err := s.exchangeService.Convert(ctx, exchangeUseCase.ConvertRequest{
+				Amount: amount,
+				Currency: currency,
+				On: expense.Date,
+			})
+			if err != nil {
+				return nil, err
+			}
+			amount = conversion.Converted
+		}
+		spend := history[max(0, dayIndex(start, expense.Date))]
+		spend.Add(spend, new(big.Rat).SetInt64(amount.Amount()))
+	}
+
+	labor, err := s.costingService.ProjectLaborCost(ctx, costingUseCase.LaborCostRequest{
+		ProjectID: project.ID,
+		To: through.AddDate(0, 0, 1),
+		Period: costingDomain.PeriodWeek,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, period := range labor.ByPeriod {
+		first := dayIndex(start, period.Start)
+		last := min(first + 6, len(history) - 1)
+		first = max(first, 0)
+		if last < first {
+			first, last = 0, 0
+		}
+
+		share := big.NewRat(period.Cost.Amount(), int64(last - first + 1))
+		for i := first; i <= last; i++ {
+			history[i].Add(history[i], share)
+		}
+	}
+	return history, nil
+}
+
+// forecastSpend forecasts the spend at the project end with model from history, the
+// spend of the project's first days.
+func forecastSpend(project projectDomain.Project, model string, history []*big.Rat) (domain.Forecast, error) {
+	start := exchangeDomain.Day(project.StartDate)
+	days := dayIndex(start, project.EndDate) + 1
+	budget := project.ProposedBudget
+	currency := budget.Currency()
+
+	burn := domain.DailyBurn(model, history)
+	spentAmount := domain.Sum(history)
+	projectedAmount := new(big.Rat).Mul(burn, new(big.Rat).SetInt64(int64(days - len(history))))
+	projectedAmount.Add(projectedAmount, spentAmount)
+
+	spent, err := toMoney(spentAmount, currency)
+	if err != nil {
+		return domain.Forecast{}, err
+	}
+	dailyBurn, err := toMoney(burn, currency)
+	if err != nil {
+		return domain.Forecast{}, err
+	}
+	projected, err := toMoney(projectedAmount, currency)
+	if err != nil {
+		return domain.Forecast{}, err
+	}
+	variance, err := budget.Sub(projected)
+	if err != nil {
+		return domain.Forecast{}, err
+	}
+
+	forecast := domain.Forecast{
+		ProjectID: project.ID,
+		Model: model,
+		AsOf: start.AddDate(0, 0, len(history) - 1),
+		Budget: budget,
+		Spent: spent,
+		DailyBurn: dailyBurn,
+		ProjectedSpend: projected,
+		Variance: variance,
+	}
+	if variance.IsNegative() {
+		exhaustion, exhausted := exhaustionDay(budget.Amount(), history, burn, days)
+		if exhausted {
+			forecast.ExhaustionDate = start.AddDate(0, 0, exhaustion)
+		}
+	}
+	return forecast, nil
+}
+
+// exhaustionDay is the index of the first day cumulative spend exceeds budget,
+// looking past history at a steady burn when it has not yet. It reports false
+// when spend stays within budget through the last of the project's days.
+func exhaustionDay(budget int64, history []*big.Rat, burn *big.Rat, days int) (int, bool) {
+	limit := new(big.Rat).SetInt64(budget)
+	cumulative := new(big.Rat)
+	for i, spend := range history {
+		cumulative.Add(cumulative, spend)
+		if cumulative.Cmp(limit) > 0 {
+			return i, true
+		}
+	}
+	if burn.Sign() <= 0 {
+		return 0, false
+	}
+
+	// The budget is exceeded on the day after the remaining budget's whole
+	// days of burn, which is only worked out as an int once it is known to
+	// fall within the project.
+	remaining := new(big.Rat).Sub(limit, cumulative)
+	remaining.Quo(remaining, burn)
+	wholeDays := new(big.Int).Quo(remaining.Num(), remaining.Denom())
+	if wholeDays.Cmp(big.NewInt(int64(days - len(history)))) >= 0 {
+		return 0, false
+	}
+	return len(history) + int(wholeDays.Int64()), true
+}
+
+func dayIndex(start time.Time, t time.Time) int {
+	return int(exchangeDomain.Day(t).Sub(start) / day)
+}
+
+// toMoney rounds an exact amount of minor units half to even.
+func toMoney(amount *big.Rat, currency string) (money.Money, error) {
+	minorUnits := money.RoundHalfEven(amount)
+	if !minorUnits.IsInt64() {
+		return money.Money{}, money.ErrOverflow
+	}
+	return money.New(minorUnits.Int64(), currency)
+}
@@ -0,0 +1,223 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	budgetDomain "github.com/captainhbb/tbs-backend/internal/budget/domain"
+	costingDomain "github.com/captainhbb/tbs-backend/internal/costing/domain"
+	costingUseCase "github.com/captainhbb/tbs-backend/internal/costing/usecase"
+	costingUseCaseMock "github.com/captainhbb/tbs-backend/internal/costing/usecase/mock"
+	exchangeUseCaseMock "github.com/captainhbb/tbs-backend/internal/exchange/usecase/mock"
+	expenseDomain "github.com/captainhbb/tbs-backend/internal/expense/domain"
+	expenseUseCaseMock "github.com/captainhbb/tbs-backend/internal/expense/usecase/mock"
+	"github.com/captainhbb/tbs-backend/internal/forecast/domain"
+	"github.com/captainhbb/tbs-backend/internal/forecast/usecase"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// start is a Monday, so project days line up with timesheet weeks.
+var start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type mocks struct {
+	projectRepo *projectPortsMock.MockRepository
+	expenseService *expenseUseCaseMock.MockExpenseService
+	costingService *costingUseCaseMock.MockCostingService
+}
+
+func newService(t *testing.T) (usecase.ForecastService, mocks) {
+	m := mocks{
+		projectRepo: projectPortsMock.NewMockRepository(t),
+		expenseService: expenseUseCaseMock.NewMockExpenseService(t),
+		costingService: costingUseCaseMock.NewMockCostingService(t),
+	}
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	membershipServiceMock.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	service := usecase.New(m.projectRepo, m.expenseService, m.costingService, exchangeUseCaseMock.NewMockExchangeService(t), membershipServiceMock)
+	return service, m
+}
+
+// dailyExpenses records amount on each of days days from first.
+func dailyExpenses(first time.Time, days int, amount int64) []expenseDomain.Expense {
+	var expenses []expenseDomain.Expense
+	for i := 0; i < days; i++ {
+		expenses = append(expenses, expenseDomain.Expense{ProjectID: 1, Category: budgetDomain.CategoryLicenses, Amount: money.MustNew(amount, "USD"), Date: first.AddDate(0, 0, i)})
+	}
+	return expenses
+}
+
+func TestForecastProject(t *testing.T) {
+	t.Parallel()
+
+	service, m := newService(t)
+	// 100 days and 1,600.00 to spend. After eight weeks spend has doubled from
+	// 70.00 a week to 140.00 a week, the last week of it on labor.
+	m.projectRepo.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{
+		ID: 1,
+		StartDate: start,
+		EndDate: start.AddDate(0, 0, 99),
+		ProposedBudget: money.MustNew(160000, "USD"),
+	}, nil)
+	expenses := append(dailyExpenses(start, 28, 1000), dailyExpenses(start.AddDate(0, 0, 28), 21, 2000)...)
+	expenses = append(expenses, dailyExpenses(start.AddDate(0, 0, 56), 3, 5000)...)
+	m.expenseService.On("ListExpenses", mock.Anything, 1).Return(expenses, nil)
+	m.costingService.On("ProjectLaborCost", mock.Anything, costingUseCase.LaborCostRequest{ProjectID: 1, To: start.AddDate(0, 0, 56), Period: costingDomain.PeriodWeek}).
+		Return(costingDomain.LaborCost{ProjectID: 1, ByPeriod: []costingDomain.PeriodLaborCost{
+			{Start: start.AddDate(0, 0, 49), Cost: money.MustNew(14000, "USD")},
+		}}, nil)
+
+	forecasts, err := service.ForecastProject(context.Background(), usecase.ForecastRequest{ProjectID: 1, AsOf: start.AddDate(0, 0, 55).Add(15 * time.Hour)})
+	require.NoError(t, err)
+	require.Len(t, forecasts, 3)
+
+	linear, movingAverage, exponential := forecasts[0], forecasts[1], forecasts[2]
+	for _, forecast := range forecasts {
+		require.Equal(t, start.AddDate(0, 0, 55), forecast.AsOf)
+		require.Equal(t, money.MustNew(84000, "USD"), forecast.Spent)
+	}
+
+	require.Equal(t, domain.ModelLinear, linear.Model)
+	require.Equal(t, money.MustNew(1500, "USD"), linear.DailyBurn)
+	require.Equal(t, money.MustNew(150000, "USD"), linear.ProjectedSpend)
+	require.Equal(t, money.MustNew(10000, "USD"), linear.Variance)
+	require.True(t, linear.ExhaustionDate.IsZero())
+
+	require.Equal(t, domain.ModelMovingAverage, movingAverage.Model)
+	require.Equal(t, money.MustNew(2000, "USD"), movingAverage.DailyBurn)
+	require.Equal(t, money.MustNew(172000, "USD"), movingAverage.ProjectedSpend)
+	require.Equal(t, money.MustNew(-12000, "USD"), movingAverage.Variance)
+	require.Equal(t, time.Date(2024, 4, 4, 0, 0, 0, 0, time.UTC), movingAverage.ExhaustionDate)
+
+	// Weekly rates of 10.00 a day for four weeks then 20.00 smooth to 19.375.
+	require.Equal(t, domain.ModelExponential, exponential.Model)
+	require.Equal(t, money.MustNew(169250, "USD"), exponential.ProjectedSpend)
+	require.Equal(t, time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC), exponential.ExhaustionDate)
+}
+
+func TestForecastProjectAfterItEnded(t *testing.T) {
+	t.Parallel()
+
+	service, m := newService(t)
+	m.projectRepo.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, StartDate: start, EndDate: start.AddDate(0, 0, 9), ProposedBudget: money.MustNew(500, "USD")}, nil)
+	m.expenseService.On("ListExpenses", mock.Anything, 1).Return(dailyExpenses(start, 10, 100), nil)
+	m.costingService.On("ProjectLaborCost", mock.Anything, mock.Anything).Return(costingDomain.LaborCost{ProjectID: 1}, nil)
+
+	forecasts, err := service.ForecastProject(context.Background(), usecase.ForecastRequest{ProjectID: 1, Model: domain.ModelLinear})
+	require.NoError(t, err)
+	require.Len(t, forecasts, 1)
+	require.Equal(t, start.AddDate(0, 0, 9), forecasts[0].AsOf)
+	require.Equal(t, money.MustNew(1000, "USD"), forecasts[0].ProjectedSpend)
+	// The budget ran out on the sixth day, when spend passed 5.00.
+	require.Equal(t, start.AddDate(0, 0, 5), forecasts[0].ExhaustionDate)
+}
+
+func TestForecastProjectAtABurnBelowOneMinorUnit(t *testing.T) {
+	t.Parallel()
+
+	service, m := newService(t)
+	// Ten years and 1.00 to spend, with a cent of labor spread over the first
+	// week, so the project burns a seventh of a cent a day.
+	m.projectRepo.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, StartDate: start, EndDate: start.AddDate(0, 0, 3649), ProposedBudget: money.MustNew(100, "USD")}, nil)
+	m.expenseService.On("ListExpenses", mock.Anything, 1).Return([]expenseDomain.Expense{}, nil)
+	m.costingService.On("ProjectLaborCost", mock.Anything, mock.Anything).Return(costingDomain.LaborCost{ProjectID: 1, ByPeriod: []costingDomain.PeriodLaborCost{
+		{Start: start, Cost: money.MustNew(1, "USD")},
+	}}, nil)
+
+	forecasts, err := service.ForecastProject(context.Background(), usecase.ForecastRequest{ProjectID: 1, Model: domain.ModelLinear, AsOf: start.AddDate(0, 0, 6)})
+	require.NoError(t, err)
+	require.Len(t, forecasts, 1)
+	require.Equal(t, money.MustNew(1, "USD"), forecasts[0].Spent)
+	require.Equal(t, money.MustNew(0, "USD"), forecasts[0].DailyBurn)
+	require.Equal(t, money.MustNew(521, "USD"), forecasts[0].ProjectedSpend)
+	// The remaining 0.99 lasts 693 days after the first week.
+	require.Equal(t, start.AddDate(0, 0, 700), forecasts[0].ExhaustionDate)
+}
+
+func TestForecastProjectErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		input usecase.ForecastRequest
+		project projectDomain.Project
+		expectedError error
+	}{
+		{
+			name: "unknown model",
+			input: usecase.ForecastRequest{ProjectID: 1, Model: "holt_winters"},
+			expectedError: usecase.ErrInvalidModel,
+		},
+		{
+			name: "no end date",
+			input: usecase.ForecastRequest{ProjectID: 1},
+			project: projectDomain.Project{ID: 1, StartDate: start, ProposedBudget: money.MustNew(500, "USD")},
+			expectedError: usecase.ErrScheduleRequired,
+		},
+		{
+			name: "not started",
+			input: usecase.ForecastRequest{ProjectID: 1, AsOf: start.AddDate(0, 0, -1)},
+			project: projectDomain.Project{ID: 1, StartDate: start, EndDate: start.AddDate(0, 0, 9), ProposedBudget: money.MustNew(500, "USD")},
+			expectedError: usecase.ErrProjectNotStarted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newService(t)
+			m.projectRepo.On("GetProject", mock.Anything, 1).Return(tt.project, nil).Maybe()
+
+			_, err := service.ForecastProject(context.Background(), tt.input)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestCompareModels(t *testing.T) {
+	t.Parallel()
+
+	service, m := newService(t)
+	// Project 1 spends steadily, project 2 triples its spend half way through.
+	m.projectRepo.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, StartDate: start, EndDate: start.AddDate(0, 0, 27), ProposedBudget: money.MustNew(5000, "USD")}, nil)
+	m.projectRepo.On("GetProject", mock.Anything, 2).Return(projectDomain.Project{ID: 2, StartDate: start, EndDate: start.AddDate(0, 0, 27), ProposedBudget: money.MustNew(5000, "USD")}, nil)
+	m.expenseService.On("ListExpenses", mock.Anything, 1).Return(dailyExpenses(start, 28, 100), nil)
+	m.expenseService.On("ListExpenses", mock.Anything, 2).Return(append(dailyExpenses(start, 14, 100), dailyExpenses(start.AddDate(0, 0, 14), 14, 300)...), nil)
+	m.costingService.On("ProjectLaborCost", mock.Anything, mock.Anything).Return(costingDomain.LaborCost{}, nil)
+
+	accuracies, err := service.CompareModels(context.Background(), usecase.CompareModelsRequest{ProjectIDs: []int{1, 2}, CheckpointPercent: 75})
+	require.NoError(t, err)
+	require.Len(t, accuracies, 3)
+
+	// At day 21 project 2 has spent 35.00: smoothing projects 49.00 and the
+	// other two models 46.67 against the 56.00 actually spent.
+	require.Equal(t, domain.ModelExponential, accuracies[0].Model)
+	require.InDelta(t, 6.25, accuracies[0].MeanAbsolutePercentageError, 1e-9)
+	require.Equal(t, []domain.Backtest{
+		{ProjectID: 1, Checkpoint: start.AddDate(0, 0, 20), ProjectedSpend: money.MustNew(2800, "USD"), ActualSpend: money.MustNew(2800, "USD")},
+		{ProjectID: 2, Checkpoint: start.AddDate(0, 0, 20), ProjectedSpend: money.MustNew(4900, "USD"), ActualSpend: money.MustNew(5600, "USD"), PercentageError: -12.5},
+	}, accuracies[0].Backtests)
+
+	require.Equal(t, domain.ModelLinear, accuracies[1].Model)
+	require.Equal(t, domain.ModelMovingAverage, accuracies[2].Model)
+	require.InDelta(t, 8.33, accuracies[1].MeanAbsolutePercentageError, 0.01)
+	require.InDelta(t, 8.33, accuracies[2].MeanAbsolutePercentageError, 0.01)
+}
+
+func TestCompareModelsNeedsCompletedProjects(t *testing.T) {
+	t.Parallel()
+
+	service, m := newService(t)
+	m.projectRepo.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, StartDate: start, EndDate: time.Now().AddDate(0, 1, 0), ProposedBudget: money.MustNew(5000, "USD")}, nil)
+
+	_, err := service.CompareModels(context.Background(), usecase.CompareModelsRequest{ProjectIDs: []int{1}})
+	require.ErrorIs(t, err, usecase.ErrProjectNotCompleted)
+
+	_, err = service.CompareModels(context.Background(), usecase.CompareModelsRequest{ProjectIDs: []int{1}, CheckpointPercent: 100})
+	require.ErrorIs(t, err, usecase.ErrInvalidCheckpoint)
+}
@@ -0,0 +1,76 @@
+// Package scoped confines an invoice repository to the tenant carried in the
+// request context, through the project each invoice belongs to. Invoices of
+// projects in other tenants are reported as not found. Platform calls see every
+// tenant, and requests carrying neither see no invoices at all.
+package scoped
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/invoice/domain"
+	"github.com/captainhbb/tbs-backend/internal/invoice/ports"
+	projectScoped "github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+)
+
+type repository struct {
+	next			ports.Repository
+	projectRepo		projectPorts.Repository
+}
+
+// New wraps next so that every query is confined to the projects of the
+// tenant in ctx, which projectRepo looks up.
+func New(next ports.Repository, projectRepo projectPorts.Repository) ports.Repository {
+	return &repository{next: next, projectRepo: projectRepo}
+}
+
+func(r *repository) CreateInvoice(ctx context.Context, invoice domain.Invoice) (domain.Invoice, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, invoice.ProjectID)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	return r.next.CreateInvoice(ctx, invoice)
+}
+
+func(r *repository) GetInvoice(ctx context.Context, id int) (domain.Invoice, error) {
+	invoice, err := r.next.GetInvoice(ctx, id)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	err = projectScoped.CheckProject(ctx, r.projectRepo, invoice.ProjectID)
+	switch err {
+	case nil:
+	case projectPorts.ErrProjectNotFound:
+		return domain.Invoice{}, ports.ErrInvoiceNotFound
+	default:
+		return domain.Invoice{}, err
+	}
+	return invoice, nil
+}
+
+// UpdateInvoice keeps the invoice in the project it belongs to.
+func(r *repository) UpdateInvoice(ctx context.Context, invoice domain.Invoice) (domain.Invoice, error) {
+	existingInvoice, err := r.GetInvoice(ctx, invoice.ID)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	invoice.ProjectID = existingInvoice.ProjectID
+	return r.next.UpdateInvoice(ctx, invoice)
+}
+
+func(r *repository) ListInvoices(ctx context.Context, projectID int) ([]domain.Invoice, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListInvoices(ctx, projectID)
+}
+
+// NextInvoiceNumber only hands out numbers of the tenant in ctx.
+func(r *repository) NextInvoiceNumber(ctx context.Context, tenantID int, year int) (int, error) {
+	if !tenant.Visible(ctx, tenantID) {
+		return 0, tenant.ErrTenantRequired
+	}
+	return r.next.NextInvoiceNumber(ctx, tenantID, year)
+}
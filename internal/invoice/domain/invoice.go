@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+const (
+	StatusDraft					= "draft"
+	StatusIssued				= "issued"
+	StatusPaid					= "paid"
+	StatusVoid					= "void"
+)
+
+const (
+	LineTime					= "time"
+	LineExpense					= "expense"
+)
+
+// Unit codes from UN/ECE Recommendation 20, as used by UBL.
+const (
+	UnitHour					= "HUR"
+	UnitPiece					= "C62"
+)
+
+// Party is the seller or buyer named on an invoice. Country is an ISO 3166-1
+// alpha-2 code.
+type Party struct {
+	Name				string
+	Street				string
+	City				string
+	PostalCode			string
+	Country				string
+	TaxID				string
+}
+
+// Line is one billed item. Quantity is in Unit and Amount is Quantity times
+// UnitPrice, rounded to the currency's minor unit. Time lines bill hundredths
+// of an hour, at the average rate when the rate changed during the period.
+type Line struct {
+	Kind				string
+	Description			string
+	Quantity			float64
+	Unit				string
+	UnitPrice			money.Money
+	Amount				money.Money
+}
+
+// Invoice bills a project's client for the billable time and expenses between
+// PeriodFrom (inclusive) and PeriodTo (exclusive). TaxRate is in basis points,
+// so 1900 is 19%. Number is assigned when the invoice is issued.
+type Invoice struct {
+	ID 					int
+	Number				string
+	ProjectID			int
+	Seller				Party
+	Buyer				Party
+	Currency			string
+	PeriodFrom			time.Time
+	PeriodTo			time.Time
+	Lines				[]Line
+	Subtotal			money.Money
+	TaxRate				int64
+	Tax					money.Money
+	Total				money.Money
+	Status				string
+	CreatedAt			time.Time
+	IssuedAt			time.Time
+	DueDate				time.Time
+	PaidAt				time.Time
+	VoidedAt			time.Time
+}
+
+// Covers reports whether the invoice, unless voided, bills any day between from
+// (inclusive) and to (exclusive).
+func (i Invoice) Covers(from time.Time, to time.Time) bool {
+	if i.Status == StatusVoid {
+		return false
+	}
+	return i.PeriodFrom.Before(to) && from.Before(i.PeriodTo)
+}
+
+// FormatNumber renders the sequence-th invoice number of year, e.g.
+// INV-2024-0007.
+func FormatNumber(year int, sequence int) string {
+	return fmt.Sprintf("INV-%d-%04d", year, sequence)
+}
@@ -0,0 +1,7 @@
+package ports
+
+import "errors"
+
+var (
+	ErrInvoiceNotFound			= errors.New("invoice not found")
+)
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/invoice/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	CreateInvoice(ctx context.Context, invoice domain.Invoice) (domain.Invoice, error)
+	GetInvoice(ctx context.Context, id int) (domain.Invoice, error)
+	UpdateInvoice(ctx context.Context, invoice domain.Invoice) (domain.Invoice, error)
+	// ListInvoices returns the project's invoices ordered by PeriodFrom.
+	ListInvoices(ctx context.Context, projectID int) ([]domain.Invoice, error)
+	// NextInvoiceNumber hands out the tenant's next sequence number of year,
+	// starting at one. A number is never handed out twice. The number is taken
+	// within the transaction carried by ctx, so it is only used up if the
+	// invoice it numbers is stored.
+	NextInvoiceNumber(ctx context.Context, tenantID int, year int) (int, error)
+}
@@ -0,0 +1,79 @@
+package render
+
+import (
+	"encoding/json"
+
+	"github.com/captainhbb/tbs-backend/internal/invoice/domain"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+type jsonParty struct {
+	Name		string	`json:"name"`
+	Street		string	`json:"street,omitempty"`
+	City		string	`json:"city,omitempty"`
+	PostalCode	string	`json:"postalCode,omitempty"`
+	Country		string	`json:"country,omitempty"`
+	TaxID		string	`json:"taxId,omitempty"`
+}
+
+type jsonLine struct {
+	Kind		string		`json:"kind"`
+	Description	string		`json:"description"`
+	Quantity	string		`json:"quantity"`
+	Unit		string		`json:"unit"`
+	UnitPrice	money.Money	`json:"unitPrice"`
+	Amount		money.Money	`json:"amount"`
+}
+
+type jsonInvoice struct {
+	Number		string		`json:"number"`
+	Status		string		`json:"status"`
+	ProjectID	int			`json:"projectId"`
+	IssueDate	string		`json:"issueDate"`
+	DueDate		string		`json:"dueDate,omitempty"`
+	PaidDate	string		`json:"paidDate,omitempty"`
+	PeriodStart	string		`json:"periodStart"`
+	PeriodEnd	string		`json:"periodEnd"`
+	Currency	string		`json:"currency"`
+	Seller		jsonParty	`json:"seller"`
+	Buyer		jsonParty	`json:"buyer"`
+	Lines		[]jsonLine	`json:"lines"`
+	Subtotal	money.Money	`json:"subtotal"`
+	TaxPercent	string		`json:"taxPercent"`
+	Tax			money.Money	`json:"tax"`
+	Total		money.Money	`json:"total"`
+}
+
+// JSON encodes the invoice with amounts as decimal strings and dates as
+// YYYY-MM-DD. The period end is the last billed day.
+func JSON(invoice domain.Invoice) ([]byte, error) {
+	document := jsonInvoice{
+		Number: documentID(invoice),
+		Status: invoice.Status,
+		ProjectID: invoice.ProjectID,
+		IssueDate: date(issueDate(invoice)),
+		DueDate: date(invoice.DueDate),
+		PaidDate: date(invoice.PaidAt),
+		PeriodStart: date(invoice.PeriodFrom),
+		PeriodEnd: date(lastDay(invoice)),
+		Currency: invoice.Currency,
+		Seller: jsonParty(invoice.Seller),
+		Buyer: jsonParty(invoice.Buyer),
+		Lines: make([]jsonLine, 0, len(invoice.Lines)),
+		Subtotal: invoice.Subtotal,
+		TaxPercent: percent(invoice.TaxRate),
+		Tax: invoice.Tax,
+		Total: invoice.Total,
+	}
+	for _, line := range invoice.Lines {
+		document.Lines = append(document.Lines, jsonLine{
+			Kind: line.Kind,
+			Description: line.Description,
+			Quantity: quantity(line.Quantity),
+			Unit: line.Unit,
+			UnitPrice: line.UnitPrice,
+			Amount: line.Amount,
+		})
+	}
+	return json.MarshalIndent(document, "", "  ")
+}
@@ -0,0 +1,232 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/captainhbb/tbs-backend/internal/invoice/domain"
+)
+
+// A4 in points, with the margins text is kept within.
+const (
+	pageWidth		= 595
+	pageHeight		= 842
+	marginLeft		= 50
+	marginTop		= 792
+	marginBottom	= 60
+	lineHeight		= 14
+	maxDescription	= 48
+)
+
+// Columns of the line item table.
+const (
+	columnQuantity	= 330
+	columnUnitPrice	= 400
+	columnAmount	= 485
+)
+
+const (
+	fontRegular		= "F1"
+	fontBold		= "F2"
+)
+
+// pdfText is a piece of text placed at x on the line row of a page.
+type pdfText struct {
+	row				int
+	x				int
+	font			string
+	size			int
+	text			string
+}
+
+// pdfLayout lays text out row by row and starts a new page when one is full.
+type pdfLayout struct {
+	pages			[][]pdfText
+	row				int
+}
+
+func(l *pdfLayout) text(x int, font string, size int, text string) {
+	if len(l.pages) == 0 || l.row >= (marginTop - marginBottom) / lineHeight {
+		l.pages = append(l.pages, nil)
+		l.row = 0
+	}
+	page := len(l.pages) - 1
+	l.pages[page] = append(l.pages[page], pdfText{row: l.row, x: x, font: font, size: size, text: text})
+}
+
+func(l *pdfLayout) newline() {
+	l.row++
+}
+
+// PDF renders the invoice as a single-column A4 document using the standard
+// Helvetica fonts, which every PDF reader provides, so nothing is embedded.
+func PDF(invoice domain.Invoice) ([]byte, error) {
+	var layout pdfLayout
+
+	title := "INVOICE"
+	switch invoice.Status {
+	case domain.StatusDraft:
+		title = "DRAFT INVOICE"
+	case domain.StatusVoid:
+		title = "VOID INVOICE"
+	}
+	layout.text(marginLeft, fontBold, 18, title)
+	layout.newline()
+	layout.newline()
+
+	details := [][2]string{
+		{"Invoice number", documentID(invoice)},
+		{"Issue date", date(issueDate(invoice))},
+		{"Due date", date(invoice.DueDate)},
+		{"Period", date(invoice.PeriodFrom) + " to " + date(lastDay(invoice))},
+	}
+	if invoice.Status == domain.StatusPaid {
+		details = append(details, [2]string{"Paid", date(invoice.PaidAt)})
+	}
+	for _, detail := range details {
+		if detail[1] == "" {
+			continue
+		}
+		layout.text(marginLeft, fontBold, 10, detail[0])
+		layout.text(marginLeft + 110, fontRegular, 10, detail[1])
+		layout.newline()
+	}
+	layout.newline()
+
+	seller, buyer := partyLines(invoice.Seller), partyLines(invoice.Buyer)
+	layout.text(marginLeft, fontBold, 10, "From")
+	layout.text(columnQuantity - 30, fontBold, 10, "Bill to")
+	layout.newline()
+	for i := 0; i < len(seller) || i < len(buyer); i++ {
+		if i < len(seller) {
+			layout.text(marginLeft, fontRegular, 10, seller[i])
+		}
+		if i < len(buyer) {
+			layout.text(columnQuantity - 30, fontRegular, 10, buyer[i])
+		}
+		layout.newline()
+	}
+	layout.newline()
+
+	layout.text(marginLeft, fontBold, 10, "Description")
+	layout.text(columnQuantity, fontBold, 10, "Quantity")
+	layout.text(columnUnitPrice, fontBold, 10, "Unit price")
+	layout.text(columnAmount, fontBold, 10, "Amount")
+	layout.newline()
+	for _, line := range invoice.Lines {
+		layout.text(marginLeft, fontRegular, 10, truncate(line.Description, maxDescription))
+		layout.text(columnQuantity, fontRegular, 10, quantity(line.Quantity) + " " + unitLabel(line.Unit))
+		layout.text(columnUnitPrice, fontRegular, 10, line.UnitPrice.Decimal())
+		layout.text(columnAmount, fontRegular, 10, line.Amount.Decimal())
+		layout.newline()
+	}
+	layout.newline()
+
+	totals := [][2]string{
+		{"Subtotal", invoice.Subtotal.Decimal()},
+		{"Tax (" + percent(invoice.TaxRate) + "%)", invoice.Tax.Decimal()},
+		{"Total " + invoice.Currency, invoice.Total.Decimal()},
+	}
+	for i, total := range totals {
+		font := fontRegular
+		if i == len(totals) - 1 {
+			font = fontBold
+		}
+		layout.text(columnUnitPrice, font, 10, total[0])
+		layout.text(columnAmount, font, 10, total[1])
+		layout.newline()
+	}
+
+	return writePDF(layout.pages), nil
+}
+
+// writePDF assembles the pages into a PDF 1.4 file. Objects 1 to 4 are the
+// catalog, the page tree and the two fonts; each page then takes two objects,
+// the page and its content stream.
+func writePDF(pages [][]pdfText) []byte {
+	var buffer bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buffer.Len())
+		fmt.Fprintf(&buffer, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buffer.WriteString("%PDF-1.4\n")
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5 + 2 * i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range pages {
+		var content strings.Builder
+		for _, text := range page {
+			fmt.Fprintf(&content, "BT /%s %d Tf %d %d Td (%s) Tj ET\n", text.font, text.size, text.x, marginTop - text.row * lineHeight, escapePDF(text.text))
+		}
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /%s 3 0 R /%s 4 0 R >> >> /Contents %d 0 R >>", pageWidth, pageHeight, fontRegular, fontBold, 6 + 2 * i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()))
+	}
+
+	xref := buffer.Len()
+	fmt.Fprintf(&buffer, "xref\n0 %d\n0000000000 65535 f \n", len(offsets) + 1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buffer, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buffer, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets) + 1, xref)
+	return buffer.Bytes()
+}
+
+func partyLines(party domain.Party) []string {
+	var lines []string
+	city := strings.TrimSpace(party.PostalCode + " " + party.City)
+	for _, line := range []string{party.Name, party.Street, city, party.Country} {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if party.TaxID != "" {
+		lines = append(lines, "Tax ID " + party.TaxID)
+	}
+	return lines
+}
+
+func unitLabel(unit string) string {
+	if unit == domain.UnitHour {
+		return "h"
+	}
+	return ""
+}
+
+func truncate(text string, length int) string {
+	runes := []rune(text)
+	if len(runes) <= length {
+		return text
+	}
+	return string(runes[:length - 3]) + "..."
+}
+
+// escapePDF makes text safe inside a PDF string literal. The standard fonts
+// are WinAnsi encoded, so characters outside Latin-1 print as question marks.
+func escapePDF(text string) string {
+	var escaped strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			escaped.WriteByte('\\')
+			escaped.WriteRune(r)
+		case r < 0x20:
+			escaped.WriteByte(' ')
+		case r < 0x80:
+			escaped.WriteRune(r)
+		case r <= 0xff:
+			fmt.Fprintf(&escaped, "\\%03o", r)
+		default:
+			escaped.WriteByte('?')
+		}
+	}
+	return escaped.String()
+}
@@ -0,0 +1,85 @@
+// Package render turns invoices into documents that can be sent to clients or
+// imported by their accounting systems. Every format is produced locally.
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/invoice/domain"
+)
+
+const (
+	FormatPDF		= "pdf"
+	FormatJSON		= "json"
+	FormatUBL		= "ubl"
+)
+
+var ErrUnknownFormat = errors.New("unknown invoice format")
+
+// Render encodes invoice in format.
+func Render(invoice domain.Invoice, format string) ([]byte, error) {
+	switch format {
+	case FormatPDF:
+		return PDF(invoice)
+	case FormatJSON:
+		return JSON(invoice)
+	case FormatUBL:
+		return UBL(invoice)
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+}
+
+// ContentType returns the media type of documents rendered in format.
+func ContentType(format string) string {
+	switch format {
+	case FormatPDF:
+		return "application/pdf"
+	case FormatJSON:
+		return "application/json"
+	case FormatUBL:
+		return "application/xml"
+	}
+	return ""
+}
+
+// documentID names the invoice in rendered documents; drafts have no number yet.
+func documentID(invoice domain.Invoice) string {
+	if invoice.Number != "" {
+		return invoice.Number
+	}
+	return "DRAFT-" + strconv.Itoa(invoice.ID)
+}
+
+// issueDate is the date a document is dated with, which for drafts is the
+// date they were created.
+func issueDate(invoice domain.Invoice) time.Time {
+	if !invoice.IssuedAt.IsZero() {
+		return invoice.IssuedAt
+	}
+	return invoice.CreatedAt
+}
+
+// lastDay turns the exclusive end of the invoice period into the last billed
+// day, which is how periods are printed.
+func lastDay(invoice domain.Invoice) time.Time {
+	return invoice.PeriodTo.AddDate(0, 0, -1)
+}
+
+func date(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+func quantity(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
+
+// percent formats a rate in basis points as a percentage, e.g. 1950 as 19.50.
+func percent(basisPoints int64) string {
+	return fmt.Sprintf("%d.%02d", basisPoints / 100, basisPoints % 100)
+}
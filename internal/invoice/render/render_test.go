@@ -0,0 +1,143 @@
+package render_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/invoice/domain"
+	"github.com/captainhbb/tbs-backend/internal/invoice/render"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/stretchr/testify/require"
+)
+
+func newInvoice(lines int) domain.Invoice {
+	invoice := domain.Invoice{
+		ID: 4,
+		Number: "INV-2024-0007",
+		ProjectID: 1,
+		Seller: domain.Party{Name: "Acme (Consulting) GmbH", Street: "Hauptstraße 1", City: "Berlin", PostalCode: "10115", Country: "DE", TaxID: "DE123456789"},
+		Buyer: domain.Party{Name: "Globex", City: "Springfield", Country: "US"},
+		Currency: "EUR",
+		PeriodFrom: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		PeriodTo: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		Subtotal: money.MustNew(0, "EUR"),
+		TaxRate: 1900,
+		Status: domain.StatusIssued,
+		IssuedAt: time.Date(2024, 4, 2, 9, 0, 0, 0, time.UTC),
+		DueDate: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+	}
+	for i := 0; i < lines; i++ {
+		invoice.Lines = append(invoice.Lines, domain.Line{
+			Kind: domain.LineTime,
+			Description: fmt.Sprintf("Services: consultant %d", i + 1),
+			Quantity: 2.5,
+			Unit: domain.UnitHour,
+			UnitPrice: money.MustNew(10000, "EUR"),
+			Amount: money.MustNew(25000, "EUR"),
+		})
+		invoice.Subtotal, _ = invoice.Subtotal.Add(money.MustNew(25000, "EUR"))
+	}
+	invoice.Tax, _ = invoice.Subtotal.Mul(invoice.TaxRate, 10000)
+	invoice.Total, _ = invoice.Subtotal.Add(invoice.Tax)
+	return invoice
+}
+
+func TestJSON(t *testing.T) {
+	t.Parallel()
+
+	document, err := render.Render(newInvoice(2), render.FormatJSON)
+	require.NoError(t, err)
+
+	var decoded struct {
+		Number		string
+		PeriodEnd	string
+		TaxPercent	string
+		Total		money.Money
+		Lines		[]struct {
+			Quantity	string
+		}
+	}
+	require.NoError(t, json.Unmarshal(document, &decoded))
+	require.Equal(t, "INV-2024-0007", decoded.Number)
+	require.Equal(t, "2024-03-31", decoded.PeriodEnd)
+	require.Equal(t, "19.00", decoded.TaxPercent)
+	require.Equal(t, money.MustNew(59500, "EUR"), decoded.Total)
+	require.Len(t, decoded.Lines, 2)
+	require.Equal(t, "2.50", decoded.Lines[0].Quantity)
+}
+
+func TestUBL(t *testing.T) {
+	t.Parallel()
+
+	document, err := render.Render(newInvoice(2), render.FormatUBL)
+	require.NoError(t, err)
+
+	values := make(map[string][]string)
+	decoder := xml.NewDecoder(bytes.NewReader(document))
+	var path []xml.Name
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		switch token := token.(type) {
+		case xml.StartElement:
+			path = append(path, token.Name)
+		case xml.EndElement:
+			path = path[:len(path)-1]
+		case xml.CharData:
+			if text := string(bytes.TrimSpace(token)); text != "" {
+				name := path[len(path)-1]
+				values[name.Space + " " + name.Local] = append(values[name.Space + " " + name.Local], text)
+			}
+		}
+	}
+
+	const cbc = "urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 "
+	require.Equal(t, []string{"INV-2024-0007", "VAT", "S", "VAT", "1", "2"}, values[cbc + "ID"])
+	require.Equal(t, []string{"2024-04-02"}, values[cbc + "IssueDate"])
+	require.Equal(t, []string{"2024-03-31"}, values[cbc + "EndDate"])
+	require.Equal(t, []string{"595.00"}, values[cbc + "PayableAmount"])
+	require.Equal(t, []string{"2.50", "2.50"}, values[cbc + "InvoicedQuantity"])
+	require.Contains(t, string(document), `<cbc:TaxAmount currencyID="EUR">95.00</cbc:TaxAmount>`)
+}
+
+func TestPDF(t *testing.T) {
+	t.Parallel()
+
+	document, err := render.Render(newInvoice(80), render.FormatPDF)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(document, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(document, []byte("%%EOF\n")))
+	require.Contains(t, string(document), "/Count 2")
+	require.Contains(t, string(document), `(Acme \(Consulting\) GmbH)`)
+	require.Contains(t, string(document), `(Hauptstra\337e 1)`)
+
+	// Every cross-reference entry must point at the start of its object.
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(document)
+	require.NotNil(t, startxref)
+	xref, err := strconv.Atoi(string(startxref[1]))
+	require.NoError(t, err)
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(document[xref:], -1)
+	require.Len(t, entries, 8)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(document[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i + 1))))
+	}
+}
+
+func TestUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	_, err := render.Render(newInvoice(1), "docx")
+	require.ErrorIs(t, err, render.ErrUnknownFormat)
+}
@@ -0,0 +1,192 @@
+package render
+
+import (
+	"encoding/xml"
+	"strconv"
+
+	"github.com/captainhbb/tbs-backend/internal/invoice/domain"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+const (
+	ublInvoiceNamespace		= "urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	ublAggregateNamespace	= "urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	ublBasicNamespace		= "urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"
+	// ublCommercialInvoice is the UNTDID 1001 code of a commercial invoice.
+	ublCommercialInvoice	= "380"
+)
+
+type ublAmount struct {
+	CurrencyID	string	`xml:"currencyID,attr"`
+	Value		string	`xml:",chardata"`
+}
+
+type ublQuantity struct {
+	UnitCode	string	`xml:"unitCode,attr"`
+	Value		string	`xml:",chardata"`
+}
+
+type ublTaxScheme struct {
+	ID			string	`xml:"cbc:ID"`
+}
+
+type ublCountry struct {
+	IdentificationCode	string	`xml:"cbc:IdentificationCode,omitempty"`
+}
+
+type ublAddress struct {
+	StreetName	string		`xml:"cbc:StreetName,omitempty"`
+	CityName	string		`xml:"cbc:CityName,omitempty"`
+	PostalZone	string		`xml:"cbc:PostalZone,omitempty"`
+	Country		ublCountry	`xml:"cac:Country"`
+}
+
+type ublPartyTaxScheme struct {
+	CompanyID	string			`xml:"cbc:CompanyID"`
+	TaxScheme	ublTaxScheme	`xml:"cac:TaxScheme"`
+}
+
+type ublParty struct {
+	Name			string				`xml:"cac:PartyName>cbc:Name"`
+	PostalAddress	ublAddress			`xml:"cac:PostalAddress"`
+	PartyTaxScheme	*ublPartyTaxScheme	`xml:"cac:PartyTaxScheme,omitempty"`
+}
+
+type ublPeriod struct {
+	StartDate	string	`xml:"cbc:StartDate"`
+	EndDate		string	`xml:"cbc:EndDate"`
+}
+
+type ublTaxCategory struct {
+	ID			string			`xml:"cbc:ID"`
+	Percent		string			`xml:"cbc:Percent"`
+	TaxScheme	ublTaxScheme	`xml:"cac:TaxScheme"`
+}
+
+type ublTaxSubtotal struct {
+	TaxableAmount	ublAmount		`xml:"cbc:TaxableAmount"`
+	TaxAmount		ublAmount		`xml:"cbc:TaxAmount"`
+	TaxCategory		ublTaxCategory	`xml:"cac:TaxCategory"`
+}
+
+type ublTaxTotal struct {
+	TaxAmount		ublAmount		`xml:"cbc:TaxAmount"`
+	TaxSubtotal		ublTaxSubtotal	`xml:"cac:TaxSubtotal"`
+}
+
+type ublMonetaryTotal struct {
+	LineExtensionAmount		ublAmount	`xml:"cbc:LineExtensionAmount"`
+	TaxExclusiveAmount		ublAmount	`xml:"cbc:TaxExclusiveAmount"`
+	TaxInclusiveAmount		ublAmount	`xml:"cbc:TaxInclusiveAmount"`
+	PayableAmount			ublAmount	`xml:"cbc:PayableAmount"`
+}
+
+type ublLine struct {
+	ID						string		`xml:"cbc:ID"`
+	InvoicedQuantity		ublQuantity	`xml:"cbc:InvoicedQuantity"`
+	LineExtensionAmount		ublAmount	`xml:"cbc:LineExtensionAmount"`
+	ItemName				string		`xml:"cac:Item>cbc:Name"`
+	PriceAmount				ublAmount	`xml:"cac:Price>cbc:PriceAmount"`
+}
+
+type ublInvoice struct {
+	XMLName					xml.Name			`xml:"Invoice"`
+	Namespace				string				`xml:"xmlns,attr"`
+	AggregateNamespace		string				`xml:"xmlns:cac,attr"`
+	BasicNamespace			string				`xml:"xmlns:cbc,attr"`
+	UBLVersionID			string				`xml:"cbc:UBLVersionID"`
+	ID						string				`xml:"cbc:ID"`
+	IssueDate				string				`xml:"cbc:IssueDate"`
+	DueDate					string				`xml:"cbc:DueDate,omitempty"`
+	InvoiceTypeCode			string				`xml:"cbc:InvoiceTypeCode"`
+	DocumentCurrencyCode	string				`xml:"cbc:DocumentCurrencyCode"`
+	InvoicePeriod			ublPeriod			`xml:"cac:InvoicePeriod"`
+	Supplier				ublParty			`xml:"cac:AccountingSupplierParty>cac:Party"`
+	Customer				ublParty			`xml:"cac:AccountingCustomerParty>cac:Party"`
+	TaxTotal				ublTaxTotal			`xml:"cac:TaxTotal"`
+	LegalMonetaryTotal		ublMonetaryTotal	`xml:"cac:LegalMonetaryTotal"`
+	Lines					[]ublLine			`xml:"cac:InvoiceLine"`
+}
+
+// UBL encodes the invoice as an OASIS UBL 2.1 Invoice document with a single
+// VAT category: standard rated, or zero rated when TaxRate is zero.
+func UBL(invoice domain.Invoice) ([]byte, error) {
+	taxCategory := "S"
+	if invoice.TaxRate == 0 {
+		taxCategory = "Z"
+	}
+
+	document := ublInvoice{
+		Namespace: ublInvoiceNamespace,
+		AggregateNamespace: ublAggregateNamespace,
+		BasicNamespace: ublBasicNamespace,
+		UBLVersionID: "2.1",
+		ID: documentID(invoice),
+		IssueDate: date(issueDate(invoice)),
+		DueDate: date(invoice.DueDate),
+		InvoiceTypeCode: ublCommercialInvoice,
+		DocumentCurrencyCode: invoice.Currency,
+		InvoicePeriod: ublPeriod{
+			StartDate: date(invoice.PeriodFrom),
+			EndDate: date(lastDay(invoice)),
+		},
+		Supplier: newUBLParty(invoice.Seller),
+		Customer: newUBLParty(invoice.Buyer),
+		TaxTotal: ublTaxTotal{
+			TaxAmount: newUBLAmount(invoice.Tax),
+			TaxSubtotal: ublTaxSubtotal{
+				TaxableAmount: newUBLAmount(invoice.Subtotal),
+				TaxAmount: newUBLAmount(invoice.Tax),
+				TaxCategory: ublTaxCategory{
+					ID: taxCategory,
+					Percent: percent(invoice.TaxRate),
+					TaxScheme: ublTaxScheme{ID: "VAT"},
+				},
+			},
+		},
+		LegalMonetaryTotal: ublMonetaryTotal{
+			LineExtensionAmount: newUBLAmount(invoice.Subtotal),
+			TaxExclusiveAmount: newUBLAmount(invoice.Subtotal),
+			TaxInclusiveAmount: newUBLAmount(invoice.Total),
+			PayableAmount: newUBLAmount(invoice.Total),
+		},
+	}
+	for i, line := range invoice.Lines {
+		document.Lines = append(document.Lines, ublLine{
+			ID: strconv.Itoa(i + 1),
+			InvoicedQuantity: ublQuantity{UnitCode: line.Unit, Value: quantity(line.Quantity)},
+			LineExtensionAmount: newUBLAmount(line.Amount),
+			ItemName: line.Description,
+			PriceAmount: newUBLAmount(line.UnitPrice),
+		})
+	}
+
+	body, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+func newUBLParty(party domain.Party) ublParty {
+	ubl := ublParty{
+		Name: party.Name,
+		PostalAddress: ublAddress{
+			StreetName: party.Street,
+			CityName: party.City,
+			PostalZone: party.PostalCode,
+			Country: ublCountry{IdentificationCode: party.Country},
+		},
+	}
+	if party.TaxID != "" {
+		ubl.PartyTaxScheme = &ublPartyTaxScheme{
+			CompanyID: party.TaxID,
+			TaxScheme: ublTaxScheme{ID: "VAT"},
+		}
+	}
+	return ubl
+}
+
+func newUBLAmount(amount money.Money) ublAmount {
+	return ublAmount{CurrencyID: amount.Currency(), Value: amount.Decimal()}
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/invoice/domain"
+)
+
+// CreateInvoiceRequest drafts an invoice for the billable work on ProjectID
+// from From (inclusive) to To (exclusive). TaxRate is in basis points.
+type CreateInvoiceRequest struct {
+	ProjectID 				int
+	From 					time.Time
+	To 						time.Time
+	Buyer 					domain.Party
+	TaxRate 				int64
+}
+
+// IssueInvoiceRequest issues a draft that is due DueDays after today, or 30
+// days when DueDays is zero.
+type IssueInvoiceRequest struct {
+	ID 						int
+	DueDays 				int
+}
+
+// MarkInvoicePaidRequest records payment of an issued invoice on PaidAt, or
+// now when PaidAt is zero.
+type MarkInvoicePaidRequest struct {
+	ID 						int
+	PaidAt 					time.Time
+}
+
+type RenderInvoiceRequest struct {
+	ID 						int
+	Format 					string
+}
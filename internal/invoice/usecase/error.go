@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvoiceNotFound			= errors.New("invoice not found")
+	ErrProjectNotFound			= errors.New("project not found")
+	ErrInvalidPeriod			= errors.New("an invoice period needs a start before its end")
+	ErrInvalidTaxRate			= errors.New("tax rate must be between 0 and 10000 basis points")
+	ErrBuyerRequired			= errors.New("an invoice needs a buyer name")
+	ErrInvalidDueDays			= errors.New("due days must not be negative")
+	ErrPeriodInvoiced			= errors.New("part of this period is already invoiced")
+	ErrNothingToInvoice			= errors.New("no billable time or expenses in this period")
+	ErrNotDraft					= errors.New("only draft invoices can be issued")
+	ErrNotIssued				= errors.New("only issued invoices can be paid")
+	ErrCannotVoid				= errors.New("paid and void invoices cannot be voided")
+	ErrUnratedTime				= errors.New("billable time has no billing rate")
+)
+
+// UnratedTimeError lists the billable time entries of an invoice period that
+// no rate applies to, so they cannot be billed. It matches ErrUnratedTime.
+type UnratedTimeError struct {
+	EntryIDs []int
+}
+
+func (e *UnratedTimeError) Error() string {
+	ids := make([]string, 0, len(e.EntryIDs))
+	for _, id := range e.EntryIDs {
+		ids = append(ids, strconv.Itoa(id))
+	}
+	return fmt.Sprintf("%s: entries %s", ErrUnratedTime, strings.Join(ids, ", "))
+}
+
+func (e *UnratedTimeError) Is(target error) bool {
+	return target == ErrUnratedTime
+}
@@ -0,0 +1,382 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	costingUseCase "github.com/captainhbb/tbs-backend/internal/costing/usecase"
+	exchangeUseCase "github.com/captainhbb/tbs-backend/internal/exchange/usecase"
+	expensePorts "github.com/captainhbb/tbs-backend/internal/expense/ports"
+	"github.com/captainhbb/tbs-backend/internal/invoice/domain"
+	"github.com/captainhbb/tbs-backend/internal/invoice/ports"
+	"github.com/captainhbb/tbs-backend/internal/invoice/render"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
+)
+
+const defaultDueDays = 30
+
+// hundredthHour is the smallest amount of time billed on an invoice.
+const hundredthHour = time.Hour / 100
+
+//go:generate mockery --dir . --name InvoiceService --structname MockInvoiceService --filename mock_invoice_service.go --output ./mock --outpkg mock
+type InvoiceService interface {
+	// CreateInvoice drafts an invoice of the billable time on approved
+	// timesheets and the billable expenses in the period.
+	CreateInvoice(ctx context.Context, invoice CreateInvoiceRequest) (domain.Invoice, error)
+	GetInvoice(ctx context.Context, id int) (domain.Invoice, error)
+	ListInvoices(ctx context.Context, projectID int) ([]domain.Invoice, error)
+	// IssueInvoice numbers a draft in its tenant's sequence for the current
+	// UTC year and fixes its due date.
+	IssueInvoice(ctx context.Context, issue IssueInvoiceRequest) (domain.Invoice, error)
+	MarkInvoicePaid(ctx context.Context, payment MarkInvoicePaidRequest) (domain.Invoice, error)
+	// VoidInvoice cancels a draft or issued invoice. Its period can then be
+	// invoiced again.
+	VoidInvoice(ctx context.Context, id int) (domain.Invoice, error)
+	// RenderInvoice encodes an invoice in one of the render formats.
+	RenderInvoice(ctx context.Context, renderRequest RenderInvoiceRequest) ([]byte, error)
+}
+
+type invoiceService struct {
+	repo ports.Repository
+	projectRepo projectPorts.Repository
+	expenseRepo expensePorts.Repository
+	costingService costingUseCase.CostingService
+	exchangeService exchangeUseCase.ExchangeService
+	userService userUseCase.UserService
+	membershipService membershipUseCase.MembershipService
+	seller domain.Party
+	transactions transaction.Manager
+}
+
+// New returns an InvoiceService that bills on behalf of seller.
+func New(repo ports.Repository, projectRepo projectPorts.Repository, expenseRepo expensePorts.Repository, costingService costingUseCase.CostingService, exchangeService exchangeUseCase.ExchangeService, userService userUseCase.UserService, membershipService membershipUseCase.MembershipService, seller domain.Party, transactions transaction.Manager) InvoiceService {
+	return &invoiceService{
+		repo: repo,
+		projectRepo: projectRepo,
+		expenseRepo: expenseRepo,
+		costingService: costingService,
+		exchangeService: exchangeService,
+		userService: userService,
+		membershipService: membershipService,
+		seller: seller,
+		transactions: transactions,
+	}
+}
+
+func(s *invoiceService) CreateInvoice(ctx context.Context, createInvoiceRequest CreateInvoiceRequest) (domain.Invoice, error) {
+	from, to := createInvoiceRequest.From, createInvoiceRequest.To
+	if from.IsZero() || !from.Before(to) {
+		return domain.Invoice{}, ErrInvalidPeriod
+	}
+	if createInvoiceRequest.TaxRate < 0 || createInvoiceRequest.TaxRate > 10000 {
+		return domain.Invoice{}, ErrInvalidTaxRate
+	}
+	if strings.TrimSpace(createInvoiceRequest.Buyer.Name) == "" {
+		return domain.Invoice{}, ErrBuyerRequired
+	}
+
+	err := s.membershipService.Authorize(ctx, createInvoiceRequest.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	project, err := s.getProject(ctx, createInvoiceRequest.ProjectID)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+
+	invoices, err := s.repo.ListInvoices(ctx, project.ID)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	for _, invoice := range invoices {
+		if invoice.Covers(from, to) {
+			return domain.Invoice{}, ErrPeriodInvoiced
+		}
+	}
+
+	currency := project.ProposedBudget.Currency()
+	timeLines, err := s.timeLines(ctx, project.ID, from, to)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	expenseLines, err := s.expenseLines(ctx, project.ID, from, to, currency)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	lines := append(timeLines, expenseLines...)
+	if len(lines) == 0 {
+		return domain.Invoice{}, ErrNothingToInvoice
+	}
+
+	subtotal := money.MustNew(0, currency)
+	for _, line := range lines {
+		subtotal, err = subtotal.Add(line.Amount)
+		if err != nil {
+			return domain.Invoice{}, err
+		}
+	}
+	tax, err := subtotal.Mul(createInvoiceRequest.TaxRate, 10000)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	total, err := subtotal.Add(tax)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+
+	return s.repo.CreateInvoice(ctx, domain.Invoice{
+		ProjectID: project.ID,
+		Seller: s.seller,
+		Buyer: createInvoiceRequest.Buyer,
+		Currency: currency,
+		PeriodFrom: from,
+		PeriodTo: to,
+		Lines: lines,
+		Subtotal: subtotal,
+		TaxRate: createInvoiceRequest.TaxRate,
+		Tax: tax,
+		Total: total,
+		Status: domain.StatusDraft,
+		CreatedAt: time.Now(),
+	})
+}
+
+func(s *invoiceService) GetInvoice(ctx context.Context, id int) (domain.Invoice, error) {
+	return s.invoice(ctx, id, membershipDomain.PermissionView)
+}
+
+func(s *invoiceService) ListInvoices(ctx context.Context, projectID int) ([]domain.Invoice, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListInvoices(ctx, projectID)
+}
+
+func(s *invoiceService) IssueInvoice(ctx context.Context, issueRequest IssueInvoiceRequest) (domain.Invoice, error) {
+	if issueRequest.DueDays < 0 {
+		return domain.Invoice{}, ErrInvalidDueDays
+	}
+	dueDays := issueRequest.DueDays
+	if dueDays == 0 {
+		dueDays = defaultDueDays
+	}
+
+	invoice, err := s.invoice(ctx, issueRequest.ID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	if invoice.Status != domain.StatusDraft {
+		return domain.Invoice{}, ErrNotDraft
+	}
+
+	project, err := s.getProject(ctx, invoice.ProjectID)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+
+	now := time.Now().UTC()
+	year, month, day := now.Date()
+	invoice.Status = domain.StatusIssued
+	invoice.IssuedAt = now
+	invoice.DueDate = time.Date(year, month, day + dueDays, 0, 0, 0, 0, time.UTC)
+
+	var issuedInvoice domain.Invoice
+	err = s.transactions.Do(ctx, func(ctx context.Context) error {
+		sequence, err := s.repo.NextInvoiceNumber(ctx, project.TenantID, year)
+		if err != nil {
+			return err
+		}
+		invoice.Number = domain.FormatNumber(year, sequence)
+		issuedInvoice, err = s.updateInvoice(ctx, invoice)
+		return err
+	})
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	return issuedInvoice, nil
+}
+
+func(s *invoiceService) MarkInvoicePaid(ctx context.Context, paymentRequest MarkInvoicePaidRequest) (domain.Invoice, error) {
+	invoice, err := s.invoice(ctx, paymentRequest.ID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	if invoice.Status != domain.StatusIssued {
+		return domain.Invoice{}, ErrNotIssued
+	}
+
+	invoice.Status = domain.StatusPaid
+	invoice.PaidAt = paymentRequest.PaidAt
+	if invoice.PaidAt.IsZero() {
+		invoice.PaidAt = time.Now()
+	}
+	return s.updateInvoice(ctx, invoice)
+}
+
+func(s *invoiceService) VoidInvoice(ctx context.Context, id int) (domain.Invoice, error) {
+	invoice, err := s.invoice(ctx, id, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	if invoice.Status != domain.StatusDraft && invoice.Status != domain.StatusIssued {
+		return domain.Invoice{}, ErrCannotVoid
+	}
+
+	invoice.Status = domain.StatusVoid
+	invoice.VoidedAt = time.Now()
+	return s.updateInvoice(ctx, invoice)
+}
+
+func(s *invoiceService) RenderInvoice(ctx context.Context, renderRequest RenderInvoiceRequest) ([]byte, error) {
+	invoice, err := s.invoice(ctx, renderRequest.ID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return render.Render(invoice, renderRequest.Format)
+}
+
+// timeLines bills each user's billable time at their billing rates, one line
+// per user. The time is billed in hundredths of an hour at the user's average
+// rate, and the line amount is worked out from those two. Billable time no
+// rate applies to fails the invoice rather than go unbilled.
+func(s *invoiceService) timeLines(ctx context.Context, projectID int, from time.Time, to time.Time) ([]domain.Line, error) {
+	laborCost, err := s.costingService.ProjectLaborCost(ctx, costingUseCase.LaborCostRequest{
+		ProjectID: projectID,
+		From: from,
+		To: to,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(laborCost.UnratedBillable) > 0 {
+		return nil, &UnratedTimeError{EntryIDs: laborCost.UnratedBillable}
+	}
+
+	var lines []domain.Line
+	for _, userCost := range laborCost.ByUser {
+		if userCost.BillableDuration == 0 || userCost.Billable.IsZero() {
+			continue
+		}
+
+		name := fmt.Sprintf("user %d", userCost.UserID)
+		user, err := s.userService.GetUser(ctx, userCost.UserID)
+		switch err {
+		case nil:
+			name = strings.TrimSpace(user.FirstName + " " + user.LastName)
+		case userUseCase.ErrUserNotFound:
+		default:
+			return nil, err
+		}
+
+		hundredths := int64((userCost.BillableDuration + hundredthHour / 2) / hundredthHour)
+		if hundredths == 0 {
+			continue
+		}
+		unitPrice, err := userCost.Billable.Mul(int64(time.Hour), int64(userCost.BillableDuration))
+		if err != nil {
+			return nil, err
+		}
+		amount, err := unitPrice.Mul(hundredths, 100)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, domain.Line{
+			Kind: domain.LineTime,
+			Description: "Services: " + name,
+			Quantity: float64(hundredths) / 100,
+			Unit: domain.UnitHour,
+			UnitPrice: unitPrice,
+			Amount: amount,
+		})
+	}
+	return lines, nil
+}
+
+// expenseLines passes each billable expense in the period on at cost,
+// converted into currency on the day it was spent.
+func(s *invoiceService) expenseLines(ctx context.Context, projectID int, from time.Time, to time.Time, currency string) ([]domain.Line, error) {
+	expenses, err := s.expenseRepo.ListExpenses(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []domain.Line
+	for _, expense := range expenses {
+		if !expense.Billable || expense.Date.Before(from) || !expense.Date.Before(to) {
+			continue
+		}
+
+		amount := expense.Amount
+		if amount.Currency() != currency {
+			conversion, err := s.exchangeService.Convert(ctx, exchangeUseCase.ConvertRequest{
+				Amount: amount,
+				Currency: currency,
+				On: expense.Date,
+			})
+			if err != nil {
+				return nil, err
+			}
+			amount = conversion.Converted
+		}
+
+		description := "Expense: " + expense.Category
+		if expense.ReceiptRef != "" {
+			description += " (" + expense.ReceiptRef + ")"
+		}
+		lines = append(lines, domain.Line{
+			Kind: domain.LineExpense,
+			Description: description,
+			Quantity: 1,
+			Unit: domain.UnitPiece,
+			UnitPrice: amount,
+			Amount: amount,
+		})
+	}
+	return lines, nil
+}
+
+// invoice loads an invoice the acting user holds permission on through its
+// project.
+func(s *invoiceService) invoice(ctx context.Context, id int, permission string) (domain.Invoice, error) {
+	invoice, err := s.repo.GetInvoice(ctx, id)
+	switch err {
+	case nil:
+	case ports.ErrInvoiceNotFound:
+		return domain.Invoice{}, ErrInvoiceNotFound
+	default:
+		return domain.Invoice{}, err
+	}
+
+	err = s.membershipService.Authorize(ctx, invoice.ProjectID, permission)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	return invoice, nil
+}
+
+func(s *invoiceService) updateInvoice(ctx context.Context, invoice domain.Invoice) (domain.Invoice, error) {
+	updatedInvoice, err := s.repo.UpdateInvoice(ctx, invoice)
+	switch err {
+	case ports.ErrInvoiceNotFound:
+		return domain.Invoice{}, ErrInvoiceNotFound
+	}
+	return updatedInvoice, err
+}
+
+func(s *invoiceService) getProject(ctx context.Context, projectID int) (projectDomain.Project, error) {
+	project, err := s.projectRepo.GetProject(ctx, projectID)
+	switch err {
+	case projectPorts.ErrProjectNotFound:
+		return projectDomain.Project{}, ErrProjectNotFound
+	}
+	return project, err
+}
@@ -0,0 +1,286 @@
+package usecase_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	costingDomain "github.com/captainhbb/tbs-backend/internal/costing/domain"
+	costingUseCase "github.com/captainhbb/tbs-backend/internal/costing/usecase"
+	costingUseCaseMock "github.com/captainhbb/tbs-backend/internal/costing/usecase/mock"
+	exchangeDomain "github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	exchangeUseCaseMock "github.com/captainhbb/tbs-backend/internal/exchange/usecase/mock"
+	expenseDomain "github.com/captainhbb/tbs-backend/internal/expense/domain"
+	expensePortsMock "github.com/captainhbb/tbs-backend/internal/expense/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/invoice/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/invoice/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/invoice/usecase"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	march = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	april = time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	seller = domain.Party{Name: "Acme", Country: "DE"}
+	buyer = domain.Party{Name: "Globex", Country: "US"}
+)
+
+type mocks struct {
+	repo *portsMock.MockRepository
+	expenseRepo *expensePortsMock.MockRepository
+	costingService *costingUseCaseMock.MockCostingService
+	exchangeService *exchangeUseCaseMock.MockExchangeService
+	userService *userUseCaseMock.MockUserService
+}
+
+func newService(t *testing.T) (usecase.InvoiceService, mocks) {
+	m := mocks{
+		repo: portsMock.NewMockRepository(t),
+		expenseRepo: expensePortsMock.NewMockRepository(t),
+		costingService: costingUseCaseMock.NewMockCostingService(t),
+		exchangeService: exchangeUseCaseMock.NewMockExchangeService(t),
+		userService: userUseCaseMock.NewMockUserService(t),
+	}
+	projectRepoMock := projectPortsMock.NewMockRepository(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	projectRepoMock.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, TenantID: 5, ProposedBudget: money.MustNew(10000000, "EUR")}, nil).Maybe()
+	membershipServiceMock.On("Authorize", mock.Anything, 1, mock.Anything).Return(nil).Maybe()
+
+	service := usecase.New(m.repo, projectRepoMock, m.expenseRepo, m.costingService, m.exchangeService, m.userService, membershipServiceMock, seller, transaction.None())
+	return service, m
+}
+
+func TestCreateInvoice(t *testing.T) {
+	t.Parallel()
+
+	laborCost := costingDomain.LaborCost{
+		ProjectID: 1,
+		ByUser: []costingDomain.UserLaborCost{
+			{UserID: 2, Duration: 12 * time.Hour, BillableDuration: 10 * time.Hour, Billable: money.MustNew(100000, "EUR")},
+			{UserID: 3, Duration: 4 * time.Hour, Billable: money.MustNew(0, "EUR")},
+		},
+	}
+	expenses := []expenseDomain.Expense{
+		{ID: 1, ProjectID: 1, Category: "travel", Amount: money.MustNew(20000, "USD"), Date: march.AddDate(0, 0, 3), ReceiptRef: "R-1", Billable: true},
+		{ID: 2, ProjectID: 1, Category: "hardware", Amount: money.MustNew(50000, "EUR"), Date: march.AddDate(0, 0, 4)},
+		{ID: 3, ProjectID: 1, Category: "travel", Amount: money.MustNew(5000, "EUR"), Date: april, Billable: true},
+	}
+
+	tests := []struct {
+		name string
+		input usecase.CreateInvoiceRequest
+		mockSetup func(m mocks)
+		expectedLines []domain.Line
+		expectedTotal money.Money
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "time and expenses",
+			input: usecase.CreateInvoiceRequest{ProjectID: 1, From: march, To: april, Buyer: buyer, TaxRate: 1900},
+			mockSetup: func(m mocks) {
+				m.repo.On("ListInvoices", mock.Anything, 1).Return([]domain.Invoice{
+					{ID: 1, ProjectID: 1, PeriodFrom: march.AddDate(0, -1, 0), PeriodTo: march, Status: domain.StatusIssued},
+					{ID: 2, ProjectID: 1, PeriodFrom: march, PeriodTo: april, Status: domain.StatusVoid},
+				}, nil)
+				m.costingService.On("ProjectLaborCost", mock.Anything, costingUseCase.LaborCostRequest{ProjectID: 1, From: march, To: april}).Return(laborCost, nil)
+				m.userService.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, FirstName: "Ada", LastName: "Lovelace"}, nil)
+				m.expenseRepo.On("ListExpenses", mock.Anything, 1).Return(expenses, nil)
+				m.exchangeService.On("Convert", mock.Anything, mock.Anything).Return(exchangeDomain.Conversion{Converted: money.MustNew(18000, "EUR"), Rate: big.NewRat(9, 10)}, nil)
+				m.repo.On("CreateInvoice", mock.Anything, mock.Anything).Return(func(_ context.Context, invoice domain.Invoice) (domain.Invoice, error) {
+					invoice.ID = 3
+					return invoice, nil
+				})
+			},
+			expectedLines: []domain.Line{
+				{Kind: domain.LineTime, Description: "Services: Ada Lovelace", Quantity: 10, Unit: domain.UnitHour, UnitPrice: money.MustNew(10000, "EUR"), Amount: money.MustNew(100000, "EUR")},
+				{Kind: domain.LineExpense, Description: "Expense: travel (R-1)", Quantity: 1, Unit: domain.UnitPiece, UnitPrice: money.MustNew(18000, "EUR"), Amount: money.MustNew(18000, "EUR")},
+			},
+			expectedTotal: money.MustNew(140420, "EUR"),
+		},
+		{
+			name: "time billed in hundredths of an hour",
+			input: usecase.CreateInvoiceRequest{ProjectID: 1, From: march, To: april, Buyer: buyer},
+			mockSetup: func(m mocks) {
+				m.repo.On("ListInvoices", mock.Anything, 1).Return([]domain.Invoice{}, nil)
+				m.costingService.On("ProjectLaborCost", mock.Anything, mock.Anything).Return(costingDomain.LaborCost{
+					ProjectID: 1,
+					ByUser: []costingDomain.UserLaborCost{
+						{UserID: 2, Duration: 80 * time.Minute, BillableDuration: 80 * time.Minute, Billable: money.MustNew(10000, "EUR")},
+					},
+				}, nil)
+				m.userService.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, FirstName: "Ada", LastName: "Lovelace"}, nil)
+				m.expenseRepo.On("ListExpenses", mock.Anything, 1).Return([]expenseDomain.Expense{}, nil)
+				m.repo.On("CreateInvoice", mock.Anything, mock.Anything).Return(func(_ context.Context, invoice domain.Invoice) (domain.Invoice, error) {
+					invoice.ID = 3
+					return invoice, nil
+				})
+			},
+			expectedLines: []domain.Line{
+				{Kind: domain.LineTime, Description: "Services: Ada Lovelace", Quantity: 1.33, Unit: domain.UnitHour, UnitPrice: money.MustNew(7500, "EUR"), Amount: money.MustNew(9975, "EUR")},
+			},
+			expectedTotal: money.MustNew(9975, "EUR"),
+		},
+		{
+			name: "billable time without a rate",
+			input: usecase.CreateInvoiceRequest{ProjectID: 1, From: march, To: april, Buyer: buyer},
+			mockSetup: func(m mocks) {
+				m.repo.On("ListInvoices", mock.Anything, 1).Return([]domain.Invoice{}, nil)
+				m.costingService.On("ProjectLaborCost", mock.Anything, mock.Anything).Return(costingDomain.LaborCost{
+					ProjectID: 1,
+					ByUser: laborCost.ByUser,
+					Unrated: []int{7, 8},
+					UnratedBillable: []int{8},
+				}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrUnratedTime,
+		},
+		{
+			name: "period already invoiced",
+			input: usecase.CreateInvoiceRequest{ProjectID: 1, From: march, To: april, Buyer: buyer},
+			mockSetup: func(m mocks) {
+				m.repo.On("ListInvoices", mock.Anything, 1).Return([]domain.Invoice{
+					{ID: 1, ProjectID: 1, PeriodFrom: march.AddDate(0, 0, 14), PeriodTo: april.AddDate(0, 0, 14), Status: domain.StatusDraft},
+				}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrPeriodInvoiced,
+		},
+		{
+			name: "nothing billable",
+			input: usecase.CreateInvoiceRequest{ProjectID: 1, From: march, To: april, Buyer: buyer},
+			mockSetup: func(m mocks) {
+				m.repo.On("ListInvoices", mock.Anything, 1).Return([]domain.Invoice{}, nil)
+				m.costingService.On("ProjectLaborCost", mock.Anything, mock.Anything).Return(costingDomain.LaborCost{ProjectID: 1}, nil)
+				m.expenseRepo.On("ListExpenses", mock.Anything, 1).Return([]expenseDomain.Expense{}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrNothingToInvoice,
+		},
+		{
+			name: "empty period",
+			input: usecase.CreateInvoiceRequest{ProjectID: 1, From: april, To: april, Buyer: buyer},
+			mockSetup: func(_ mocks) {},
+			expectError: true,
+			expectedError: usecase.ErrInvalidPeriod,
+		},
+		{
+			name: "tax rate above 100%",
+			input: usecase.CreateInvoiceRequest{ProjectID: 1, From: march, To: april, Buyer: buyer, TaxRate: 10001},
+			mockSetup: func(_ mocks) {},
+			expectError: true,
+			expectedError: usecase.ErrInvalidTaxRate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newService(t)
+			tt.mockSetup(m)
+
+			invoice, err := service.CreateInvoice(context.Background(), tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, domain.StatusDraft, invoice.Status)
+				require.Equal(t, seller, invoice.Seller)
+				require.Equal(t, tt.expectedLines, invoice.Lines)
+				require.Equal(t, tt.expectedTotal, invoice.Total)
+			}
+		})
+	}
+}
+
+func TestIssueInvoice(t *testing.T) {
+	t.Parallel()
+
+	service, m := newService(t)
+	m.repo.On("GetInvoice", mock.Anything, 3).Return(domain.Invoice{ID: 3, ProjectID: 1, Status: domain.StatusDraft}, nil)
+	m.repo.On("NextInvoiceNumber", mock.Anything, 5, time.Now().UTC().Year()).Return(7, nil)
+	m.repo.On("UpdateInvoice", mock.Anything, mock.Anything).Return(func(_ context.Context, invoice domain.Invoice) (domain.Invoice, error) {
+		return invoice, nil
+	})
+
+	invoice, err := service.IssueInvoice(context.Background(), usecase.IssueInvoiceRequest{ID: 3, DueDays: 14})
+	require.NoError(t, err)
+	require.Equal(t, domain.StatusIssued, invoice.Status)
+	require.Equal(t, domain.FormatNumber(time.Now().UTC().Year(), 7), invoice.Number)
+	year, month, day := invoice.IssuedAt.UTC().Date()
+	require.Equal(t, time.Date(year, month, day + 14, 0, 0, 0, 0, time.UTC), invoice.DueDate)
+}
+
+func TestInvoiceStatusTransitions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		status string
+		change func(service usecase.InvoiceService) error
+		expectedError error
+	}{
+		{
+			name: "issue an issued invoice",
+			status: domain.StatusIssued,
+			change: func(service usecase.InvoiceService) error {
+				_, err := service.IssueInvoice(context.Background(), usecase.IssueInvoiceRequest{ID: 3})
+				return err
+			},
+			expectedError: usecase.ErrNotDraft,
+		},
+		{
+			name: "pay a draft",
+			status: domain.StatusDraft,
+			change: func(service usecase.InvoiceService) error {
+				_, err := service.MarkInvoicePaid(context.Background(), usecase.MarkInvoicePaidRequest{ID: 3})
+				return err
+			},
+			expectedError: usecase.ErrNotIssued,
+		},
+		{
+			name: "void a paid invoice",
+			status: domain.StatusPaid,
+			change: func(service usecase.InvoiceService) error {
+				_, err := service.VoidInvoice(context.Background(), 3)
+				return err
+			},
+			expectedError: usecase.ErrCannotVoid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newService(t)
+			m.repo.On("GetInvoice", mock.Anything, 3).Return(domain.Invoice{ID: 3, ProjectID: 1, Status: tt.status}, nil)
+
+			err := tt.change(service)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestRenderInvoiceChecksAccess(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	service := usecase.New(repoMock, projectPortsMock.NewMockRepository(t), expensePortsMock.NewMockRepository(t), costingUseCaseMock.NewMockCostingService(t), exchangeUseCaseMock.NewMockExchangeService(t), userUseCaseMock.NewMockUserService(t), membershipServiceMock, seller, transaction.None())
+
+	repoMock.On("GetInvoice", mock.Anything, 3).Return(domain.Invoice{ID: 3, ProjectID: 2}, nil)
+	membershipServiceMock.On("Authorize", mock.Anything, 2, membershipDomain.PermissionView).Return(membershipUseCase.ErrForbidden)
+
+	_, err := service.RenderInvoice(context.Background(), usecase.RenderInvoiceRequest{ID: 3, Format: "pdf"})
+	require.ErrorIs(t, err, membershipUseCase.ErrForbidden)
+}
@@ -0,0 +1,98 @@
+// Package scoped confines a membership repository to the tenant carried in the
+// request context, through the project each membership belongs to. Members of
+// projects in other tenants are reported as not found. Platform calls see every
+// tenant, and requests carrying neither see no members at all.
+package scoped
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/membership/domain"
+	"github.com/captainhbb/tbs-backend/internal/membership/ports"
+	projectScoped "github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+)
+
+type repository struct {
+	next			ports.Repository
+	projectRepo		projectPorts.Repository
+}
+
+// New wraps next so that every query is confined to the projects of the
+// tenant in ctx, which projectRepo looks up.
+func New(next ports.Repository, projectRepo projectPorts.Repository) ports.Repository {
+	return &repository{next: next, projectRepo: projectRepo}
+}
+
+func(r *repository) AddMember(ctx context.Context, member domain.Member) (domain.Member, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, member.ProjectID)
+	if err != nil {
+		return domain.Member{}, err
+	}
+	return r.next.AddMember(ctx, member)
+}
+
+func(r *repository) GetMember(ctx context.Context, projectID int, userID int) (domain.Member, error) {
+	err := r.checkProject(ctx, projectID)
+	if err != nil {
+		return domain.Member{}, err
+	}
+	return r.next.GetMember(ctx, projectID, userID)
+}
+
+func(r *repository) UpdateMember(ctx context.Context, member domain.Member) (domain.Member, error) {
+	err := r.checkProject(ctx, member.ProjectID)
+	if err != nil {
+		return domain.Member{}, err
+	}
+	return r.next.UpdateMember(ctx, member)
+}
+
+func(r *repository) RemoveMember(ctx context.Context, projectID int, userID int) error {
+	err := r.checkProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	return r.next.RemoveMember(ctx, projectID, userID)
+}
+
+func(r *repository) ListMembers(ctx context.Context, projectID int) ([]domain.Member, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListMembers(ctx, projectID)
+}
+
+// ListMembershipsByUser leaves out the user's memberships of projects in
+// other tenants.
+func(r *repository) ListMembershipsByUser(ctx context.Context, userID int) ([]domain.Member, error) {
+	members, err := r.next.ListMembershipsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]domain.Member, 0, len(members))
+	for _, member := range members {
+		err := projectScoped.CheckProject(ctx, r.projectRepo, member.ProjectID)
+		switch err {
+		case nil:
+			visible = append(visible, member)
+		case projectPorts.ErrProjectNotFound:
+		default:
+			return nil, err
+		}
+	}
+	return visible, nil
+}
+
+// checkProject fails with ErrMemberNotFound unless the project is visible
+// from ctx.
+func(r *repository) checkProject(ctx context.Context, projectID int) error {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, projectID)
+	switch err {
+	case projectPorts.ErrProjectNotFound:
+		return ports.ErrMemberNotFound
+	}
+	return err
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	RoleManager				= "manager"
+	RoleContributor			= "contributor"
+	RoleViewer				= "viewer"
+)
+
+const (
+	PermissionView			= "view"
+	PermissionEdit			= "edit"
+	PermissionManageMembers	= "manage_members"
+	// PermissionAdminister covers deleting a project and handing it over. Only the
+	// owner and global admins hold it; no member role grants it.
+	PermissionAdminister	= "administer"
+)
+
+var rolePermissions = map[string]map[string]bool{
+	RoleManager: {PermissionView: true, PermissionEdit: true, PermissionManageMembers: true},
+	RoleContributor: {PermissionView: true, PermissionEdit: true},
+	RoleViewer: {PermissionView: true},
+}
+
+var roleRanks = map[string]int{
+	RoleViewer: 1,
+	RoleContributor: 2,
+	RoleManager: 3,
+}
+
+type Member struct {
+	ProjectID			int
+	UserID				int
+	Role				string
+	// TeamID is the team the membership is held through, kept in sync with the
+	// team's grants and members. It is zero for members added directly.
+	TeamID				int
+	AddedAt				time.Time
+}
+
+func IsValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+func HasPermission(role string, permission string) bool {
+	return rolePermissions[role][permission]
+}
+
+// StrongerRole returns whichever of the two roles grants more; an empty role
+// grants nothing.
+func StrongerRole(role string, other string) string {
+	if roleRanks[other] > roleRanks[role] {
+		return other
+	}
+	return role
+}
@@ -0,0 +1,8 @@
+package ports
+
+import "errors"
+
+var (
+	ErrMemberNotFound			= errors.New("member not found")
+	ErrMemberAlreadyExists		= errors.New("member already exists")
+)
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/membership/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	AddMember(ctx context.Context, member domain.Member) (domain.Member, error)
+	GetMember(ctx context.Context, projectID int, userID int) (domain.Member, error)
+	UpdateMember(ctx context.Context, member domain.Member) (domain.Member, error)
+	RemoveMember(ctx context.Context, projectID int, userID int) error
+	ListMembers(ctx context.Context, projectID int) ([]domain.Member, error)
+	ListMembershipsByUser(ctx context.Context, userID int) ([]domain.Member, error)
+}
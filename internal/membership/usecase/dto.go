@@ -0,0 +1,18 @@
+package usecase
+
+type AddMemberRequest struct {
+	ProjectID		int
+	UserID			int
+	Role			string
+}
+
+type UpdateMemberRoleRequest struct {
+	ProjectID		int
+	UserID			int
+	Role			string
+}
+
+type RemoveMemberRequest struct {
+	ProjectID		int
+	UserID			int
+}
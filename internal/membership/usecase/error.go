@@ -0,0 +1,14 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrInvalidRole				= errors.New("invalid project role")
+	ErrMemberNotFound			= errors.New("member not found")
+	ErrMemberAlreadyExists		= errors.New("user is already a member of the project")
+	ErrOwnerCannotBeMember		= errors.New("project owner cannot be added as a member")
+	ErrUserNotFound				= errors.New("user not found")
+	ErrProjectNotFound			= errors.New("project not found")
+	ErrForbidden				= errors.New("not allowed to perform this action on the project")
+	ErrTeamMembership			= errors.New("membership is held through a team and follows the team's grants")
+)
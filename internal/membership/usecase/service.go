@@ -0,0 +1,499 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/membership/domain"
+	"github.com/captainhbb/tbs-backend/internal/membership/ports"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	portfolioPorts "github.com/captainhbb/tbs-backend/internal/portfolio/ports"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	teamPorts "github.com/captainhbb/tbs-backend/internal/team/ports"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+)
+
+//go:generate mockery --dir . --name MembershipService --structname MockMembershipService --filename mock_membership_service.go --output ./mock --outpkg mock
+type MembershipService interface {
+	AddMember(ctx context.Context, member AddMemberRequest) (domain.Member, error)
+	UpdateMemberRole(ctx context.Context, member UpdateMemberRoleRequest) (domain.Member, error)
+	// RemoveMember removes a membership added directly. The user keeps the role
+	// their teams are granted on the project, if any.
+	RemoveMember(ctx context.Context, member RemoveMemberRequest) error
+	ListMembers(ctx context.Context, projectID int) ([]domain.Member, error)
+	// ListUserProjects returns the projects the user owns or is a member of.
+	// Users other than userID, global admins and the system only see those
+	// they may view themselves.
+	ListUserProjects(ctx context.Context, userID int) ([]projectDomain.Project, error)
+	// Authorize checks that the user carried in ctx holds permission on the project.
+	// Requests without an acting user are denied; internal callers act as the
+	// system, which is always allowed. Members hold
+	// the stronger of their own role and the roles granted to their teams.
+	// Managers of the project's portfolio, or of any portfolio above it, may
+	// view it.
+	Authorize(ctx context.Context, projectID int, permission string) error
+	// FilterVisible returns the projects the user carried in ctx may view, on
+	// the terms of Authorize. The user's memberships, teams and portfolios are
+	// looked up once for all projects rather than for each.
+	FilterVisible(ctx context.Context, projects []projectDomain.Project) ([]projectDomain.Project, error)
+	// SyncMember brings the user's membership of the project in line with the
+	// grants of the teams they belong to: a membership held through teams is
+	// added, moved to the strongest granted role or removed. Memberships added
+	// directly are left alone. It is called by the team service and does no
+	// authorization of its own.
+	SyncMember(ctx context.Context, projectID int, userID int) error
+}
+
+type membershipService struct {
+	repo ports.Repository
+	projectRepo projectPorts.Repository
+	userService userUseCase.UserService
+	portfolioRepo portfolioPorts.Repository
+	teamRepo teamPorts.Repository
+}
+
+func New(repo ports.Repository, projectRepo projectPorts.Repository, userService userUseCase.UserService, portfolioRepo portfolioPorts.Repository, teamRepo teamPorts.Repository) MembershipService {
+	return &membershipService{
+		repo: repo,
+		projectRepo: projectRepo,
+		userService: userService,
+		portfolioRepo: portfolioRepo,
+		teamRepo: teamRepo,
+	}
+}
+
+func(s *membershipService) AddMember(ctx context.Context, addMemberRequest AddMemberRequest) (domain.Member, error) {
+	if !domain.IsValidRole(addMemberRequest.Role) {
+		return domain.Member{}, ErrInvalidRole
+	}
+
+	err := s.Authorize(ctx, addMemberRequest.ProjectID, domain.PermissionManageMembers)
+	if err != nil {
+		return domain.Member{}, err
+	}
+
+	project, err := s.getProject(ctx, addMemberRequest.ProjectID)
+	if err != nil {
+		return domain.Member{}, err
+	}
+	if project.OwnerID == addMemberRequest.UserID {
+		return domain.Member{}, ErrOwnerCannotBeMember
+	}
+
+	_, err = s.userService.GetUser(ctx, addMemberRequest.UserID)
+	switch err {
+	case nil:
+	case userUseCase.ErrUserNotFound:
+		return domain.Member{}, ErrUserNotFound
+	default:
+		return domain.Member{}, err
+	}
+
+	member := domain.Member{
+		ProjectID: addMemberRequest.ProjectID,
+		UserID: addMemberRequest.UserID,
+		Role: addMemberRequest.Role,
+		AddedAt: time.Now(),
+	}
+	createdMember, err := s.repo.AddMember(ctx, member)
+	switch err {
+	case ports.ErrMemberAlreadyExists:
+		return domain.Member{}, ErrMemberAlreadyExists
+	}
+	return createdMember, err
+}
+
+func(s *membershipService) UpdateMemberRole(ctx context.Context, updateMemberRoleRequest UpdateMemberRoleRequest) (domain.Member, error) {
+	if !domain.IsValidRole(updateMemberRoleRequest.Role) {
+		return domain.Member{}, ErrInvalidRole
+	}
+
+	err := s.Authorize(ctx, updateMemberRoleRequest.ProjectID, domain.PermissionManageMembers)
+	if err != nil {
+		return domain.Member{}, err
+	}
+
+	member, err := s.repo.GetMember(ctx, updateMemberRoleRequest.ProjectID, updateMemberRoleRequest.UserID)
+	switch err {
+	case nil:
+	case ports.ErrMemberNotFound:
+		return domain.Member{}, ErrMemberNotFound
+	default:
+		return domain.Member{}, err
+	}
+	if member.TeamID != 0 {
+		return domain.Member{}, ErrTeamMembership
+	}
+
+	member.Role = updateMemberRoleRequest.Role
+	return s.repo.UpdateMember(ctx, member)
+}
+
+func(s *membershipService) RemoveMember(ctx context.Context, removeMemberRequest RemoveMemberRequest) error {
+	err := s.Authorize(ctx, removeMemberRequest.ProjectID, domain.PermissionManageMembers)
+	if err != nil {
+		return err
+	}
+
+	member, err := s.repo.GetMember(ctx, removeMemberRequest.ProjectID, removeMemberRequest.UserID)
+	switch err {
+	case nil:
+	case ports.ErrMemberNotFound:
+		return ErrMemberNotFound
+	default:
+		return err
+	}
+	if member.TeamID != 0 {
+		return ErrTeamMembership
+	}
+
+	err = s.repo.RemoveMember(ctx, removeMemberRequest.ProjectID, removeMemberRequest.UserID)
+	switch err {
+	case nil:
+	case ports.ErrMemberNotFound:
+		return ErrMemberNotFound
+	default:
+		return err
+	}
+	return s.SyncMember(ctx, removeMemberRequest.ProjectID, removeMemberRequest.UserID)
+}
+
+func(s *membershipService) ListMembers(ctx context.Context, projectID int) ([]domain.Member, error) {
+	err := s.Authorize(ctx, projectID, domain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListMembers(ctx, projectID)
+}
+
+// ListUserProjects returns every project the user owns or is a member of.
+func(s *membershipService) ListUserProjects(ctx context.Context, userID int) ([]projectDomain.Project, error) {
+	projects, err := s.projectRepo.ListProjectsByOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]bool, len(projects))
+	for _, project := range projects {
+		seen[project.ID] = true
+	}
+
+	memberships, err := s.repo.ListMembershipsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, membership := range memberships {
+		if seen[membership.ProjectID] {
+			continue
+		}
+		project, err := s.getProject(ctx, membership.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		seen[project.ID] = true
+		projects = append(projects, project)
+	}
+
+	actorID, ok := actor.IDFromContext(ctx)
+	if ok && actorID == userID {
+		return projects, nil
+	}
+	return s.FilterVisible(ctx, projects)
+}
+
+func(s *membershipService) Authorize(ctx context.Context, projectID int, permission string) error {
+	if actor.IsSystem(ctx) {
+		return nil
+	}
+	actorID, ok := actor.IDFromContext(ctx)
+	if !ok {
+		return ErrForbidden
+	}
+
+	project, err := s.getProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if project.OwnerID == actorID {
+		return nil
+	}
+
+	user, err := s.userService.GetUser(ctx, actorID)
+	switch err {
+	case nil:
+	case userUseCase.ErrUserNotFound:
+		return ErrForbidden
+	default:
+		return err
+	}
+	if user.Role == userDomain.RoleAdmin {
+		return nil
+	}
+
+	member, err := s.repo.GetMember(ctx, projectID, actorID)
+	switch err {
+	case nil:
+	case ports.ErrMemberNotFound:
+	default:
+		return err
+	}
+	if domain.HasPermission(member.Role, permission) {
+		return nil
+	}
+
+	_, teamRole, err := s.teamGrant(ctx, projectID, actorID)
+	if err != nil {
+		return err
+	}
+	if domain.HasPermission(teamRole, permission) {
+		return nil
+	}
+
+	if permission == domain.PermissionView {
+		manages, err := s.managesPortfolio(ctx, projectID, actorID)
+		if err != nil {
+			return err
+		}
+		if manages {
+			return nil
+		}
+	}
+	return ErrForbidden
+}
+
+func(s *membershipService) FilterVisible(ctx context.Context, projects []projectDomain.Project) ([]projectDomain.Project, error) {
+	if actor.IsSystem(ctx) {
+		return projects, nil
+	}
+	actorID, ok := actor.IDFromContext(ctx)
+	if !ok {
+		return []projectDomain.Project{}, nil
+	}
+	if !hasOthers(projects, actorID, nil) {
+		return projects, nil
+	}
+
+	viewable := make(map[int]bool)
+	user, err := s.userService.GetUser(ctx, actorID)
+	switch err {
+	case nil:
+	case userUseCase.ErrUserNotFound:
+		return visibleProjects(projects, actorID, viewable), nil
+	default:
+		return nil, err
+	}
+	if user.Role == userDomain.RoleAdmin {
+		return projects, nil
+	}
+
+	err = s.addMemberProjects(ctx, actorID, viewable)
+	if err != nil {
+		return nil, err
+	}
+	if hasOthers(projects, actorID, viewable) {
+		err = s.addManagedProjects(ctx, 0, actorID, false, viewable)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return visibleProjects(projects, actorID, viewable), nil
+}
+
+// addMemberProjects adds the projects the user may view as a member, directly
+// or through their teams, to viewable.
+func(s *membershipService) addMemberProjects(ctx context.Context, userID int, viewable map[int]bool) error {
+	memberships, err := s.repo.ListMembershipsByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, membership := range memberships {
+		if domain.HasPermission(membership.Role, domain.PermissionView) {
+			viewable[membership.ProjectID] = true
+		}
+	}
+
+	teams, err := s.teamRepo.ListTeamsByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, team := range teams {
+		grants, err := s.teamRepo.ListGrants(ctx, team.ID)
+		if err != nil {
+			return err
+		}
+		for _, grant := range grants {
+			if domain.HasPermission(grant.Role, domain.PermissionView) {
+				viewable[grant.ProjectID] = true
+			}
+		}
+	}
+	return nil
+}
+
+// addManagedProjects walks the portfolios beneath parentID and adds the
+// projects of those the user manages, or that sit below one they manage, to
+// viewable.
+func(s *membershipService) addManagedProjects(ctx context.Context, parentID int, userID int, managed bool, viewable map[int]bool) error {
+	portfolios, err := s.portfolioRepo.ListPortfolios(ctx, parentID)
+	if err != nil {
+		return err
+	}
+	for _, portfolio := range portfolios {
+		portfolioManaged := managed || portfolio.ManagerID == userID
+		if portfolioManaged {
+			projectIDs, err := s.portfolioRepo.ListProjectIDs(ctx, portfolio.ID)
+			if err != nil {
+				return err
+			}
+			for _, projectID := range projectIDs {
+				viewable[projectID] = true
+			}
+		}
+
+		err = s.addManagedProjects(ctx, portfolio.ID, userID, portfolioManaged, viewable)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasOthers reports whether any of the projects is neither owned by userID nor
+// viewable.
+func hasOthers(projects []projectDomain.Project, userID int, viewable map[int]bool) bool {
+	for _, project := range projects {
+		if project.OwnerID != userID && !viewable[project.ID] {
+			return true
+		}
+	}
+	return false
+}
+
+func visibleProjects(projects []projectDomain.Project, userID int, viewable map[int]bool) []projectDomain.Project {
+	visible := make([]projectDomain.Project, 0, len(projects))
+	for _, project := range projects {
+		if project.OwnerID == userID || viewable[project.ID] {
+			visible = append(visible, project)
+		}
+	}
+	return visible
+}
+
+func(s *membershipService) SyncMember(ctx context.Context, projectID int, userID int) error {
+	project, err := s.getProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	member, err := s.repo.GetMember(ctx, projectID, userID)
+	exists := true
+	switch err {
+	case nil:
+	case ports.ErrMemberNotFound:
+		exists = false
+	default:
+		return err
+	}
+	if exists && member.TeamID == 0 {
+		return nil
+	}
+
+	teamID, role, err := s.teamGrant(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+	if project.OwnerID == userID {
+		role = ""
+	}
+
+	switch {
+	case role == "" && !exists:
+		return nil
+	case role == "":
+		return s.repo.RemoveMember(ctx, projectID, userID)
+	case !exists:
+		_, err = s.repo.AddMember(ctx, domain.Member{
+			ProjectID: projectID,
+			UserID: userID,
+			Role: role,
+			TeamID: teamID,
+			AddedAt: time.Now(),
+		})
+		return err
+	case member.Role == role && member.TeamID == teamID:
+		return nil
+	default:
+		member.Role = role
+		member.TeamID = teamID
+		_, err = s.repo.UpdateMember(ctx, member)
+		return err
+	}
+}
+
+// teamGrant returns the strongest role the user's teams are granted on the
+// project and the team granting it. The role is empty when none of their teams
+// has a grant.
+func(s *membershipService) teamGrant(ctx context.Context, projectID int, userID int) (int, string, error) {
+	grants, err := s.teamRepo.ListProjectGrants(ctx, projectID)
+	if err != nil {
+		return 0, "", err
+	}
+	if len(grants) == 0 {
+		return 0, "", nil
+	}
+
+	teams, err := s.teamRepo.ListTeamsByUser(ctx, userID)
+	if err != nil {
+		return 0, "", err
+	}
+	memberOf := make(map[int]bool, len(teams))
+	for _, team := range teams {
+		memberOf[team.ID] = true
+	}
+
+	teamID, role := 0, ""
+	for _, grant := range grants {
+		if !memberOf[grant.TeamID] {
+			continue
+		}
+		if domain.StrongerRole(role, grant.Role) != role {
+			teamID, role = grant.TeamID, grant.Role
+		}
+	}
+	return teamID, role, nil
+}
+
+// managesPortfolio reports whether userID manages the portfolio holding the
+// project or one of the portfolios above it.
+func(s *membershipService) managesPortfolio(ctx context.Context, projectID int, userID int) (bool, error) {
+	portfolio, err := s.portfolioRepo.GetProjectPortfolio(ctx, projectID)
+	switch err {
+	case nil:
+	case portfolioPorts.ErrPortfolioNotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+
+	ancestors, err := s.portfolioRepo.ListAncestors(ctx, portfolio.ID)
+	if err != nil {
+		return false, err
+	}
+	for _, ancestor := range ancestors {
+		if ancestor.ManagerID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func(s *membershipService) getProject(ctx context.Context, projectID int) (projectDomain.Project, error) {
+	project, err := s.projectRepo.GetProject(ctx, projectID)
+	switch err {
+	case projectPorts.ErrProjectNotFound:
+		return projectDomain.Project{}, ErrProjectNotFound
+	}
+	return project, err
+}
@@ -0,0 +1,565 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/captainhbb/tbs-backend/internal/membership/domain"
+	"github.com/captainhbb/tbs-backend/internal/membership/ports"
+	portsMock "github.com/captainhbb/tbs-backend/internal/membership/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	portfolioDomain "github.com/captainhbb/tbs-backend/internal/portfolio/domain"
+	portfolioPorts "github.com/captainhbb/tbs-backend/internal/portfolio/ports"
+	portfolioPortsMock "github.com/captainhbb/tbs-backend/internal/portfolio/ports/mock"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	teamDomain "github.com/captainhbb/tbs-backend/internal/team/domain"
+	teamPortsMock "github.com/captainhbb/tbs-backend/internal/team/ports/mock"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddMember(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		input usecase.AddMemberRequest
+		mockSetup func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService)
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "success",
+			input: usecase.AddMemberRequest{ProjectID: 1, UserID: 2, Role: domain.RoleContributor},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, OwnerID: 1}, nil)
+				userService.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2}, nil)
+				repo.On("AddMember", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.Member)
+					require.Equal(t, domain.RoleContributor, capturedArg.Role)
+				}).Return(domain.Member{ProjectID: 1, UserID: 2, Role: domain.RoleContributor}, nil)
+			},
+		},
+		{
+			name: "invalid role",
+			input: usecase.AddMemberRequest{ProjectID: 1, UserID: 2, Role: "superhero"},
+			mockSetup: func(_ *portsMock.MockRepository, _ *projectPortsMock.MockRepository, _ *userUseCaseMock.MockUserService) {},
+			expectError: true,
+			expectedError: usecase.ErrInvalidRole,
+		},
+		{
+			name: "owner cannot be member",
+			input: usecase.AddMemberRequest{ProjectID: 1, UserID: 1, Role: domain.RoleViewer},
+			mockSetup: func(_ *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, _ *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, OwnerID: 1}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrOwnerCannotBeMember,
+		},
+		{
+			name: "user not found",
+			input: usecase.AddMemberRequest{ProjectID: 1, UserID: 2, Role: domain.RoleViewer},
+			mockSetup: func(_ *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, OwnerID: 1}, nil)
+				userService.On("GetUser", mock.Anything, 2).Return(userDomain.User{}, userUseCase.ErrUserNotFound)
+			},
+			expectError: true,
+			expectedError: usecase.ErrUserNotFound,
+		},
+		{
+			name: "already a member",
+			input: usecase.AddMemberRequest{ProjectID: 1, UserID: 2, Role: domain.RoleViewer},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, OwnerID: 1}, nil)
+				userService.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2}, nil)
+				repo.On("AddMember", mock.Anything, mock.Anything).Return(domain.Member{}, ports.ErrMemberAlreadyExists)
+			},
+			expectError: true,
+			expectedError: usecase.ErrMemberAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			userServiceMock := userUseCaseMock.NewMockUserService(t)
+			service := usecase.New(repoMock, projectRepoMock, userServiceMock, portfolioPortsMock.NewMockRepository(t), teamPortsMock.NewMockRepository(t))
+
+			ctx := actor.WithID(context.Background(), 1)
+
+			tt.mockSetup(repoMock, projectRepoMock, userServiceMock)
+
+			member, err := service.AddMember(ctx, tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.input.ProjectID, member.ProjectID)
+				require.Equal(t, tt.input.UserID, member.UserID)
+				require.Equal(t, tt.input.Role, member.Role)
+			}
+		})
+	}
+}
+
+func TestListUserProjects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name				string
+		ctx					context.Context
+		expectedProjectIDs	[]int
+	}{
+		{
+			name: "the user themselves",
+			ctx: actor.WithID(context.Background(), 2),
+			expectedProjectIDs: []int{1, 3},
+		},
+		{
+			name: "system",
+			ctx: actor.AsSystem(context.Background()),
+			expectedProjectIDs: []int{1, 3},
+		},
+		{
+			name: "admin",
+			ctx: actor.WithID(context.Background(), 8),
+			expectedProjectIDs: []int{1, 3},
+		},
+		{
+			name: "another user sees the projects they may view",
+			ctx: actor.WithID(context.Background(), 7),
+			expectedProjectIDs: []int{3},
+		},
+		{
+			name: "no actor",
+			ctx: context.Background(),
+			expectedProjectIDs: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			userServiceMock := userUseCaseMock.NewMockUserService(t)
+			portfolioRepoMock := portfolioPortsMock.NewMockRepository(t)
+			teamRepoMock := teamPortsMock.NewMockRepository(t)
+			service := usecase.New(repoMock, projectRepoMock, userServiceMock, portfolioRepoMock, teamRepoMock)
+
+			projectRepoMock.On("ListProjectsByOwner", mock.Anything, 2).Return([]projectDomain.Project{{ID: 1, OwnerID: 2}}, nil)
+			repoMock.On("ListMembershipsByUser", mock.Anything, 2).Return([]domain.Member{
+				{ProjectID: 1, UserID: 2, Role: domain.RoleManager},
+				{ProjectID: 3, UserID: 2, Role: domain.RoleViewer},
+			}, nil)
+			projectRepoMock.On("GetProject", mock.Anything, 3).Return(projectDomain.Project{ID: 3, OwnerID: 5}, nil)
+			userServiceMock.On("GetUser", mock.Anything, 8).Return(userDomain.User{ID: 8, Role: userDomain.RoleAdmin}, nil).Maybe()
+			userServiceMock.On("GetUser", mock.Anything, 7).Return(userDomain.User{ID: 7}, nil).Maybe()
+			repoMock.On("ListMembershipsByUser", mock.Anything, 7).Return([]domain.Member{{ProjectID: 3, UserID: 7, Role: domain.RoleViewer}}, nil).Maybe()
+			teamRepoMock.On("ListTeamsByUser", mock.Anything, 7).Return([]teamDomain.Team{}, nil).Maybe()
+			portfolioRepoMock.On("ListPortfolios", mock.Anything, 0).Return([]portfolioDomain.Portfolio{}, nil).Maybe()
+
+			projects, err := service.ListUserProjects(tt.ctx, 2)
+			require.NoError(t, err)
+			projectIDs := make([]int, 0, len(projects))
+			for _, project := range projects {
+				projectIDs = append(projectIDs, project.ID)
+			}
+			require.Equal(t, tt.expectedProjectIDs, projectIDs)
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		// actorID is left zero for requests carrying no actor.
+		actorID int
+		system bool
+		permission string
+		// portfolios is the chain of portfolios holding project 10, nearest
+		// first.
+		portfolios []portfolioDomain.Portfolio
+		// grants are the team grants on project 10 and teams the actor's teams.
+		grants []teamDomain.Grant
+		teams []teamDomain.Team
+		mockSetup func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService)
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "no actor",
+			permission: domain.PermissionView,
+			mockSetup: func(_ *portsMock.MockRepository, _ *projectPortsMock.MockRepository, _ *userUseCaseMock.MockUserService) {},
+			expectError: true,
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "system may administer",
+			system: true,
+			permission: domain.PermissionAdminister,
+			mockSetup: func(_ *portsMock.MockRepository, _ *projectPortsMock.MockRepository, _ *userUseCaseMock.MockUserService) {},
+		},
+		{
+			name: "owner may administer",
+			actorID: 1,
+			permission: domain.PermissionAdminister,
+			mockSetup: func(_ *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, _ *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{ID: 10, OwnerID: 1}, nil)
+			},
+		},
+		{
+			name: "admin may administer",
+			actorID: 2,
+			permission: domain.PermissionAdminister,
+			mockSetup: func(_ *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{ID: 10, OwnerID: 1}, nil)
+				userService.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, Role: userDomain.RoleAdmin}, nil)
+			},
+		},
+		{
+			name: "contributor may edit",
+			actorID: 3,
+			permission: domain.PermissionEdit,
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{ID: 10, OwnerID: 1}, nil)
+				userService.On("GetUser", mock.Anything, 3).Return(userDomain.User{ID: 3}, nil)
+				repo.On("GetMember", mock.Anything, 10, 3).Return(domain.Member{ProjectID: 10, UserID: 3, Role: domain.RoleContributor}, nil)
+			},
+		},
+		{
+			name: "viewer may not edit",
+			actorID: 3,
+			permission: domain.PermissionEdit,
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{ID: 10, OwnerID: 1}, nil)
+				userService.On("GetUser", mock.Anything, 3).Return(userDomain.User{ID: 3}, nil)
+				repo.On("GetMember", mock.Anything, 10, 3).Return(domain.Member{ProjectID: 10, UserID: 3, Role: domain.RoleViewer}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "manager may not administer",
+			actorID: 3,
+			permission: domain.PermissionAdminister,
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{ID: 10, OwnerID: 1}, nil)
+				userService.On("GetUser", mock.Anything, 3).Return(userDomain.User{ID: 3}, nil)
+				repo.On("GetMember", mock.Anything, 10, 3).Return(domain.Member{ProjectID: 10, UserID: 3, Role: domain.RoleManager}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "non-member",
+			actorID: 4,
+			permission: domain.PermissionView,
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{ID: 10, OwnerID: 1}, nil)
+				userService.On("GetUser", mock.Anything, 4).Return(userDomain.User{ID: 4}, nil)
+				repo.On("GetMember", mock.Anything, 10, 4).Return(domain.Member{}, ports.ErrMemberNotFound)
+			},
+			expectError: true,
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "team role outranks own role",
+			actorID: 4,
+			permission: domain.PermissionEdit,
+			grants: []teamDomain.Grant{{TeamID: 7, ProjectID: 10, Role: domain.RoleViewer}, {TeamID: 8, ProjectID: 10, Role: domain.RoleContributor}},
+			teams: []teamDomain.Team{{ID: 8}},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{ID: 10, OwnerID: 1}, nil)
+				userService.On("GetUser", mock.Anything, 4).Return(userDomain.User{ID: 4}, nil)
+				repo.On("GetMember", mock.Anything, 10, 4).Return(domain.Member{ProjectID: 10, UserID: 4, Role: domain.RoleViewer}, nil)
+			},
+		},
+		{
+			name: "grant of a team the actor is not in",
+			actorID: 4,
+			permission: domain.PermissionView,
+			grants: []teamDomain.Grant{{TeamID: 7, ProjectID: 10, Role: domain.RoleManager}},
+			teams: []teamDomain.Team{{ID: 8}},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{ID: 10, OwnerID: 1}, nil)
+				userService.On("GetUser", mock.Anything, 4).Return(userDomain.User{ID: 4}, nil)
+				repo.On("GetMember", mock.Anything, 10, 4).Return(domain.Member{}, ports.ErrMemberNotFound)
+			},
+			expectError: true,
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "portfolio manager may view",
+			actorID: 4,
+			permission: domain.PermissionView,
+			portfolios: []portfolioDomain.Portfolio{{ID: 2, ParentID: 1, ManagerID: 5}, {ID: 1, ManagerID: 4}},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{ID: 10, OwnerID: 1}, nil)
+				userService.On("GetUser", mock.Anything, 4).Return(userDomain.User{ID: 4}, nil)
+				repo.On("GetMember", mock.Anything, 10, 4).Return(domain.Member{}, ports.ErrMemberNotFound)
+			},
+		},
+		{
+			name: "portfolio manager may not edit",
+			actorID: 4,
+			permission: domain.PermissionEdit,
+			portfolios: []portfolioDomain.Portfolio{{ID: 1, ManagerID: 4}},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{ID: 10, OwnerID: 1}, nil)
+				userService.On("GetUser", mock.Anything, 4).Return(userDomain.User{ID: 4}, nil)
+				repo.On("GetMember", mock.Anything, 10, 4).Return(domain.Member{}, ports.ErrMemberNotFound)
+			},
+			expectError: true,
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "manager of another program",
+			actorID: 4,
+			permission: domain.PermissionView,
+			portfolios: []portfolioDomain.Portfolio{{ID: 2, ParentID: 1, ManagerID: 5}, {ID: 1, ManagerID: 6}},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				projectRepo.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{ID: 10, OwnerID: 1}, nil)
+				userService.On("GetUser", mock.Anything, 4).Return(userDomain.User{ID: 4}, nil)
+				repo.On("GetMember", mock.Anything, 10, 4).Return(domain.Member{}, ports.ErrMemberNotFound)
+			},
+			expectError: true,
+			expectedError: usecase.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			userServiceMock := userUseCaseMock.NewMockUserService(t)
+			portfolioRepoMock := portfolioPortsMock.NewMockRepository(t)
+			teamRepoMock := teamPortsMock.NewMockRepository(t)
+			service := usecase.New(repoMock, projectRepoMock, userServiceMock, portfolioRepoMock, teamRepoMock)
+
+			ctx := context.Background()
+			if tt.actorID != 0 {
+				ctx = actor.WithID(ctx, tt.actorID)
+			}
+			if tt.system {
+				ctx = actor.AsSystem(ctx)
+			}
+			if len(tt.portfolios) == 0 {
+				portfolioRepoMock.On("GetProjectPortfolio", mock.Anything, 10).Return(portfolioDomain.Portfolio{}, portfolioPorts.ErrPortfolioNotFound).Maybe()
+			} else {
+				portfolioRepoMock.On("GetProjectPortfolio", mock.Anything, 10).Return(tt.portfolios[0], nil).Maybe()
+				portfolioRepoMock.On("ListAncestors", mock.Anything, tt.portfolios[0].ID).Return(tt.portfolios, nil).Maybe()
+			}
+
+			teamRepoMock.On("ListProjectGrants", mock.Anything, 10).Return(tt.grants, nil).Maybe()
+			teamRepoMock.On("ListTeamsByUser", mock.Anything, tt.actorID).Return(tt.teams, nil).Maybe()
+
+			tt.mockSetup(repoMock, projectRepoMock, userServiceMock)
+
+			err := service.Authorize(ctx, 10, tt.permission)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFilterVisible(t *testing.T) {
+	t.Parallel()
+
+	// User 2 owns project 1, is a member of 2, is granted 3 through team 7 and
+	// manages portfolio 20, whose program 21 holds project 4. Project 5 is
+	// none of theirs.
+	projects := []projectDomain.Project{
+		{ID: 1, OwnerID: 2},
+		{ID: 2, OwnerID: 1},
+		{ID: 3, OwnerID: 1},
+		{ID: 4, OwnerID: 1},
+		{ID: 5, OwnerID: 1},
+	}
+
+	tests := []struct {
+		name			string
+		ctx				context.Context
+		role			string
+		expectedIDs		[]int
+	}{
+		{
+			name: "regular user",
+			ctx: actor.WithID(context.Background(), 2),
+			expectedIDs: []int{1, 2, 3, 4},
+		},
+		{
+			name: "global admin",
+			ctx: actor.WithID(context.Background(), 2),
+			role: userDomain.RoleAdmin,
+			expectedIDs: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name: "no acting user",
+			ctx: context.Background(),
+			expectedIDs: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoMock := portsMock.NewMockRepository(t)
+			userServiceMock := userUseCaseMock.NewMockUserService(t)
+			portfolioRepoMock := portfolioPortsMock.NewMockRepository(t)
+			teamRepoMock := teamPortsMock.NewMockRepository(t)
+			service := usecase.New(repoMock, projectPortsMock.NewMockRepository(t), userServiceMock, portfolioRepoMock, teamRepoMock)
+
+			userServiceMock.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, Role: tt.role}, nil).Maybe()
+			repoMock.On("ListMembershipsByUser", mock.Anything, 2).Return([]domain.Member{{ProjectID: 2, UserID: 2, Role: domain.RoleViewer}}, nil).Maybe()
+			teamRepoMock.On("ListTeamsByUser", mock.Anything, 2).Return([]teamDomain.Team{{ID: 7}}, nil).Maybe()
+			teamRepoMock.On("ListGrants", mock.Anything, 7).Return([]teamDomain.Grant{{TeamID: 7, ProjectID: 3, Role: domain.RoleContributor}}, nil).Maybe()
+			portfolioRepoMock.On("ListPortfolios", mock.Anything, 0).Return([]portfolioDomain.Portfolio{{ID: 20, ManagerID: 2}, {ID: 30, ManagerID: 1}}, nil).Maybe()
+			portfolioRepoMock.On("ListPortfolios", mock.Anything, 20).Return([]portfolioDomain.Portfolio{{ID: 21, ParentID: 20}}, nil).Maybe()
+			portfolioRepoMock.On("ListPortfolios", mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+			portfolioRepoMock.On("ListProjectIDs", mock.Anything, 20).Return(nil, nil).Maybe()
+			portfolioRepoMock.On("ListProjectIDs", mock.Anything, 21).Return([]int{4}, nil).Maybe()
+
+			visible, err := service.FilterVisible(tt.ctx, projects)
+			require.NoError(t, err)
+			ids := make([]int, 0, len(visible))
+			for _, project := range visible {
+				ids = append(ids, project.ID)
+			}
+			require.Equal(t, tt.expectedIDs, ids)
+			portfolioRepoMock.AssertNotCalled(t, "ListProjectIDs", mock.Anything, 30)
+		})
+	}
+}
+
+func TestSyncMember(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		userID int
+		// grants are the team grants on project 10 and teams the user's teams.
+		grants []teamDomain.Grant
+		teams []teamDomain.Team
+		mockSetup func(repo *portsMock.MockRepository)
+	}{
+		{
+			name: "adds the strongest team role",
+			userID: 2,
+			grants: []teamDomain.Grant{{TeamID: 7, ProjectID: 10, Role: domain.RoleViewer}, {TeamID: 8, ProjectID: 10, Role: domain.RoleContributor}},
+			teams: []teamDomain.Team{{ID: 7}, {ID: 8}},
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("GetMember", mock.Anything, 10, 2).Return(domain.Member{}, ports.ErrMemberNotFound)
+				repo.On("AddMember", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.Member)
+					require.Equal(t, domain.RoleContributor, capturedArg.Role)
+					require.Equal(t, 8, capturedArg.TeamID)
+				}).Return(domain.Member{}, nil)
+			},
+		},
+		{
+			name: "leaves a direct member alone",
+			userID: 2,
+			grants: []teamDomain.Grant{{TeamID: 7, ProjectID: 10, Role: domain.RoleManager}},
+			teams: []teamDomain.Team{{ID: 7}},
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("GetMember", mock.Anything, 10, 2).Return(domain.Member{ProjectID: 10, UserID: 2, Role: domain.RoleViewer}, nil)
+			},
+		},
+		{
+			name: "moves to the role of the remaining team",
+			userID: 2,
+			grants: []teamDomain.Grant{{TeamID: 7, ProjectID: 10, Role: domain.RoleViewer}, {TeamID: 8, ProjectID: 10, Role: domain.RoleManager}},
+			teams: []teamDomain.Team{{ID: 7}},
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("GetMember", mock.Anything, 10, 2).Return(domain.Member{ProjectID: 10, UserID: 2, Role: domain.RoleManager, TeamID: 8}, nil)
+				repo.On("UpdateMember", mock.Anything, domain.Member{ProjectID: 10, UserID: 2, Role: domain.RoleViewer, TeamID: 7}).Return(domain.Member{}, nil)
+			},
+		},
+		{
+			name: "removes a membership no team grants any more",
+			userID: 2,
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("GetMember", mock.Anything, 10, 2).Return(domain.Member{ProjectID: 10, UserID: 2, Role: domain.RoleViewer, TeamID: 7}, nil)
+				repo.On("RemoveMember", mock.Anything, 10, 2).Return(nil)
+			},
+		},
+		{
+			name: "never adds the owner",
+			userID: 1,
+			grants: []teamDomain.Grant{{TeamID: 7, ProjectID: 10, Role: domain.RoleViewer}},
+			teams: []teamDomain.Team{{ID: 7}},
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("GetMember", mock.Anything, 10, 1).Return(domain.Member{}, ports.ErrMemberNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			teamRepoMock := teamPortsMock.NewMockRepository(t)
+			service := usecase.New(repoMock, projectRepoMock, userUseCaseMock.NewMockUserService(t), portfolioPortsMock.NewMockRepository(t), teamRepoMock)
+
+			projectRepoMock.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{ID: 10, OwnerID: 1}, nil)
+			teamRepoMock.On("ListProjectGrants", mock.Anything, 10).Return(tt.grants, nil).Maybe()
+			teamRepoMock.On("ListTeamsByUser", mock.Anything, tt.userID).Return(tt.teams, nil).Maybe()
+			tt.mockSetup(repoMock)
+
+			err := service.SyncMember(context.Background(), 10, tt.userID)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRemoveTeamMembership(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	service := usecase.New(repoMock, projectPortsMock.NewMockRepository(t), userUseCaseMock.NewMockUserService(t), portfolioPortsMock.NewMockRepository(t), teamPortsMock.NewMockRepository(t))
+
+	repoMock.On("GetMember", mock.Anything, 10, 2).Return(domain.Member{ProjectID: 10, UserID: 2, Role: domain.RoleViewer, TeamID: 7}, nil)
+
+	err := service.RemoveMember(actor.AsSystem(context.Background()), usecase.RemoveMemberRequest{ProjectID: 10, UserID: 2})
+	require.ErrorIs(t, err, usecase.ErrTeamMembership)
+}
+
+func TestRemoveDirectMemberKeepsTheTeamRole(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	projectRepoMock := projectPortsMock.NewMockRepository(t)
+	teamRepoMock := teamPortsMock.NewMockRepository(t)
+	service := usecase.New(repoMock, projectRepoMock, userUseCaseMock.NewMockUserService(t), portfolioPortsMock.NewMockRepository(t), teamRepoMock)
+
+	projectRepoMock.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{ID: 10, OwnerID: 1}, nil)
+	repoMock.On("GetMember", mock.Anything, 10, 2).Return(domain.Member{ProjectID: 10, UserID: 2, Role: domain.RoleManager}, nil).Once()
+	repoMock.On("RemoveMember", mock.Anything, 10, 2).Return(nil).Once()
+	repoMock.On("GetMember", mock.Anything, 10, 2).Return(domain.Member{}, ports.ErrMemberNotFound).Once()
+	teamRepoMock.On("ListProjectGrants", mock.Anything, 10).Return([]teamDomain.Grant{{TeamID: 7, ProjectID: 10, Role: domain.RoleViewer}}, nil)
+	teamRepoMock.On("ListTeamsByUser", mock.Anything, 2).Return([]teamDomain.Team{{ID: 7}}, nil)
+	repoMock.On("AddMember", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		capturedArg := args.Get(1).(domain.Member)
+		require.Equal(t, domain.RoleViewer, capturedArg.Role)
+		require.Equal(t, 7, capturedArg.TeamID)
+	}).Return(domain.Member{}, nil).Once()
+
+	err := service.RemoveMember(actor.AsSystem(context.Background()), usecase.RemoveMemberRequest{ProjectID: 10, UserID: 2})
+	require.NoError(t, err)
+}
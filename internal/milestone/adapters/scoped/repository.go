@@ -0,0 +1,75 @@
+// Package scoped confines a milestone repository to the tenant carried in the
+// request context, through the project each milestone belongs to. Milestones of
+// projects in other tenants are reported as not found. Platform calls see every
+// tenant, and requests carrying neither see no milestones at all.
+package scoped
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/milestone/domain"
+	"github.com/captainhbb/tbs-backend/internal/milestone/ports"
+	projectScoped "github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+)
+
+type repository struct {
+	next			ports.Repository
+	projectRepo		projectPorts.Repository
+}
+
+// New wraps next so that every query is confined to the projects of the
+// tenant in ctx, which projectRepo looks up.
+func New(next ports.Repository, projectRepo projectPorts.Repository) ports.Repository {
+	return &repository{next: next, projectRepo: projectRepo}
+}
+
+func(r *repository) CreateMilestone(ctx context.Context, milestone domain.Milestone) (domain.Milestone, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, milestone.ProjectID)
+	if err != nil {
+		return domain.Milestone{}, err
+	}
+	return r.next.CreateMilestone(ctx, milestone)
+}
+
+func(r *repository) GetMilestone(ctx context.Context, id int) (domain.Milestone, error) {
+	milestone, err := r.next.GetMilestone(ctx, id)
+	if err != nil {
+		return domain.Milestone{}, err
+	}
+	err = projectScoped.CheckProject(ctx, r.projectRepo, milestone.ProjectID)
+	switch err {
+	case nil:
+	case projectPorts.ErrProjectNotFound:
+		return domain.Milestone{}, ports.ErrMilestoneNotFound
+	default:
+		return domain.Milestone{}, err
+	}
+	return milestone, nil
+}
+
+// UpdateMilestone keeps the milestone in the project it belongs to.
+func(r *repository) UpdateMilestone(ctx context.Context, milestone domain.Milestone) (domain.Milestone, error) {
+	existingMilestone, err := r.GetMilestone(ctx, milestone.ID)
+	if err != nil {
+		return domain.Milestone{}, err
+	}
+	milestone.ProjectID = existingMilestone.ProjectID
+	return r.next.UpdateMilestone(ctx, milestone)
+}
+
+func(r *repository) DeleteMilestone(ctx context.Context, id int) error {
+	_, err := r.GetMilestone(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeleteMilestone(ctx, id)
+}
+
+func(r *repository) ListMilestones(ctx context.Context, projectID int) ([]domain.Milestone, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListMilestones(ctx, projectID)
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	AcceptancePending			= "pending"
+	AcceptanceAccepted			= "accepted"
+	AcceptanceRejected			= "rejected"
+)
+
+// Milestone is a dated checkpoint of a project. It is completed once its
+// deliverables are handed over and then accepted or rejected on review.
+type Milestone struct {
+	ID 					int
+	ProjectID			int
+	Name				string
+	Description			string
+	Deliverables		[]string
+	TaskIDs				[]int
+	TargetDate			time.Time
+	CompletedAt			time.Time
+	Acceptance			string
+	ReviewedBy			int
+	ReviewNote			string
+}
+
+func (m Milestone) Completed() bool {
+	return !m.CompletedAt.IsZero()
+}
+
+// Within reports whether the milestone's target and completion dates fall
+// inside [start, end], compared by calendar day. A zero start or end leaves
+// that side open.
+func (m Milestone) Within(start time.Time, end time.Time) bool {
+	for _, date := range []time.Time{m.TargetDate, m.CompletedAt} {
+		if date.IsZero() {
+			continue
+		}
+		if !start.IsZero() && Day(date).Before(Day(start)) {
+			return false
+		}
+		if !end.IsZero() && Day(date).After(Day(end)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Day truncates t to midnight UTC of its calendar date.
+func Day(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
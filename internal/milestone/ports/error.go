@@ -0,0 +1,7 @@
+package ports
+
+import "errors"
+
+var (
+	ErrMilestoneNotFound		= errors.New("milestone not found")
+)
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/milestone/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	CreateMilestone(ctx context.Context, milestone domain.Milestone) (domain.Milestone, error)
+	GetMilestone(ctx context.Context, id int) (domain.Milestone, error)
+	UpdateMilestone(ctx context.Context, milestone domain.Milestone) (domain.Milestone, error)
+	DeleteMilestone(ctx context.Context, id int) error
+	// ListMilestones returns the project's milestones ordered by target date.
+	ListMilestones(ctx context.Context, projectID int) ([]domain.Milestone, error)
+}
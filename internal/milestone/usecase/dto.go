@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"time"
+)
+
+type CreateMilestoneRequest struct {
+	ProjectID 				int
+	Name 					string
+	Description 			string
+	Deliverables 			[]string
+	TaskIDs 				[]int
+	TargetDate 				time.Time
+}
+
+type UpdateMilestoneRequest struct {
+	ID 						int
+	Name 					string
+	Description 			string
+	Deliverables 			[]string
+	TaskIDs 				[]int
+	TargetDate 				time.Time
+}
+
+type CompleteMilestoneRequest struct {
+	ID 						int
+	// CompletedAt defaults to now.
+	CompletedAt 			time.Time
+}
+
+type ReviewMilestoneRequest struct {
+	ID 						int
+	Accepted 				bool
+	Note 					string
+}
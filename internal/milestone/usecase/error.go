@@ -0,0 +1,13 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrMilestoneNotFound		= errors.New("milestone not found")
+	ErrProjectNotFound			= errors.New("project not found")
+	ErrNameRequired				= errors.New("milestone name is required")
+	ErrTargetDateRequired		= errors.New("milestone target date is required")
+	ErrOutsideProject			= errors.New("milestone dates must fall within the project's start and end dates")
+	ErrInvalidTask				= errors.New("linked tasks must belong to the milestone's project")
+	ErrNotCompleted				= errors.New("milestone must be completed before it is reviewed")
+)
@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	"github.com/captainhbb/tbs-backend/internal/milestone/domain"
+	"github.com/captainhbb/tbs-backend/internal/milestone/ports"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	taskPorts "github.com/captainhbb/tbs-backend/internal/task/ports"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+)
+
+//go:generate mockery --dir . --name MilestoneService --structname MockMilestoneService --filename mock_milestone_service.go --output ./mock --outpkg mock
+type MilestoneService interface {
+	CreateMilestone(ctx context.Context, milestone CreateMilestoneRequest) (domain.Milestone, error)
+	GetMilestone(ctx context.Context, id int) (domain.Milestone, error)
+	UpdateMilestone(ctx context.Context, milestone UpdateMilestoneRequest) (domain.Milestone, error)
+	DeleteMilestone(ctx context.Context, id int) error
+	ListMilestones(ctx context.Context, projectID int) ([]domain.Milestone, error)
+	CompleteMilestone(ctx context.Context, completion CompleteMilestoneRequest) (domain.Milestone, error)
+	ReviewMilestone(ctx context.Context, review ReviewMilestoneRequest) (domain.Milestone, error)
+}
+
+type milestoneService struct {
+	repo ports.Repository
+	projectRepo projectPorts.Repository
+	taskRepo taskPorts.Repository
+	membershipService membershipUseCase.MembershipService
+}
+
+func New(repo ports.Repository, projectRepo projectPorts.Repository, taskRepo taskPorts.Repository, membershipService membershipUseCase.MembershipService) MilestoneService {
+	return &milestoneService{
+		repo: repo,
+		projectRepo: projectRepo,
+		taskRepo: taskRepo,
+		membershipService: membershipService,
+	}
+}
+
+func(s *milestoneService) CreateMilestone(ctx context.Context, createMilestoneRequest CreateMilestoneRequest) (domain.Milestone, error) {
+	milestone := domain.Milestone{
+		ProjectID: createMilestoneRequest.ProjectID,
+		Name: createMilestoneRequest.Name,
+		Description: createMilestoneRequest.Description,
+		Deliverables: createMilestoneRequest.Deliverables,
+		TaskIDs: createMilestoneRequest.TaskIDs,
+		TargetDate: createMilestoneRequest.TargetDate,
+		Acceptance: domain.AcceptancePending,
+	}
+
+	err := s.membershipService.Authorize(ctx, milestone.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Milestone{}, err
+	}
+
+	err = s.validate(ctx, milestone)
+	if err != nil {
+		return domain.Milestone{}, err
+	}
+	return s.repo.CreateMilestone(ctx, milestone)
+}
+
+func(s *milestoneService) GetMilestone(ctx context.Context, id int) (domain.Milestone, error) {
+	milestone, err := s.getMilestone(ctx, id)
+	if err != nil {
+		return domain.Milestone{}, err
+	}
+
+	err = s.membershipService.Authorize(ctx, milestone.ProjectID, membershipDomain.PermissionView)
+	if err != nil {
+		return domain.Milestone{}, err
+	}
+	return milestone, nil
+}
+
+func(s *milestoneService) UpdateMilestone(ctx context.Context, updateMilestoneRequest UpdateMilestoneRequest) (domain.Milestone, error) {
+	milestone, err := s.editableMilestone(ctx, updateMilestoneRequest.ID)
+	if err != nil {
+		return domain.Milestone{}, err
+	}
+
+	milestone.Name = updateMilestoneRequest.Name
+	milestone.Description = updateMilestoneRequest.Description
+	milestone.Deliverables = updateMilestoneRequest.Deliverables
+	milestone.TaskIDs = updateMilestoneRequest.TaskIDs
+	milestone.TargetDate = updateMilestoneRequest.TargetDate
+
+	err = s.validate(ctx, milestone)
+	if err != nil {
+		return domain.Milestone{}, err
+	}
+	return s.updateMilestone(ctx, milestone)
+}
+
+func(s *milestoneService) DeleteMilestone(ctx context.Context, id int) error {
+	_, err := s.editableMilestone(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteMilestone(ctx, id)
+	switch err {
+	case ports.ErrMilestoneNotFound:
+		return ErrMilestoneNotFound
+	}
+	return err
+}
+
+func(s *milestoneService) ListMilestones(ctx context.Context, projectID int) ([]domain.Milestone, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListMilestones(ctx, projectID)
+}
+
+func(s *milestoneService) CompleteMilestone(ctx context.Context, completeMilestoneRequest CompleteMilestoneRequest) (domain.Milestone, error) {
+	milestone, err := s.editableMilestone(ctx, completeMilestoneRequest.ID)
+	if err != nil {
+		return domain.Milestone{}, err
+	}
+
+	milestone.CompletedAt = completeMilestoneRequest.CompletedAt
+	if milestone.CompletedAt.IsZero() {
+		milestone.CompletedAt = time.Now()
+	}
+	milestone.Acceptance = domain.AcceptancePending
+	milestone.ReviewedBy = 0
+	milestone.ReviewNote = ""
+
+	err = s.validate(ctx, milestone)
+	if err != nil {
+		return domain.Milestone{}, err
+	}
+	return s.updateMilestone(ctx, milestone)
+}
+
+func(s *milestoneService) ReviewMilestone(ctx context.Context, reviewMilestoneRequest ReviewMilestoneRequest) (domain.Milestone, error) {
+	milestone, err := s.editableMilestone(ctx, reviewMilestoneRequest.ID)
+	if err != nil {
+		return domain.Milestone{}, err
+	}
+	if !milestone.Completed() {
+		return domain.Milestone{}, ErrNotCompleted
+	}
+
+	milestone.Acceptance = domain.AcceptanceRejected
+	if reviewMilestoneRequest.Accepted {
+		milestone.Acceptance = domain.AcceptanceAccepted
+	}
+	milestone.ReviewedBy, _ = actor.IDFromContext(ctx)
+	milestone.ReviewNote = reviewMilestoneRequest.Note
+	return s.updateMilestone(ctx, milestone)
+}
+
+func(s *milestoneService) validate(ctx context.Context, milestone domain.Milestone) error {
+	if milestone.Name == "" {
+		return ErrNameRequired
+	}
+	if milestone.TargetDate.IsZero() {
+		return ErrTargetDateRequired
+	}
+
+	project, err := s.projectRepo.GetProject(ctx, milestone.ProjectID)
+	switch err {
+	case nil:
+	case projectPorts.ErrProjectNotFound:
+		return ErrProjectNotFound
+	default:
+		return err
+	}
+	if !milestone.Within(project.StartDate, project.EndDate) {
+		return ErrOutsideProject
+	}
+
+	for _, taskID := range milestone.TaskIDs {
+		task, err := s.taskRepo.GetTask(ctx, taskID)
+		switch err {
+		case nil:
+		case taskPorts.ErrTaskNotFound:
+			return ErrInvalidTask
+		default:
+			return err
+		}
+		if task.ProjectID != milestone.ProjectID {
+			return ErrInvalidTask
+		}
+	}
+	return nil
+}
+
+func(s *milestoneService) editableMilestone(ctx context.Context, id int) (domain.Milestone, error) {
+	milestone, err := s.getMilestone(ctx, id)
+	if err != nil {
+		return domain.Milestone{}, err
+	}
+
+	err = s.membershipService.Authorize(ctx, milestone.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Milestone{}, err
+	}
+	return milestone, nil
+}
+
+func(s *milestoneService) getMilestone(ctx context.Context, id int) (domain.Milestone, error) {
+	milestone, err := s.repo.GetMilestone(ctx, id)
+	switch err {
+	case ports.ErrMilestoneNotFound:
+		return domain.Milestone{}, ErrMilestoneNotFound
+	}
+	return milestone, err
+}
+
+func(s *milestoneService) updateMilestone(ctx context.Context, milestone domain.Milestone) (domain.Milestone, error) {
+	updatedMilestone, err := s.repo.UpdateMilestone(ctx, milestone)
+	switch err {
+	case ports.ErrMilestoneNotFound:
+		return domain.Milestone{}, ErrMilestoneNotFound
+	}
+	return updatedMilestone, err
+}
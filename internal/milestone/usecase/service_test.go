@@ -0,0 +1,200 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	"github.com/captainhbb/tbs-backend/internal/milestone/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/milestone/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/milestone/usecase"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	taskDomain "github.com/captainhbb/tbs-backend/internal/task/domain"
+	taskPortsMock "github.com/captainhbb/tbs-backend/internal/task/ports/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	projectStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	projectEnd = time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+)
+
+func TestCreateMilestone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		input usecase.CreateMilestoneRequest
+		mockSetup func(repo *portsMock.MockRepository, taskRepo *taskPortsMock.MockRepository)
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "success",
+			input: usecase.CreateMilestoneRequest{ProjectID: 1, Name: "Beta", TargetDate: projectStart.AddDate(0, 3, 0), TaskIDs: []int{4}},
+			mockSetup: func(repo *portsMock.MockRepository, taskRepo *taskPortsMock.MockRepository) {
+				taskRepo.On("GetTask", mock.Anything, 4).Return(taskDomain.Task{ID: 4, ProjectID: 1}, nil)
+				repo.On("CreateMilestone", mock.Anything, mock.Anything).Return(func(_ context.Context, milestone domain.Milestone) (domain.Milestone, error) {
+					milestone.ID = 1
+					return milestone, nil
+				})
+			},
+		},
+		{
+			name: "target before project start",
+			input: usecase.CreateMilestoneRequest{ProjectID: 1, Name: "Beta", TargetDate: projectStart.AddDate(0, 0, -1)},
+			mockSetup: func(_ *portsMock.MockRepository, _ *taskPortsMock.MockRepository) {},
+			expectError: true,
+			expectedError: usecase.ErrOutsideProject,
+		},
+		{
+			name: "target after project end",
+			input: usecase.CreateMilestoneRequest{ProjectID: 1, Name: "Beta", TargetDate: projectEnd.AddDate(0, 0, 1)},
+			mockSetup: func(_ *portsMock.MockRepository, _ *taskPortsMock.MockRepository) {},
+			expectError: true,
+			expectedError: usecase.ErrOutsideProject,
+		},
+		{
+			name: "task from another project",
+			input: usecase.CreateMilestoneRequest{ProjectID: 1, Name: "Beta", TargetDate: projectStart, TaskIDs: []int{4}},
+			mockSetup: func(_ *portsMock.MockRepository, taskRepo *taskPortsMock.MockRepository) {
+				taskRepo.On("GetTask", mock.Anything, 4).Return(taskDomain.Task{ID: 4, ProjectID: 2}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrInvalidTask,
+		},
+		{
+			name: "missing target date",
+			input: usecase.CreateMilestoneRequest{ProjectID: 1, Name: "Beta"},
+			mockSetup: func(_ *portsMock.MockRepository, _ *taskPortsMock.MockRepository) {},
+			expectError: true,
+			expectedError: usecase.ErrTargetDateRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			taskRepoMock := taskPortsMock.NewMockRepository(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			service := usecase.New(repoMock, projectRepoMock, taskRepoMock, membershipServiceMock)
+
+			membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+			projectRepoMock.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, StartDate: projectStart, EndDate: projectEnd}, nil).Maybe()
+			tt.mockSetup(repoMock, taskRepoMock)
+
+			milestone, err := service.CreateMilestone(context.Background(), tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, domain.AcceptancePending, milestone.Acceptance)
+				require.False(t, milestone.Completed())
+			}
+		})
+	}
+}
+
+func TestCompleteMilestone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		completedAt time.Time
+		expectedError error
+	}{
+		{
+			name: "later on the project's last day",
+			completedAt: projectEnd.Add(17 * time.Hour),
+		},
+		{
+			name: "the day after the project ends",
+			completedAt: projectEnd.AddDate(0, 0, 1),
+			expectedError: usecase.ErrOutsideProject,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			service := usecase.New(repoMock, projectRepoMock, taskPortsMock.NewMockRepository(t), membershipServiceMock)
+
+			repoMock.On("GetMilestone", mock.Anything, 1).Return(domain.Milestone{ID: 1, ProjectID: 1, Name: "Launch", TargetDate: projectEnd}, nil)
+			membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+			projectRepoMock.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, StartDate: projectStart, EndDate: projectEnd}, nil)
+			repoMock.On("UpdateMilestone", mock.Anything, mock.Anything).Return(func(_ context.Context, milestone domain.Milestone) (domain.Milestone, error) {
+				return milestone, nil
+			}).Maybe()
+
+			milestone, err := service.CompleteMilestone(context.Background(), usecase.CompleteMilestoneRequest{ID: 1, CompletedAt: tt.completedAt})
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.completedAt, milestone.CompletedAt)
+			}
+		})
+	}
+}
+
+func TestReviewMilestone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		existing domain.Milestone
+		accepted bool
+		expectedAcceptance string
+		expectedError error
+	}{
+		{
+			name: "accept completed milestone",
+			existing: domain.Milestone{ID: 1, ProjectID: 1, Name: "Beta", CompletedAt: projectStart, Acceptance: domain.AcceptancePending},
+			accepted: true,
+			expectedAcceptance: domain.AcceptanceAccepted,
+		},
+		{
+			name: "reject completed milestone",
+			existing: domain.Milestone{ID: 1, ProjectID: 1, Name: "Beta", CompletedAt: projectStart, Acceptance: domain.AcceptancePending},
+			expectedAcceptance: domain.AcceptanceRejected,
+		},
+		{
+			name: "not completed yet",
+			existing: domain.Milestone{ID: 1, ProjectID: 1, Name: "Beta", Acceptance: domain.AcceptancePending},
+			accepted: true,
+			expectedError: usecase.ErrNotCompleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			service := usecase.New(repoMock, projectPortsMock.NewMockRepository(t), taskPortsMock.NewMockRepository(t), membershipServiceMock)
+
+			repoMock.On("GetMilestone", mock.Anything, 1).Return(tt.existing, nil)
+			membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+			repoMock.On("UpdateMilestone", mock.Anything, mock.Anything).Return(func(_ context.Context, milestone domain.Milestone) (domain.Milestone, error) {
+				return milestone, nil
+			}).Maybe()
+
+			ctx := actor.WithID(context.Background(), 3)
+			milestone, err := service.ReviewMilestone(ctx, usecase.ReviewMilestoneRequest{ID: 1, Accepted: tt.accepted, Note: "checked"})
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedAcceptance, milestone.Acceptance)
+				require.Equal(t, 3, milestone.ReviewedBy)
+			}
+		})
+	}
+}
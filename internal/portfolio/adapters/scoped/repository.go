@@ -0,0 +1,125 @@
+// Package scoped confines a portfolio repository to the tenant carried in the
+// request context. Portfolios of other tenants, and the projects they hold,
+// are reported as not found. Platform calls see every tenant, and requests
+// carrying neither see no portfolios at all.
+package scoped
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/portfolio/domain"
+	"github.com/captainhbb/tbs-backend/internal/portfolio/ports"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+)
+
+type repository struct {
+	next		ports.Repository
+}
+
+// New wraps next so that every query is filtered by the tenant in ctx and new
+// portfolios are created within it, which fails with tenant.ErrTenantRequired
+// when ctx carries none.
+func New(next ports.Repository) ports.Repository {
+	return &repository{next: next}
+}
+
+func(r *repository) CreatePortfolio(ctx context.Context, portfolio domain.Portfolio) (domain.Portfolio, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return domain.Portfolio{}, err
+	}
+	portfolio.TenantID = tenantID
+	return r.next.CreatePortfolio(ctx, portfolio)
+}
+
+func(r *repository) GetPortfolio(ctx context.Context, id int) (domain.Portfolio, error) {
+	portfolio, err := r.next.GetPortfolio(ctx, id)
+	if err != nil {
+		return domain.Portfolio{}, err
+	}
+	if !tenant.Visible(ctx, portfolio.TenantID) {
+		return domain.Portfolio{}, ports.ErrPortfolioNotFound
+	}
+	return portfolio, nil
+}
+
+// UpdatePortfolio keeps the portfolio in the tenant it belongs to.
+func(r *repository) UpdatePortfolio(ctx context.Context, portfolio domain.Portfolio) (domain.Portfolio, error) {
+	existingPortfolio, err := r.GetPortfolio(ctx, portfolio.ID)
+	if err != nil {
+		return domain.Portfolio{}, err
+	}
+	portfolio.TenantID = existingPortfolio.TenantID
+	return r.next.UpdatePortfolio(ctx, portfolio)
+}
+
+func(r *repository) DeletePortfolio(ctx context.Context, id int) error {
+	_, err := r.GetPortfolio(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeletePortfolio(ctx, id)
+}
+
+func(r *repository) ListPortfolios(ctx context.Context, parentID int) ([]domain.Portfolio, error) {
+	portfolios, err := r.next.ListPortfolios(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]domain.Portfolio, 0, len(portfolios))
+	for _, portfolio := range portfolios {
+		if tenant.Visible(ctx, portfolio.TenantID) {
+			visible = append(visible, portfolio)
+		}
+	}
+	return visible, nil
+}
+
+// ListAncestors checks the portfolio itself; its parents are always in the
+// same tenant.
+func(r *repository) ListAncestors(ctx context.Context, id int) ([]domain.Portfolio, error) {
+	ancestors, err := r.next.ListAncestors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(ancestors) > 0 && !tenant.Visible(ctx, ancestors[0].TenantID) {
+		return nil, ports.ErrPortfolioNotFound
+	}
+	return ancestors, nil
+}
+
+func(r *repository) AddProject(ctx context.Context, portfolioID int, projectID int) error {
+	_, err := r.GetPortfolio(ctx, portfolioID)
+	if err != nil {
+		return err
+	}
+	return r.next.AddProject(ctx, portfolioID, projectID)
+}
+
+func(r *repository) RemoveProject(ctx context.Context, portfolioID int, projectID int) error {
+	_, err := r.GetPortfolio(ctx, portfolioID)
+	if err != nil {
+		return err
+	}
+	return r.next.RemoveProject(ctx, portfolioID, projectID)
+}
+
+func(r *repository) ListProjectIDs(ctx context.Context, portfolioID int) ([]int, error) {
+	_, err := r.GetPortfolio(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListProjectIDs(ctx, portfolioID)
+}
+
+func(r *repository) GetProjectPortfolio(ctx context.Context, projectID int) (domain.Portfolio, error) {
+	portfolio, err := r.next.GetProjectPortfolio(ctx, projectID)
+	if err != nil {
+		return domain.Portfolio{}, err
+	}
+	if !tenant.Visible(ctx, portfolio.TenantID) {
+		return domain.Portfolio{}, ports.ErrPortfolioNotFound
+	}
+	return portfolio, nil
+}
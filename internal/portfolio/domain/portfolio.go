@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+// Portfolio groups projects under a manager. A portfolio with a parent is a
+// program within that parent; programs may nest.
+type Portfolio struct {
+	ID 					int
+	// TenantID is the organization the portfolio belongs to. Programs are in
+	// the tenant of their parent.
+	TenantID			int
+	Name				string
+	Description			string
+	// ParentID is zero for a top-level portfolio.
+	ParentID			int
+	// ManagerID may view every project in the portfolio and its programs, and
+	// manage the programs beneath it.
+	ManagerID			int
+	CreatedAt			time.Time
+}
+
+// Summary aggregates the projects of a portfolio and all of its programs.
+// Budget and Actual are the proposed budgets and the spend to AsOf, expressed
+// in Currency at the rates of AsOf. StartDate and EndDate span the projects'
+// dates and are zero when no project has one.
+type Summary struct {
+	PortfolioID			int
+	Currency			string
+	AsOf				time.Time
+	ProjectCount		int
+	Budget				money.Money
+	Actual				money.Money
+	Remaining			money.Money
+	// StatusCounts counts projects by status.
+	StatusCounts		map[string]int
+	StartDate			time.Time
+	EndDate				time.Time
+}
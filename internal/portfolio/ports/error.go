@@ -0,0 +1,9 @@
+package ports
+
+import "errors"
+
+var (
+	ErrPortfolioNotFound			= errors.New("portfolio not found")
+	ErrProjectAlreadyInPortfolio	= errors.New("project already belongs to a portfolio")
+	ErrProjectNotInPortfolio		= errors.New("project is not in the portfolio")
+)
@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/portfolio/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	CreatePortfolio(ctx context.Context, portfolio domain.Portfolio) (domain.Portfolio, error)
+	GetPortfolio(ctx context.Context, id int) (domain.Portfolio, error)
+	UpdatePortfolio(ctx context.Context, portfolio domain.Portfolio) (domain.Portfolio, error)
+	DeletePortfolio(ctx context.Context, id int) error
+	// ListPortfolios returns the programs directly beneath parentID, or the
+	// top-level portfolios when parentID is zero.
+	ListPortfolios(ctx context.Context, parentID int) ([]domain.Portfolio, error)
+	// ListAncestors returns the portfolio followed by its parents up to its
+	// top-level portfolio.
+	ListAncestors(ctx context.Context, id int) ([]domain.Portfolio, error)
+	// AddProject returns ErrProjectAlreadyInPortfolio when the project belongs
+	// to any portfolio already.
+	AddProject(ctx context.Context, portfolioID int, projectID int) error
+	RemoveProject(ctx context.Context, portfolioID int, projectID int) error
+	// ListProjectIDs returns the projects directly in the portfolio.
+	ListProjectIDs(ctx context.Context, portfolioID int) ([]int, error)
+	// GetProjectPortfolio returns ErrPortfolioNotFound for projects in no
+	// portfolio.
+	GetProjectPortfolio(ctx context.Context, projectID int) (domain.Portfolio, error)
+}
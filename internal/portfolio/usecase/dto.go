@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"time"
+)
+
+// CreatePortfolioRequest creates a top-level portfolio, or a program within
+// ParentID when it is set.
+type CreatePortfolioRequest struct {
+	Name 					string
+	Description 			string
+	ParentID 				int
+	ManagerID 				int
+}
+
+type UpdatePortfolioRequest struct {
+	ID 						int
+	Name 					string
+	Description 			string
+	ParentID 				int
+	ManagerID 				int
+}
+
+type PortfolioProjectRequest struct {
+	PortfolioID 			int
+	ProjectID 				int
+}
+
+// PortfolioSummaryRequest aggregates a portfolio in Currency at the rates of
+// AsOf, which also ends the spend counted. Zero means now.
+type PortfolioSummaryRequest struct {
+	ID 						int
+	Currency 				string
+	AsOf 					time.Time
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrPortfolioNotFound			= errors.New("portfolio not found")
+	ErrManagerNotFound				= errors.New("portfolio manager not found")
+	ErrNameRequired					= errors.New("a portfolio needs a name")
+	ErrForbidden					= errors.New("only admins and the managers above a portfolio can manage it")
+	ErrManagerChangeForbidden		= errors.New("only admins can change a portfolio's manager")
+	ErrPortfolioCycle				= errors.New("a portfolio cannot be moved beneath itself")
+	ErrPortfolioNotEmpty			= errors.New("portfolio still holds programs or projects")
+	ErrProjectAlreadyInPortfolio	= errors.New("project already belongs to a portfolio")
+	ErrProjectNotInPortfolio		= errors.New("project is not in the portfolio")
+	ErrUnknownCurrency				= errors.New("unknown reporting currency")
+)
@@ -0,0 +1,313 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	exchangeUseCase "github.com/captainhbb/tbs-backend/internal/exchange/usecase"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	"github.com/captainhbb/tbs-backend/internal/portfolio/domain"
+	"github.com/captainhbb/tbs-backend/internal/portfolio/ports"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectUseCase "github.com/captainhbb/tbs-backend/internal/project/usecase"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+)
+
+//go:generate mockery --dir . --name PortfolioService --structname MockPortfolioService --filename mock_portfolio_service.go --output ./mock --outpkg mock
+type PortfolioService interface {
+	// CreatePortfolio is restricted to global admins for top-level portfolios
+	// and to those who manage the parent for programs.
+	CreatePortfolio(ctx context.Context, portfolio CreatePortfolioRequest) (domain.Portfolio, error)
+	GetPortfolio(ctx context.Context, id int) (domain.Portfolio, error)
+	// UpdatePortfolio leaves changing the manager to global admins.
+	UpdatePortfolio(ctx context.Context, portfolio UpdatePortfolioRequest) (domain.Portfolio, error)
+	// DeletePortfolio only deletes portfolios without programs or projects.
+	DeletePortfolio(ctx context.Context, id int) error
+	// ListPortfolios returns the programs directly beneath parentID, or the
+	// top-level portfolios when parentID is zero.
+	ListPortfolios(ctx context.Context, parentID int) ([]domain.Portfolio, error)
+	// AddProject also needs the administer permission on the project, since
+	// the portfolio's managers gain view access to it.
+	AddProject(ctx context.Context, project PortfolioProjectRequest) error
+	// RemoveProject needs the administer permission on the project as well.
+	RemoveProject(ctx context.Context, project PortfolioProjectRequest) error
+	// ListPortfolioProjects returns the projects of the portfolio and of all
+	// its programs.
+	ListPortfolioProjects(ctx context.Context, id int) ([]projectDomain.Project, error)
+	SummarizePortfolio(ctx context.Context, summary PortfolioSummaryRequest) (domain.Summary, error)
+}
+
+type portfolioService struct {
+	repo ports.Repository
+	projectService projectUseCase.ProjectService
+	exchangeService exchangeUseCase.ExchangeService
+	userService userUseCase.UserService
+	membershipService membershipUseCase.MembershipService
+}
+
+func New(repo ports.Repository, projectService projectUseCase.ProjectService, exchangeService exchangeUseCase.ExchangeService, userService userUseCase.UserService, membershipService membershipUseCase.MembershipService) PortfolioService {
+	return &portfolioService{
+		repo: repo,
+		projectService: projectService,
+		exchangeService: exchangeService,
+		userService: userService,
+		membershipService: membershipService,
+	}
+}
+
+func(s *portfolioService) CreatePortfolio(ctx context.Context, createPortfolioRequest CreatePortfolioRequest) (domain.Portfolio, error) {
+	portfolio := domain.Portfolio{
+		Name: strings.TrimSpace(createPortfolioRequest.Name),
+		Description: createPortfolioRequest.Description,
+		ParentID: createPortfolioRequest.ParentID,
+		ManagerID: createPortfolioRequest.ManagerID,
+		CreatedAt: time.Now(),
+	}
+	if portfolio.Name == "" {
+		return domain.Portfolio{}, ErrNameRequired
+	}
+
+	err := s.authorizeParent(ctx, portfolio.ParentID)
+	if err != nil {
+		return domain.Portfolio{}, err
+	}
+	err = s.checkManager(ctx, portfolio.ManagerID)
+	if err != nil {
+		return domain.Portfolio{}, err
+	}
+	return s.repo.CreatePortfolio(ctx, portfolio)
+}
+
+func(s *portfolioService) GetPortfolio(ctx context.Context, id int) (domain.Portfolio, error) {
+	return s.authorize(ctx, id)
+}
+
+func(s *portfolioService) UpdatePortfolio(ctx context.Context, updatePortfolioRequest UpdatePortfolioRequest) (domain.Portfolio, error) {
+	portfolio, err := s.authorize(ctx, updatePortfolioRequest.ID)
+	if err != nil {
+		return domain.Portfolio{}, err
+	}
+
+	name := strings.TrimSpace(updatePortfolioRequest.Name)
+	if name == "" {
+		return domain.Portfolio{}, ErrNameRequired
+	}
+
+	if updatePortfolioRequest.ParentID != portfolio.ParentID {
+		err = s.authorizeParent(ctx, updatePortfolioRequest.ParentID)
+		if err != nil {
+			return domain.Portfolio{}, err
+		}
+		if updatePortfolioRequest.ParentID != 0 {
+			ancestors, err := s.repo.ListAncestors(ctx, updatePortfolioRequest.ParentID)
+			if err != nil {
+				return domain.Portfolio{}, err
+			}
+			for _, ancestor := range ancestors {
+				if ancestor.ID == portfolio.ID {
+					return domain.Portfolio{}, ErrPortfolioCycle
+				}
+			}
+		}
+	}
+	if updatePortfolioRequest.ManagerID != portfolio.ManagerID {
+		err = s.requireAdmin(ctx)
+		switch err {
+		case nil:
+		case ErrForbidden:
+			return domain.Portfolio{}, ErrManagerChangeForbidden
+		default:
+			return domain.Portfolio{}, err
+		}
+		err = s.checkManager(ctx, updatePortfolioRequest.ManagerID)
+		if err != nil {
+			return domain.Portfolio{}, err
+		}
+	}
+
+	portfolio.Name = name
+	portfolio.Description = updatePortfolioRequest.Description
+	portfolio.ParentID = updatePortfolioRequest.ParentID
+	portfolio.ManagerID = updatePortfolioRequest.ManagerID
+	updatedPortfolio, err := s.repo.UpdatePortfolio(ctx, portfolio)
+	switch err {
+	case ports.ErrPortfolioNotFound:
+		return domain.Portfolio{}, ErrPortfolioNotFound
+	}
+	return updatedPortfolio, err
+}
+
+func(s *portfolioService) DeletePortfolio(ctx context.Context, id int) error {
+	_, err := s.authorize(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	programs, err := s.repo.ListPortfolios(ctx, id)
+	if err != nil {
+		return err
+	}
+	projectIDs, err := s.repo.ListProjectIDs(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(programs) > 0 || len(projectIDs) > 0 {
+		return ErrPortfolioNotEmpty
+	}
+
+	err = s.repo.DeletePortfolio(ctx, id)
+	switch err {
+	case ports.ErrPortfolioNotFound:
+		return ErrPortfolioNotFound
+	}
+	return err
+}
+
+func(s *portfolioService) ListPortfolios(ctx context.Context, parentID int) ([]domain.Portfolio, error) {
+	err := s.authorizeParent(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListPortfolios(ctx, parentID)
+}
+
+func(s *portfolioService) AddProject(ctx context.Context, projectRequest PortfolioProjectRequest) error {
+	_, err := s.authorize(ctx, projectRequest.PortfolioID)
+	if err != nil {
+		return err
+	}
+	err = s.membershipService.Authorize(ctx, projectRequest.ProjectID, membershipDomain.PermissionAdminister)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.AddProject(ctx, projectRequest.PortfolioID, projectRequest.ProjectID)
+	switch err {
+	case ports.ErrProjectAlreadyInPortfolio:
+		return ErrProjectAlreadyInPortfolio
+	}
+	return err
+}
+
+func(s *portfolioService) RemoveProject(ctx context.Context, projectRequest PortfolioProjectRequest) error {
+	_, err := s.authorize(ctx, projectRequest.PortfolioID)
+	if err != nil {
+		return err
+	}
+	err = s.membershipService.Authorize(ctx, projectRequest.ProjectID, membershipDomain.PermissionAdminister)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.RemoveProject(ctx, projectRequest.PortfolioID, projectRequest.ProjectID)
+	switch err {
+	case ports.ErrProjectNotInPortfolio:
+		return ErrProjectNotInPortfolio
+	}
+	return err
+}
+
+func(s *portfolioService) ListPortfolioProjects(ctx context.Context, id int) ([]projectDomain.Project, error) {
+	_, err := s.authorize(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	projectIDs, err := s.projectIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	projects := make([]projectDomain.Project, 0, len(projectIDs))
+	for _, projectID := range projectIDs {
+		project, err := s.projectService.GetProject(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+	return projects, nil
+}
+
+// projectIDs collects the projects of the portfolio and its programs, depth
+// first.
+func(s *portfolioService) projectIDs(ctx context.Context, id int) ([]int, error) {
+	projectIDs, err := s.repo.ListProjectIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	programs, err := s.repo.ListPortfolios(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, program := range programs {
+		programProjectIDs, err := s.projectIDs(ctx, program.ID)
+		if err != nil {
+			return nil, err
+		}
+		projectIDs = append(projectIDs, programProjectIDs...)
+	}
+	return projectIDs, nil
+}
+
+// authorize loads a portfolio that the acting user is an admin for or manages,
+// directly or through a portfolio above it.
+func(s *portfolioService) authorize(ctx context.Context, id int) (domain.Portfolio, error) {
+	ancestors, err := s.repo.ListAncestors(ctx, id)
+	switch err {
+	case nil:
+	case ports.ErrPortfolioNotFound:
+		return domain.Portfolio{}, ErrPortfolioNotFound
+	default:
+		return domain.Portfolio{}, err
+	}
+	if len(ancestors) == 0 {
+		return domain.Portfolio{}, ErrPortfolioNotFound
+	}
+
+	actorID, ok := actor.IDFromContext(ctx)
+	if ok {
+		for _, ancestor := range ancestors {
+			if ancestor.ManagerID == actorID {
+				return ancestors[0], nil
+			}
+		}
+	}
+
+	err = s.requireAdmin(ctx)
+	if err != nil {
+		return domain.Portfolio{}, err
+	}
+	return ancestors[0], nil
+}
+
+// authorizeParent checks that the acting user may place portfolios beneath
+// parentID: anyone managing it, or global admins at the top level.
+func(s *portfolioService) authorizeParent(ctx context.Context, parentID int) error {
+	if parentID != 0 {
+		_, err := s.authorize(ctx, parentID)
+		return err
+	}
+	return s.requireAdmin(ctx)
+}
+
+func(s *portfolioService) requireAdmin(ctx context.Context) error {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin)
+	switch err {
+	case userUseCase.ErrForbidden:
+		return ErrForbidden
+	}
+	return err
+}
+
+func(s *portfolioService) checkManager(ctx context.Context, managerID int) error {
+	_, err := s.userService.GetUser(ctx, managerID)
+	switch err {
+	case userUseCase.ErrUserNotFound:
+		return ErrManagerNotFound
+	}
+	return err
+}
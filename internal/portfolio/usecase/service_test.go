@@ -0,0 +1,275 @@
+package usecase_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	exchangeDomain "github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	exchangeUseCase "github.com/captainhbb/tbs-backend/internal/exchange/usecase"
+	exchangeUseCaseMock "github.com/captainhbb/tbs-backend/internal/exchange/usecase/mock"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	"github.com/captainhbb/tbs-backend/internal/portfolio/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/portfolio/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/portfolio/usecase"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectUseCase "github.com/captainhbb/tbs-backend/internal/project/usecase"
+	projectUseCaseMock "github.com/captainhbb/tbs-backend/internal/project/usecase/mock"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// The PMO portfolio (1, managed by user 2) holds the platform program (2,
+// managed by user 3), which holds the migration program (3, managed by user 4).
+var (
+	pmo = domain.Portfolio{ID: 1, Name: "PMO", ManagerID: 2}
+	platform = domain.Portfolio{ID: 2, Name: "Platform", ParentID: 1, ManagerID: 3}
+	migration = domain.Portfolio{ID: 3, Name: "Migration", ParentID: 2, ManagerID: 4}
+)
+
+type mocks struct {
+	repo *portsMock.MockRepository
+	projectService *projectUseCaseMock.MockProjectService
+	exchangeService *exchangeUseCaseMock.MockExchangeService
+	membershipService *membershipUseCaseMock.MockMembershipService
+}
+
+func newService(t *testing.T) (usecase.PortfolioService, mocks) {
+	m := mocks{
+		repo: portsMock.NewMockRepository(t),
+		projectService: projectUseCaseMock.NewMockProjectService(t),
+		exchangeService: exchangeUseCaseMock.NewMockExchangeService(t),
+		membershipService: membershipUseCaseMock.NewMockMembershipService(t),
+	}
+	m.repo.On("ListAncestors", mock.Anything, 1).Return([]domain.Portfolio{pmo}, nil).Maybe()
+	m.repo.On("ListAncestors", mock.Anything, 2).Return([]domain.Portfolio{platform, pmo}, nil).Maybe()
+	m.repo.On("ListAncestors", mock.Anything, 3).Return([]domain.Portfolio{migration, platform, pmo}, nil).Maybe()
+
+	userServiceMock := userUseCaseMock.NewMockUserService(t)
+	userServiceMock.On("RequireRole", mock.Anything, userDomain.RoleAdmin).Return(func(ctx context.Context, _ ...string) error {
+		if actorID, _ := actor.IDFromContext(ctx); actorID != 1 {
+			return userUseCase.ErrForbidden
+		}
+		return nil
+	}).Maybe()
+	userServiceMock.On("GetUser", mock.Anything, 1).Return(userDomain.User{ID: 1, Role: userDomain.RoleAdmin}, nil).Maybe()
+	for userID := 2; userID <= 5; userID++ {
+		userServiceMock.On("GetUser", mock.Anything, userID).Return(userDomain.User{ID: userID, Role: userDomain.RoleManager}, nil).Maybe()
+	}
+
+	service := usecase.New(m.repo, m.projectService, m.exchangeService, userServiceMock, m.membershipService)
+	return service, m
+}
+
+func TestCreatePortfolio(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		actorID int
+		input usecase.CreatePortfolioRequest
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "admin creates a portfolio",
+			actorID: 1,
+			input: usecase.CreatePortfolioRequest{Name: "Operations", ManagerID: 5},
+		},
+		{
+			name: "portfolio manager creates a nested program",
+			actorID: 2,
+			input: usecase.CreatePortfolioRequest{Name: "Data", ParentID: 3, ManagerID: 5},
+		},
+		{
+			name: "manager creates a portfolio",
+			actorID: 2,
+			input: usecase.CreatePortfolioRequest{Name: "Operations", ManagerID: 5},
+			expectError: true,
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "program manager creates a sibling program",
+			actorID: 4,
+			input: usecase.CreatePortfolioRequest{Name: "Data", ParentID: 2, ManagerID: 5},
+			expectError: true,
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "no name",
+			actorID: 1,
+			input: usecase.CreatePortfolioRequest{Name: " ", ManagerID: 5},
+			expectError: true,
+			expectedError: usecase.ErrNameRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newService(t)
+			m.repo.On("CreatePortfolio", mock.Anything, mock.Anything).Return(func(_ context.Context, portfolio domain.Portfolio) (domain.Portfolio, error) {
+				portfolio.ID = 9
+				return portfolio, nil
+			}).Maybe()
+
+			portfolio, err := service.CreatePortfolio(actor.WithID(context.Background(), tt.actorID), tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.input.ParentID, portfolio.ParentID)
+				require.Equal(t, tt.input.ManagerID, portfolio.ManagerID)
+			}
+		})
+	}
+}
+
+func TestUpdatePortfolioRejectsCycles(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newService(t)
+	_, err := service.UpdatePortfolio(actor.WithID(context.Background(), 1), usecase.UpdatePortfolioRequest{ID: 2, Name: "Platform", ParentID: 3, ManagerID: 3})
+	require.ErrorIs(t, err, usecase.ErrPortfolioCycle)
+}
+
+func TestUpdatePortfolioManagerIsLeftToAdmins(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		actorID int
+		managerID int
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "admin hands the program over",
+			actorID: 1,
+			managerID: 5,
+		},
+		{
+			name: "program manager renames the program",
+			actorID: 3,
+			managerID: 3,
+		},
+		{
+			name: "program manager hands the program over",
+			actorID: 3,
+			managerID: 5,
+			expectError: true,
+			expectedError: usecase.ErrManagerChangeForbidden,
+		},
+		{
+			name: "portfolio manager hands the program over",
+			actorID: 2,
+			managerID: 2,
+			expectError: true,
+			expectedError: usecase.ErrManagerChangeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newService(t)
+			m.repo.On("UpdatePortfolio", mock.Anything, mock.Anything).Return(func(_ context.Context, portfolio domain.Portfolio) (domain.Portfolio, error) {
+				return portfolio, nil
+			}).Maybe()
+
+			portfolio, err := service.UpdatePortfolio(actor.WithID(context.Background(), tt.actorID), usecase.UpdatePortfolioRequest{ID: 2, Name: "Platform team", ParentID: 1, ManagerID: tt.managerID})
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.managerID, portfolio.ManagerID)
+			}
+		})
+	}
+}
+
+func TestAddProjectNeedsProjectAdministration(t *testing.T) {
+	t.Parallel()
+
+	service, m := newService(t)
+	m.membershipService.On("Authorize", mock.Anything, 10, membershipDomain.PermissionAdminister).Return(membershipUseCase.ErrForbidden)
+
+	err := service.AddProject(actor.WithID(context.Background(), 3), usecase.PortfolioProjectRequest{PortfolioID: 2, ProjectID: 10})
+	require.ErrorIs(t, err, membershipUseCase.ErrForbidden)
+}
+
+func TestRemoveProjectNeedsProjectAdministration(t *testing.T) {
+	t.Parallel()
+
+	service, m := newService(t)
+	m.membershipService.On("Authorize", mock.Anything, 10, membershipDomain.PermissionAdminister).Return(membershipUseCase.ErrForbidden)
+
+	err := service.RemoveProject(actor.WithID(context.Background(), 3), usecase.PortfolioProjectRequest{PortfolioID: 2, ProjectID: 10})
+	require.ErrorIs(t, err, membershipUseCase.ErrForbidden)
+}
+
+func TestDeletePortfolioMustBeEmpty(t *testing.T) {
+	t.Parallel()
+
+	service, m := newService(t)
+	m.repo.On("ListPortfolios", mock.Anything, 2).Return([]domain.Portfolio{migration}, nil)
+	m.repo.On("ListProjectIDs", mock.Anything, 2).Return([]int{}, nil)
+
+	err := service.DeletePortfolio(actor.WithID(context.Background(), 1), 2)
+	require.ErrorIs(t, err, usecase.ErrPortfolioNotEmpty)
+}
+
+func TestSummarizePortfolio(t *testing.T) {
+	t.Parallel()
+
+	service, m := newService(t)
+	asOf := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	m.repo.On("ListProjectIDs", mock.Anything, 2).Return([]int{10}, nil)
+	m.repo.On("ListPortfolios", mock.Anything, 2).Return([]domain.Portfolio{migration}, nil)
+	m.repo.On("ListProjectIDs", mock.Anything, 3).Return([]int{11, 12}, nil)
+	m.repo.On("ListPortfolios", mock.Anything, 3).Return([]domain.Portfolio{}, nil)
+
+	projects := []projectDomain.Project{
+		{ID: 10, Status: "active", StartDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), ProposedBudget: money.MustNew(1000000, "USD")},
+		{ID: 11, Status: "active", StartDate: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC), ProposedBudget: money.MustNew(500000, "EUR")},
+		{ID: 12, Status: "planned", ProposedBudget: money.MustNew(200000, "USD")},
+	}
+	spent := map[int]money.Money{
+		10: money.MustNew(400000, "USD"),
+		11: money.MustNew(100000, "EUR"),
+		12: money.MustNew(0, "USD"),
+	}
+	for _, project := range projects {
+		m.projectService.On("GetProject", mock.Anything, project.ID).Return(project, nil)
+		m.projectService.On("ReportProjectSpend", mock.Anything, projectUseCase.ProjectSpendReportRequest{ProjectID: project.ID, AsOf: asOf}).
+			Return(projectDomain.SpendReport{ProjectID: project.ID, Actual: spent[project.ID]}, nil)
+	}
+	m.exchangeService.On("Convert", mock.Anything, mock.Anything).Return(func(_ context.Context, convert exchangeUseCase.ConvertRequest) (exchangeDomain.Conversion, error) {
+		converted, err := convert.Amount.MulRat(big.NewRat(11, 10))
+		if err != nil {
+			return exchangeDomain.Conversion{}, err
+		}
+		return exchangeDomain.Conversion{Original: convert.Amount, Converted: money.MustNew(converted.Amount(), convert.Currency), Rate: big.NewRat(11, 10)}, nil
+	})
+
+	// User 4 manages the migration program only, so it cannot see the platform.
+	_, err := service.SummarizePortfolio(actor.WithID(context.Background(), 4), usecase.PortfolioSummaryRequest{ID: 2, Currency: "USD", AsOf: asOf})
+	require.ErrorIs(t, err, usecase.ErrForbidden)
+
+	summary, err := service.SummarizePortfolio(actor.WithID(context.Background(), 2), usecase.PortfolioSummaryRequest{ID: 2, Currency: "USD", AsOf: asOf})
+	require.NoError(t, err)
+	require.Equal(t, 3, summary.ProjectCount)
+	require.Equal(t, money.MustNew(1750000, "USD"), summary.Budget)
+	require.Equal(t, money.MustNew(510000, "USD"), summary.Actual)
+	require.Equal(t, money.MustNew(1240000, "USD"), summary.Remaining)
+	require.Equal(t, map[string]int{"active": 2, "planned": 1}, summary.StatusCounts)
+	require.Equal(t, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), summary.StartDate)
+	require.Equal(t, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), summary.EndDate)
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	exchangeDomain "github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	exchangeUseCase "github.com/captainhbb/tbs-backend/internal/exchange/usecase"
+	"github.com/captainhbb/tbs-backend/internal/portfolio/domain"
+	projectUseCase "github.com/captainhbb/tbs-backend/internal/project/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+func(s *portfolioService) SummarizePortfolio(ctx context.Context, summaryRequest PortfolioSummaryRequest) (domain.Summary, error) {
+	zero, err := money.Zero(summaryRequest.Currency)
+	if err != nil {
+		return domain.Summary{}, ErrUnknownCurrency
+	}
+
+	portfolio, err := s.authorize(ctx, summaryRequest.ID)
+	if err != nil {
+		return domain.Summary{}, err
+	}
+
+	asOf := summaryRequest.AsOf
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+
+	projectIDs, err := s.projectIDs(ctx, portfolio.ID)
+	if err != nil {
+		return domain.Summary{}, err
+	}
+
+	summary := domain.Summary{
+		PortfolioID: portfolio.ID,
+		Currency: summaryRequest.Currency,
+		AsOf: exchangeDomain.Day(asOf),
+		ProjectCount: len(projectIDs),
+		Budget: zero,
+		Actual: zero,
+		StatusCounts: make(map[string]int),
+	}
+	for _, projectID := range projectIDs {
+		project, err := s.projectService.GetProject(ctx, projectID)
+		if err != nil {
+			return domain.Summary{}, err
+		}
+		summary.StatusCounts[project.Status]++
+		if !project.StartDate.IsZero() && (summary.StartDate.IsZero() || project.StartDate.Before(summary.StartDate)) {
+			summary.StartDate = project.StartDate
+		}
+		if project.EndDate.After(summary.EndDate) {
+			summary.EndDate = project.EndDate
+		}
+
+		budget, err := s.convert(ctx, project.ProposedBudget, summary.Currency, asOf)
+		if err != nil {
+			return domain.Summary{}, err
+		}
+		summary.Budget, err = summary.Budget.Add(budget)
+		if err != nil {
+			return domain.Summary{}, err
+		}
+
+		spend, err := s.projectService.ReportProjectSpend(ctx, projectUseCase.ProjectSpendReportRequest{ProjectID: projectID, AsOf: asOf})
+		if err != nil {
+			return domain.Summary{}, err
+		}
+		actual, err := s.convert(ctx, spend.Actual, summary.Currency, asOf)
+		if err != nil {
+			return domain.Summary{}, err
+		}
+		summary.Actual, err = summary.Actual.Add(actual)
+		if err != nil {
+			return domain.Summary{}, err
+		}
+	}
+
+	summary.Remaining, err = summary.Budget.Sub(summary.Actual)
+	if err != nil {
+		return domain.Summary{}, err
+	}
+	return summary, nil
+}
+
+func(s *portfolioService) convert(ctx context.Context, amount money.Money, currency string, on time.Time) (money.Money, error) {
+	if amount.Currency() == currency {
+		return amount, nil
+	}
+	conversion, err := s.exchangeService.Convert(ctx, exchangeUseCase.ConvertRequest{
+		Amount: amount,
+		Currency: currency,
+		On: on,
+	})
+	if err != nil {
+		return money.Money{}, err
+	}
+	return conversion.Converted, nil
+}
@@ -0,0 +1,308 @@
+// Package scoped confines a project repository to the tenant carried in the
+// request context. Projects and custom fields of other tenants, and the
+// transfers, revisions and approvals that belong to them, are reported as not
+// found. Platform calls see every tenant, and requests carrying neither see no
+// projects at all.
+package scoped
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	"github.com/captainhbb/tbs-backend/internal/project/ports"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+)
+
+type repository struct {
+	next		ports.Repository
+}
+
+// New wraps next so that every query is filtered by the tenant in ctx and new
+// projects and fields are created within it, which fails with
+// tenant.ErrTenantRequired when ctx carries none.
+func New(next ports.Repository) ports.Repository {
+	return &repository{next: next}
+}
+
+func(r *repository) CreateProject(ctx context.Context, project domain.Project) (domain.Project, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	project.TenantID = tenantID
+	return r.next.CreateProject(ctx, project)
+}
+
+func(r *repository) GetProject(ctx context.Context, id int) (domain.Project, error) {
+	project, err := r.next.GetProject(ctx, id)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	if !tenant.Visible(ctx, project.TenantID) {
+		return domain.Project{}, ports.ErrProjectNotFound
+	}
+	return project, nil
+}
+
+// UpdateProject keeps the project in the tenant it belongs to.
+func(r *repository) UpdateProject(ctx context.Context, project domain.Project) (domain.Project, error) {
+	existingProject, err := r.GetProject(ctx, project.ID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	project.TenantID = existingProject.TenantID
+	return r.next.UpdateProject(ctx, project)
+}
+
+func(r *repository) DeleteProject(ctx context.Context, id int) error {
+	err := r.checkProject(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeleteProject(ctx, id)
+}
+
+func(r *repository) ListProjectsByOwner(ctx context.Context, ownerID int) ([]domain.Project, error) {
+	projects, err := r.next.ListProjectsByOwner(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]domain.Project, 0, len(projects))
+	for _, project := range projects {
+		if tenant.Visible(ctx, project.TenantID) {
+			visible = append(visible, project)
+		}
+	}
+	return visible, nil
+}
+
+func(r *repository) ListProjects(ctx context.Context, filter domain.Filter) ([]domain.Project, error) {
+	projects, err := r.next.ListProjects(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]domain.Project, 0, len(projects))
+	for _, project := range projects {
+		if tenant.Visible(ctx, project.TenantID) {
+			visible = append(visible, project)
+		}
+	}
+	return visible, nil
+}
+
+func(r *repository) ChangeOwner(ctx context.Context, change domain.OwnerChange) (domain.Project, error) {
+	err := r.checkProject(ctx, change.ProjectID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	return r.next.ChangeOwner(ctx, change)
+}
+
+func(r *repository) ListOwnerChanges(ctx context.Context, projectID int) ([]domain.OwnerChange, error) {
+	err := r.checkProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListOwnerChanges(ctx, projectID)
+}
+
+func(r *repository) CreateOwnershipTransfer(ctx context.Context, transfer domain.OwnershipTransfer) (domain.OwnershipTransfer, error) {
+	err := r.checkProject(ctx, transfer.ProjectID)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	return r.next.CreateOwnershipTransfer(ctx, transfer)
+}
+
+func(r *repository) GetOwnershipTransfer(ctx context.Context, id int) (domain.OwnershipTransfer, error) {
+	transfer, err := r.next.GetOwnershipTransfer(ctx, id)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	err = r.checkProject(ctx, transfer.ProjectID)
+	switch err {
+	case nil:
+	case ports.ErrProjectNotFound:
+		return domain.OwnershipTransfer{}, ports.ErrOwnershipTransferNotFound
+	default:
+		return domain.OwnershipTransfer{}, err
+	}
+	return transfer, nil
+}
+
+func(r *repository) GetPendingOwnershipTransfer(ctx context.Context, projectID int) (domain.OwnershipTransfer, error) {
+	err := r.checkProject(ctx, projectID)
+	switch err {
+	case nil:
+	case ports.ErrProjectNotFound:
+		return domain.OwnershipTransfer{}, ports.ErrOwnershipTransferNotFound
+	default:
+		return domain.OwnershipTransfer{}, err
+	}
+	return r.next.GetPendingOwnershipTransfer(ctx, projectID)
+}
+
+func(r *repository) UpdateOwnershipTransfer(ctx context.Context, transfer domain.OwnershipTransfer) (domain.OwnershipTransfer, error) {
+	existingTransfer, err := r.GetOwnershipTransfer(ctx, transfer.ID)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	transfer.ProjectID = existingTransfer.ProjectID
+	return r.next.UpdateOwnershipTransfer(ctx, transfer)
+}
+
+func(r *repository) CreateRevision(ctx context.Context, revision domain.Revision) (domain.Revision, error) {
+	err := r.checkProject(ctx, revision.ProjectID)
+	if err != nil {
+		return domain.Revision{}, err
+	}
+	return r.next.CreateRevision(ctx, revision)
+}
+
+func(r *repository) GetRevision(ctx context.Context, projectID int, number int) (domain.Revision, error) {
+	err := r.checkProject(ctx, projectID)
+	switch err {
+	case nil:
+	case ports.ErrProjectNotFound:
+		return domain.Revision{}, ports.ErrRevisionNotFound
+	default:
+		return domain.Revision{}, err
+	}
+	return r.next.GetRevision(ctx, projectID, number)
+}
+
+func(r *repository) ListRevisions(ctx context.Context, projectID int) ([]domain.Revision, error) {
+	err := r.checkProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListRevisions(ctx, projectID)
+}
+
+func(r *repository) CreateBudgetApproval(ctx context.Context, approval domain.BudgetApproval) (domain.BudgetApproval, error) {
+	err := r.checkProject(ctx, approval.ProjectID)
+	if err != nil {
+		return domain.BudgetApproval{}, err
+	}
+	return r.next.CreateBudgetApproval(ctx, approval)
+}
+
+func(r *repository) GetBudgetApproval(ctx context.Context, id int) (domain.BudgetApproval, error) {
+	approval, err := r.next.GetBudgetApproval(ctx, id)
+	if err != nil {
+		return domain.BudgetApproval{}, err
+	}
+	err = r.checkProject(ctx, approval.ProjectID)
+	switch err {
+	case nil:
+	case ports.ErrProjectNotFound:
+		return domain.BudgetApproval{}, ports.ErrBudgetApprovalNotFound
+	default:
+		return domain.BudgetApproval{}, err
+	}
+	return approval, nil
+}
+
+func(r *repository) UpdateBudgetApproval(ctx context.Context, approval domain.BudgetApproval) (domain.BudgetApproval, error) {
+	existingApproval, err := r.GetBudgetApproval(ctx, approval.ID)
+	if err != nil {
+		return domain.BudgetApproval{}, err
+	}
+	approval.ProjectID = existingApproval.ProjectID
+	return r.next.UpdateBudgetApproval(ctx, approval)
+}
+
+func(r *repository) ListBudgetApprovals(ctx context.Context, projectID int) ([]domain.BudgetApproval, error) {
+	err := r.checkProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListBudgetApprovals(ctx, projectID)
+}
+
+func(r *repository) SetApprovedBudget(ctx context.Context, projectID int, budget money.Money) (domain.Project, error) {
+	err := r.checkProject(ctx, projectID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	return r.next.SetApprovedBudget(ctx, projectID, budget)
+}
+
+func(r *repository) CreateFieldDefinition(ctx context.Context, definition domain.FieldDefinition) (domain.FieldDefinition, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return domain.FieldDefinition{}, err
+	}
+	definition.TenantID = tenantID
+	return r.next.CreateFieldDefinition(ctx, definition)
+}
+
+func(r *repository) GetFieldDefinition(ctx context.Context, id int) (domain.FieldDefinition, error) {
+	definition, err := r.next.GetFieldDefinition(ctx, id)
+	if err != nil {
+		return domain.FieldDefinition{}, err
+	}
+	if !tenant.Visible(ctx, definition.TenantID) {
+		return domain.FieldDefinition{}, ports.ErrFieldDefinitionNotFound
+	}
+	return definition, nil
+}
+
+// UpdateFieldDefinition keeps the field in the tenant it belongs to.
+func(r *repository) UpdateFieldDefinition(ctx context.Context, definition domain.FieldDefinition) (domain.FieldDefinition, error) {
+	existingDefinition, err := r.GetFieldDefinition(ctx, definition.ID)
+	if err != nil {
+		return domain.FieldDefinition{}, err
+	}
+	definition.TenantID = existingDefinition.TenantID
+	return r.next.UpdateFieldDefinition(ctx, definition)
+}
+
+func(r *repository) DeleteFieldDefinition(ctx context.Context, id int) error {
+	_, err := r.GetFieldDefinition(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeleteFieldDefinition(ctx, id)
+}
+
+func(r *repository) ListFieldDefinitions(ctx context.Context) ([]domain.FieldDefinition, error) {
+	definitions, err := r.next.ListFieldDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]domain.FieldDefinition, 0, len(definitions))
+	for _, definition := range definitions {
+		if tenant.Visible(ctx, definition.TenantID) {
+			visible = append(visible, definition)
+		}
+	}
+	return visible, nil
+}
+
+// checkProject fails with ErrProjectNotFound unless the project is visible
+// from ctx.
+func(r *repository) checkProject(ctx context.Context, projectID int) error {
+	return CheckProject(ctx, r.next, projectID)
+}
+
+// CheckProject fails with ports.ErrProjectNotFound unless the project is
+// visible from ctx. Platform calls skip the lookup. Repositories of data that
+// belongs to a project use it to confine that data to the project's tenant.
+func CheckProject(ctx context.Context, repo ports.Repository, projectID int) error {
+	if tenant.IsPlatform(ctx) {
+		return nil
+	}
+	project, err := repo.GetProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if !tenant.Visible(ctx, project.TenantID) {
+		return ports.ErrProjectNotFound
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+const (
+	ApprovalStatusPending		= "pending"
+	ApprovalStatusApproved		= "approved"
+	ApprovalStatusRejected		= "rejected"
+	ApprovalStatusSuperseded	= "superseded"
+)
+
+// ApprovalThreshold requires sign-off by Role at Level for budgets of at least
+// Min.
+type ApprovalThreshold struct {
+	Level				int
+	Role				string
+	Min					money.Money
+}
+
+type ApprovalLevel struct {
+	Level				int
+	Role				string
+}
+
+type ApprovalDecision struct {
+	Level				int
+	ApproverID			int
+	Approved			bool
+	Comment				string
+	DecidedAt			time.Time
+}
+
+// BudgetApproval is a request to make Amount the project's approved budget. It
+// stays pending until every level in Levels has approved it, one level at a
+// time and lowest first, or until any level rejects it.
+type BudgetApproval struct {
+	ID 					int
+	ProjectID			int
+	RequestedBy			int
+	Amount				money.Money
+	Levels				[]ApprovalLevel
+	Decisions			[]ApprovalDecision
+	Status				string
+	RequestedAt			time.Time
+	DecidedAt			time.Time
+}
+
+// NextLevel returns the lowest level that has not approved the request yet.
+func (a BudgetApproval) NextLevel() (ApprovalLevel, bool) {
+	approved := make(map[int]bool, len(a.Decisions))
+	for _, decision := range a.Decisions {
+		if decision.Approved {
+			approved[decision.Level] = true
+		}
+	}
+	for _, level := range a.Levels {
+		if !approved[level.Level] {
+			return level, true
+		}
+	}
+	return ApprovalLevel{}, false
+}
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+// EarnedValue measures a project's cost and schedule performance as of AsOf.
+// BudgetAtCompletion is the proposed budget. PlannedValue is the share of it
+// scheduled to be done by AsOf, EarnedValue the share actually done according
+// to task progress, and ActualCost what was spent, labor included.
+//
+// CostPerformanceIndex (EV/AC) and SchedulePerformanceIndex (EV/PV) are zero
+// while their denominator is zero. EstimateAtCompletion is BAC/CPI, or
+// AC + (BAC - EV) while CPI is zero.
+type EarnedValue struct {
+	ProjectID					int
+	AsOf						time.Time
+	BudgetAtCompletion			money.Money
+	PlannedValue				money.Money
+	EarnedValue					money.Money
+	ActualCost					money.Money
+	CostVariance				money.Money
+	ScheduleVariance			money.Money
+	CostPerformanceIndex		float64
+	SchedulePerformanceIndex	float64
+	EstimateAtCompletion		money.Money
+}
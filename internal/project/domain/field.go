@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	FieldTypeText			= "text"
+	FieldTypeNumber			= "number"
+	FieldTypeDate			= "date"
+	FieldTypeEnum			= "enum"
+	FieldTypeUser			= "user"
+)
+
+// DateFieldLayout is the canonical form of date field values. Numbers are
+// stored in their shortest decimal form and users by their ID.
+const DateFieldLayout = "2006-01-02"
+
+var fieldTypes = map[string]bool{
+	FieldTypeText: true,
+	FieldTypeNumber: true,
+	FieldTypeDate: true,
+	FieldTypeEnum: true,
+	FieldTypeUser: true,
+}
+
+// FieldDefinition is a custom field admins define for the projects of their
+// tenant. Key and Type cannot change once the field exists.
+type FieldDefinition struct {
+	ID 					int
+	// TenantID is the organization the field is defined for.
+	TenantID			int
+	Key					string
+	Name				string
+	Type				string
+	// Options are the allowed values of an enum field.
+	Options				[]string
+	Required			bool
+}
+
+// Filter narrows a project listing. Zero-valued fields do not filter; a
+// project must carry every tag and every custom field value listed.
+type Filter struct {
+	OwnerID				int
+	Status				string
+	Tags				[]string
+	CustomFields		map[string]string
+}
+
+func IsValidFieldType(fieldType string) bool {
+	return fieldTypes[fieldType]
+}
+
+// Matches reports whether project satisfies every non-zero field of the
+// filter. Tags and custom field values are compared in their stored form.
+func (f Filter) Matches(project Project) bool {
+	if f.OwnerID != 0 && f.OwnerID != project.OwnerID {
+		return false
+	}
+	if f.Status != "" && f.Status != project.Status {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !project.HasTag(tag) {
+			return false
+		}
+	}
+	for key, value := range f.CustomFields {
+		if project.CustomFields[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (p Project) HasTag(tag string) bool {
+	for _, projectTag := range p.Tags {
+		if projectTag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeTags trims and lower-cases the tags, drops empty and duplicate ones
+// and sorts the rest. It returns nil when no tag is left.
+func NormalizeTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	var normalized []string
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	TransferStatusPending		= "pending"
+	TransferStatusCompleted		= "completed"
+	TransferStatusDeclined		= "declined"
+	TransferStatusExpired		= "expired"
+	TransferStatusCancelled		= "cancelled"
+)
+
+// OwnershipTransfer is a request to hand a project over to a new owner. Transfers
+// that require acceptance stay pending until the recipient responds or they expire.
+// A project has at most one pending transfer, and a transfer is cancelled once
+// the project changes hands some other way.
+type OwnershipTransfer struct {
+	ID 					int
+	ProjectID			int
+	FromOwnerID			int
+	ToOwnerID			int
+	Status				string
+	RequestedAt			time.Time
+	ExpiresAt			time.Time
+	RespondedAt			time.Time
+}
+
+// OwnerChange is a single entry of a project's ownership history.
+type OwnerChange struct {
+	ID 					int
+	ProjectID			int
+	PreviousOwnerID		int
+	NewOwnerID			int
+	ChangedAt			time.Time
+}
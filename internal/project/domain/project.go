@@ -2,16 +2,28 @@ package domain
 
 import (
 	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
 )
 
 
 type Project struct {
 	ID 					int
+	// TenantID is the organization the project belongs to.
+	TenantID			int
 	Name				string
 	Description			string
 	StartDate			time.Time
 	EndDate				time.Time
 	OwnerID				int
-	ProposedBudget		float64
+	ProposedBudget		money.Money
+	// ApprovedBudget only changes once a budget approval has been signed off at
+	// every required level.
+	ApprovedBudget		money.Money
 	Status				string
+	// Tags are free-form labels, stored trimmed, lower-case and sorted.
+	Tags				[]string
+	// CustomFields holds the values of the custom fields by key, in the
+	// canonical form of each field's type.
+	CustomFields		map[string]string
 }
\ No newline at end of file
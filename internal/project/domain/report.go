@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+// BudgetReport expresses the budgets of several projects in one reporting
+// currency as of a given date.
+type BudgetReport struct {
+	Currency			string
+	AsOf				time.Time
+	Lines				[]BudgetReportLine
+	Total				money.Money
+}
+
+type BudgetReportLine struct {
+	ProjectID			int
+	ProjectName			string
+	ProposedBudget		money.Money
+	ReportedBudget		money.Money
+	Rate				*big.Rat
+	RateDate			time.Time
+}
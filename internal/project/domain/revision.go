@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"time"
+)
+
+// Revision is a full snapshot of a project taken after one of its changes.
+// Numbers start at 1 and increase by one per project.
+type Revision struct {
+	ID 					int
+	ProjectID			int
+	Number				int
+	Snapshot			Project
+	ActorID				int
+	CreatedAt			time.Time
+}
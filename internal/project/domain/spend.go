@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+// SpendReport compares actual spend with the budget of a single project. All
+// amounts are in the currency of the project's proposed budget; BurnRate is
+// the average spend per day between the project start and AsOf. Actual
+// includes LaborCost, the cost of approved time, which is also reported under
+// the labor category.
+type SpendReport struct {
+	ProjectID			int
+	AsOf				time.Time
+	Budget				money.Money
+	Actual				money.Money
+	Remaining			money.Money
+	PercentConsumed		float64
+	BurnRate			money.Money
+	LaborCost			money.Money
+	Categories			[]CategorySpend
+}
+
+// CategorySpend is the share of a SpendReport for one budget category. Budget
+// is the sum of the category's planned line items.
+type CategorySpend struct {
+	Category			string
+	Budget				money.Money
+	Actual				money.Money
+	Remaining			money.Money
+	PercentConsumed		float64
+	BurnRate			money.Money
+}
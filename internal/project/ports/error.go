@@ -4,4 +4,10 @@ import "errors"
 
 var (
 	ErrProjectNotFound = errors.New("project not found")
+	ErrOwnershipTransferNotFound = errors.New("ownership transfer not found")
+	ErrPendingTransferExists = errors.New("project already has a pending ownership transfer")
+	ErrRevisionNotFound = errors.New("project revision not found")
+	ErrBudgetApprovalNotFound = errors.New("budget approval not found")
+	ErrFieldDefinitionNotFound = errors.New("field definition not found")
+	ErrFieldKeyAlreadyExists = errors.New("field key already exists")
 )
\ No newline at end of file
@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	domain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	money "github.com/captainhbb/tbs-backend/pkg/money"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -14,6 +15,118 @@ type MockRepository struct {
 	mock.Mock
 }
 
+// ChangeOwner provides a mock function with given fields: ctx, change
+func (_m *MockRepository) ChangeOwner(ctx context.Context, change domain.OwnerChange) (domain.Project, error) {
+	ret := _m.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeOwner")
+	}
+
+	var r0 domain.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OwnerChange) (domain.Project, error)); ok {
+		return rf(ctx, change)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OwnerChange) domain.Project); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Get(0).(domain.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.OwnerChange) error); ok {
+		r1 = rf(ctx, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateBudgetApproval provides a mock function with given fields: ctx, approval
+func (_m *MockRepository) CreateBudgetApproval(ctx context.Context, approval domain.BudgetApproval) (domain.BudgetApproval, error) {
+	ret := _m.Called(ctx, approval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBudgetApproval")
+	}
+
+	var r0 domain.BudgetApproval
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.BudgetApproval) (domain.BudgetApproval, error)); ok {
+		return rf(ctx, approval)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.BudgetApproval) domain.BudgetApproval); ok {
+		r0 = rf(ctx, approval)
+	} else {
+		r0 = ret.Get(0).(domain.BudgetApproval)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.BudgetApproval) error); ok {
+		r1 = rf(ctx, approval)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateFieldDefinition provides a mock function with given fields: ctx, definition
+func (_m *MockRepository) CreateFieldDefinition(ctx context.Context, definition domain.FieldDefinition) (domain.FieldDefinition, error) {
+	ret := _m.Called(ctx, definition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFieldDefinition")
+	}
+
+	var r0 domain.FieldDefinition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.FieldDefinition) (domain.FieldDefinition, error)); ok {
+		return rf(ctx, definition)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.FieldDefinition) domain.FieldDefinition); ok {
+		r0 = rf(ctx, definition)
+	} else {
+		r0 = ret.Get(0).(domain.FieldDefinition)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.FieldDefinition) error); ok {
+		r1 = rf(ctx, definition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOwnershipTransfer provides a mock function with given fields: ctx, transfer
+func (_m *MockRepository) CreateOwnershipTransfer(ctx context.Context, transfer domain.OwnershipTransfer) (domain.OwnershipTransfer, error) {
+	ret := _m.Called(ctx, transfer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOwnershipTransfer")
+	}
+
+	var r0 domain.OwnershipTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OwnershipTransfer) (domain.OwnershipTransfer, error)); ok {
+		return rf(ctx, transfer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OwnershipTransfer) domain.OwnershipTransfer); ok {
+		r0 = rf(ctx, transfer)
+	} else {
+		r0 = ret.Get(0).(domain.OwnershipTransfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.OwnershipTransfer) error); ok {
+		r1 = rf(ctx, transfer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateProject provides a mock function with given fields: ctx, project
 func (_m *MockRepository) CreateProject(ctx context.Context, project domain.Project) (domain.Project, error) {
 	ret := _m.Called(ctx, project)
@@ -42,6 +155,52 @@ func (_m *MockRepository) CreateProject(ctx context.Context, project domain.Proj
 	return r0, r1
 }
 
+// CreateRevision provides a mock function with given fields: ctx, revision
+func (_m *MockRepository) CreateRevision(ctx context.Context, revision domain.Revision) (domain.Revision, error) {
+	ret := _m.Called(ctx, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRevision")
+	}
+
+	var r0 domain.Revision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Revision) (domain.Revision, error)); ok {
+		return rf(ctx, revision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Revision) domain.Revision); ok {
+		r0 = rf(ctx, revision)
+	} else {
+		r0 = ret.Get(0).(domain.Revision)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Revision) error); ok {
+		r1 = rf(ctx, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteFieldDefinition provides a mock function with given fields: ctx, id
+func (_m *MockRepository) DeleteFieldDefinition(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFieldDefinition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteProject provides a mock function with given fields: ctx, id
 func (_m *MockRepository) DeleteProject(ctx context.Context, id int) error {
 	ret := _m.Called(ctx, id)
@@ -60,6 +219,118 @@ func (_m *MockRepository) DeleteProject(ctx context.Context, id int) error {
 	return r0
 }
 
+// GetBudgetApproval provides a mock function with given fields: ctx, id
+func (_m *MockRepository) GetBudgetApproval(ctx context.Context, id int) (domain.BudgetApproval, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBudgetApproval")
+	}
+
+	var r0 domain.BudgetApproval
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (domain.BudgetApproval, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) domain.BudgetApproval); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.BudgetApproval)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFieldDefinition provides a mock function with given fields: ctx, id
+func (_m *MockRepository) GetFieldDefinition(ctx context.Context, id int) (domain.FieldDefinition, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFieldDefinition")
+	}
+
+	var r0 domain.FieldDefinition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (domain.FieldDefinition, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) domain.FieldDefinition); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.FieldDefinition)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOwnershipTransfer provides a mock function with given fields: ctx, id
+func (_m *MockRepository) GetOwnershipTransfer(ctx context.Context, id int) (domain.OwnershipTransfer, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOwnershipTransfer")
+	}
+
+	var r0 domain.OwnershipTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (domain.OwnershipTransfer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) domain.OwnershipTransfer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.OwnershipTransfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPendingOwnershipTransfer provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) GetPendingOwnershipTransfer(ctx context.Context, projectID int) (domain.OwnershipTransfer, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingOwnershipTransfer")
+	}
+
+	var r0 domain.OwnershipTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (domain.OwnershipTransfer, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) domain.OwnershipTransfer); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(domain.OwnershipTransfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProject provides a mock function with given fields: ctx, id
 func (_m *MockRepository) GetProject(ctx context.Context, id int) (domain.Project, error) {
 	ret := _m.Called(ctx, id)
@@ -88,6 +359,326 @@ func (_m *MockRepository) GetProject(ctx context.Context, id int) (domain.Projec
 	return r0, r1
 }
 
+// GetRevision provides a mock function with given fields: ctx, projectID, number
+func (_m *MockRepository) GetRevision(ctx context.Context, projectID int, number int) (domain.Revision, error) {
+	ret := _m.Called(ctx, projectID, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevision")
+	}
+
+	var r0 domain.Revision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (domain.Revision, error)); ok {
+		return rf(ctx, projectID, number)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) domain.Revision); ok {
+		r0 = rf(ctx, projectID, number)
+	} else {
+		r0 = ret.Get(0).(domain.Revision)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, projectID, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBudgetApprovals provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) ListBudgetApprovals(ctx context.Context, projectID int) ([]domain.BudgetApproval, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBudgetApprovals")
+	}
+
+	var r0 []domain.BudgetApproval
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.BudgetApproval, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.BudgetApproval); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.BudgetApproval)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListFieldDefinitions provides a mock function with given fields: ctx
+func (_m *MockRepository) ListFieldDefinitions(ctx context.Context) ([]domain.FieldDefinition, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFieldDefinitions")
+	}
+
+	var r0 []domain.FieldDefinition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.FieldDefinition, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.FieldDefinition); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.FieldDefinition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOwnerChanges provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) ListOwnerChanges(ctx context.Context, projectID int) ([]domain.OwnerChange, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOwnerChanges")
+	}
+
+	var r0 []domain.OwnerChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.OwnerChange, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.OwnerChange); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.OwnerChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProjects provides a mock function with given fields: ctx, filter
+func (_m *MockRepository) ListProjects(ctx context.Context, filter domain.Filter) ([]domain.Project, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjects")
+	}
+
+	var r0 []domain.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) ([]domain.Project, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) []domain.Project); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Filter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProjectsByOwner provides a mock function with given fields: ctx, ownerID
+func (_m *MockRepository) ListProjectsByOwner(ctx context.Context, ownerID int) ([]domain.Project, error) {
+	ret := _m.Called(ctx, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjectsByOwner")
+	}
+
+	var r0 []domain.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.Project, error)); ok {
+		return rf(ctx, ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Project); ok {
+		r0 = rf(ctx, ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRevisions provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) ListRevisions(ctx context.Context, projectID int) ([]domain.Revision, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRevisions")
+	}
+
+	var r0 []domain.Revision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.Revision, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Revision); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Revision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetApprovedBudget provides a mock function with given fields: ctx, projectID, budget
+func (_m *MockRepository) SetApprovedBudget(ctx context.Context, projectID int, budget money.Money) (domain.Project, error) {
+	ret := _m.Called(ctx, projectID, budget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetApprovedBudget")
+	}
+
+	var r0 domain.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, money.Money) (domain.Project, error)); ok {
+		return rf(ctx, projectID, budget)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, money.Money) domain.Project); ok {
+		r0 = rf(ctx, projectID, budget)
+	} else {
+		r0 = ret.Get(0).(domain.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, money.Money) error); ok {
+		r1 = rf(ctx, projectID, budget)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateBudgetApproval provides a mock function with given fields: ctx, approval
+func (_m *MockRepository) UpdateBudgetApproval(ctx context.Context, approval domain.BudgetApproval) (domain.BudgetApproval, error) {
+	ret := _m.Called(ctx, approval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBudgetApproval")
+	}
+
+	var r0 domain.BudgetApproval
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.BudgetApproval) (domain.BudgetApproval, error)); ok {
+		return rf(ctx, approval)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.BudgetApproval) domain.BudgetApproval); ok {
+		r0 = rf(ctx, approval)
+	} else {
+		r0 = ret.Get(0).(domain.BudgetApproval)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.BudgetApproval) error); ok {
+		r1 = rf(ctx, approval)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateFieldDefinition provides a mock function with given fields: ctx, definition
+func (_m *MockRepository) UpdateFieldDefinition(ctx context.Context, definition domain.FieldDefinition) (domain.FieldDefinition, error) {
+	ret := _m.Called(ctx, definition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFieldDefinition")
+	}
+
+	var r0 domain.FieldDefinition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.FieldDefinition) (domain.FieldDefinition, error)); ok {
+		return rf(ctx, definition)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.FieldDefinition) domain.FieldDefinition); ok {
+		r0 = rf(ctx, definition)
+	} else {
+		r0 = ret.Get(0).(domain.FieldDefinition)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.FieldDefinition) error); ok {
+		r1 = rf(ctx, definition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateOwnershipTransfer provides a mock function with given fields: ctx, transfer
+func (_m *MockRepository) UpdateOwnershipTransfer(ctx context.Context, transfer domain.OwnershipTransfer) (domain.OwnershipTransfer, error) {
+	ret := _m.Called(ctx, transfer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOwnershipTransfer")
+	}
+
+	var r0 domain.OwnershipTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OwnershipTransfer) (domain.OwnershipTransfer, error)); ok {
+		return rf(ctx, transfer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OwnershipTransfer) domain.OwnershipTransfer); ok {
+		r0 = rf(ctx, transfer)
+	} else {
+		r0 = ret.Get(0).(domain.OwnershipTransfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.OwnershipTransfer) error); ok {
+		r1 = rf(ctx, transfer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateProject provides a mock function with given fields: ctx, project
 func (_m *MockRepository) UpdateProject(ctx context.Context, project domain.Project) (domain.Project, error) {
 	ret := _m.Called(ctx, project)
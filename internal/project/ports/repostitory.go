@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	"github.com/captainhbb/tbs-backend/pkg/money"
 )
 
 //go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
@@ -12,4 +13,39 @@ type Repository interface {
 	GetProject(ctx context.Context, id int) (domain.Project, error)
 	UpdateProject(ctx context.Context, project domain.Project) (domain.Project, error)
 	DeleteProject(ctx context.Context, id int) error
+	ListProjectsByOwner(ctx context.Context, ownerID int) ([]domain.Project, error)
+	// ListProjects returns the projects matching filter ordered by ID.
+	ListProjects(ctx context.Context, filter domain.Filter) ([]domain.Project, error)
+	// ChangeOwner sets the project's owner and appends change to its ownership
+	// history in a single transaction.
+	ChangeOwner(ctx context.Context, change domain.OwnerChange) (domain.Project, error)
+	ListOwnerChanges(ctx context.Context, projectID int) ([]domain.OwnerChange, error)
+	// CreateOwnershipTransfer fails with ErrPendingTransferExists when the
+	// project already has a pending transfer.
+	CreateOwnershipTransfer(ctx context.Context, transfer domain.OwnershipTransfer) (domain.OwnershipTransfer, error)
+	GetOwnershipTransfer(ctx context.Context, id int) (domain.OwnershipTransfer, error)
+	// GetPendingOwnershipTransfer returns the project's pending transfer, or
+	// ErrOwnershipTransferNotFound when it has none.
+	GetPendingOwnershipTransfer(ctx context.Context, projectID int) (domain.OwnershipTransfer, error)
+	UpdateOwnershipTransfer(ctx context.Context, transfer domain.OwnershipTransfer) (domain.OwnershipTransfer, error)
+	// CreateRevision stores revision under the next number for its project and
+	// returns it with ID and Number set.
+	CreateRevision(ctx context.Context, revision domain.Revision) (domain.Revision, error)
+	GetRevision(ctx context.Context, projectID int, number int) (domain.Revision, error)
+	ListRevisions(ctx context.Context, projectID int) ([]domain.Revision, error)
+	CreateBudgetApproval(ctx context.Context, approval domain.BudgetApproval) (domain.BudgetApproval, error)
+	GetBudgetApproval(ctx context.Context, id int) (domain.BudgetApproval, error)
+	UpdateBudgetApproval(ctx context.Context, approval domain.BudgetApproval) (domain.BudgetApproval, error)
+	ListBudgetApprovals(ctx context.Context, projectID int) ([]domain.BudgetApproval, error)
+	// SetApprovedBudget stores budget as the project's approved budget without
+	// touching any other field.
+	SetApprovedBudget(ctx context.Context, projectID int, budget money.Money) (domain.Project, error)
+	// CreateFieldDefinition returns ErrFieldKeyAlreadyExists when the tenant
+	// already defines a field with the key.
+	CreateFieldDefinition(ctx context.Context, definition domain.FieldDefinition) (domain.FieldDefinition, error)
+	GetFieldDefinition(ctx context.Context, id int) (domain.FieldDefinition, error)
+	UpdateFieldDefinition(ctx context.Context, definition domain.FieldDefinition) (domain.FieldDefinition, error)
+	DeleteFieldDefinition(ctx context.Context, id int) error
+	// ListFieldDefinitions returns the field definitions ordered by key.
+	ListFieldDefinitions(ctx context.Context) ([]domain.FieldDefinition, error)
 }
\ No newline at end of file
@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	auditDomain "github.com/captainhbb/tbs-backend/internal/audit/domain"
+	exchangeUseCase "github.com/captainhbb/tbs-backend/internal/exchange/usecase"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	"github.com/captainhbb/tbs-backend/internal/project/ports"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+// DefaultBudgetApprovalPolicy decides which levels must sign off a budget
+// unless the service is given another policy. Every budget needs a manager;
+// large budgets also need an administrator. Budgets in other currencies are
+// converted before being compared with a threshold; while no exchange rate is
+// available the threshold's level is required.
+var DefaultBudgetApprovalPolicy = []domain.ApprovalThreshold{
+	{Level: 1, Role: userDomain.RoleManager, Min: money.MustNew(0, "USD")},
+	{Level: 2, Role: userDomain.RoleAdmin, Min: money.MustNew(10000000, "USD")},
+}
+
+func(s *projectService) ListBudgetApprovals(ctx context.Context, projectID int) ([]domain.BudgetApproval, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListBudgetApprovals(ctx, projectID)
+}
+
+func(s *projectService) ApproveBudget(ctx context.Context, decideRequest DecideBudgetApprovalRequest) (domain.BudgetApproval, error) {
+	approval, level, approverID, err := s.pendingApprovalFor(ctx, decideRequest)
+	if err != nil {
+		return domain.BudgetApproval{}, err
+	}
+
+	now := time.Now()
+	approval.Decisions = append(approval.Decisions, domain.ApprovalDecision{
+		Level: level.Level,
+		ApproverID: approverID,
+		Approved: true,
+		Comment: decideRequest.Comment,
+		DecidedAt: now,
+	})
+
+	var updatedApproval domain.BudgetApproval
+	err = s.transactions.Do(ctx, func(ctx context.Context) error {
+		if _, pending := approval.NextLevel(); !pending {
+			project, err := s.repo.GetProject(ctx, approval.ProjectID)
+			if err != nil {
+				return err
+			}
+			_, err = s.setApprovedBudget(ctx, project, approval.Amount)
+			if err != nil {
+				return err
+			}
+
+			approval.Status = domain.ApprovalStatusApproved
+			approval.DecidedAt = now
+		}
+
+		var err error
+		updatedApproval, err = s.repo.UpdateBudgetApproval(ctx, approval)
+		return err
+	})
+	if err != nil {
+		return domain.BudgetApproval{}, err
+	}
+	return updatedApproval, nil
+}
+
+func(s *projectService) RejectBudget(ctx context.Context, decideRequest DecideBudgetApprovalRequest) (domain.BudgetApproval, error) {
+	if decideRequest.Comment == "" {
+		return domain.BudgetApproval{}, ErrCommentRequired
+	}
+
+	approval, level, approverID, err := s.pendingApprovalFor(ctx, decideRequest)
+	if err != nil {
+		return domain.BudgetApproval{}, err
+	}
+
+	now := time.Now()
+	approval.Decisions = append(approval.Decisions, domain.ApprovalDecision{
+		Level: level.Level,
+		ApproverID: approverID,
+		Comment: decideRequest.Comment,
+		DecidedAt: now,
+	})
+	approval.Status = domain.ApprovalStatusRejected
+	approval.DecidedAt = now
+	return s.repo.UpdateBudgetApproval(ctx, approval)
+}
+
+// requestBudgetApproval opens an approval of the given levels for the
+// project's proposed budget and supersedes any request still pending for it.
+// The levels are worked out before the project is written, so that a budget
+// that cannot be converted is rejected before anything is stored. When no
+// level applies the budget is approved at once and the updated project is
+// returned.
+func(s *projectService) requestBudgetApproval(ctx context.Context, project domain.Project, levels []domain.ApprovalLevel) (domain.Project, error) {
+	pendingApprovals, err := s.repo.ListBudgetApprovals(ctx, project.ID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	now := time.Now()
+	for _, pendingApproval := range pendingApprovals {
+		if pendingApproval.Status != domain.ApprovalStatusPending {
+			continue
+		}
+		pendingApproval.Status = domain.ApprovalStatusSuperseded
+		pendingApproval.DecidedAt = now
+		_, err := s.repo.UpdateBudgetApproval(ctx, pendingApproval)
+		if err != nil {
+			return domain.Project{}, err
+		}
+	}
+
+	requestedBy, _ := actor.IDFromContext(ctx)
+	approval := domain.BudgetApproval{
+		ProjectID: project.ID,
+		RequestedBy: requestedBy,
+		Amount: project.ProposedBudget,
+		Levels: levels,
+		Status: domain.ApprovalStatusPending,
+		RequestedAt: now,
+	}
+	if len(levels) == 0 {
+		approval.Status = domain.ApprovalStatusApproved
+		approval.DecidedAt = now
+	}
+	_, err = s.repo.CreateBudgetApproval(ctx, approval)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	if approval.Status == domain.ApprovalStatusApproved {
+		return s.setApprovedBudget(ctx, project, approval.Amount)
+	}
+	return project, nil
+}
+
+func(s *projectService) setApprovedBudget(ctx context.Context, project domain.Project, amount money.Money) (domain.Project, error) {
+	updatedProject, err := s.repo.SetApprovedBudget(ctx, project.ID, amount)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	err = s.recordProjectChange(ctx, auditDomain.ActionUpdate, &project, &updatedProject)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	return updatedProject, nil
+}
+
+func(s *projectService) requiredApprovalLevels(ctx context.Context, budget money.Money) ([]domain.ApprovalLevel, error) {
+	levels := make([]domain.ApprovalLevel, 0, len(s.approvalPolicy))
+	for _, threshold := range s.approvalPolicy {
+		amount := budget
+		if !threshold.Min.IsZero() && amount.Currency() != threshold.Min.Currency() {
+			conversion, err := s.exchangeService.Convert(ctx, exchangeUseCase.ConvertRequest{
+				Amount: amount,
+				Currency: threshold.Min.Currency(),
+				On: time.Now(),
+			})
+			switch err {
+			case nil:
+				amount = conversion.Converted
+			case exchangeUseCase.ErrRateNotFound:
+				levels = append(levels, domain.ApprovalLevel{Level: threshold.Level, Role: threshold.Role})
+				continue
+			default:
+				return nil, err
+			}
+		}
+
+		if !threshold.Min.IsZero() {
+			cmp, err := amount.Cmp(threshold.Min)
+			if err != nil {
+				return nil, err
+			}
+			if cmp < 0 {
+				continue
+			}
+		}
+		levels = append(levels, domain.ApprovalLevel{Level: threshold.Level, Role: threshold.Role})
+	}
+	return levels, nil
+}
+
+// pendingApprovalFor loads an approval and checks that the acting user may sign
+// off its next level.
+func(s *projectService) pendingApprovalFor(ctx context.Context, decideRequest DecideBudgetApprovalRequest) (domain.BudgetApproval, domain.ApprovalLevel, int, error) {
+	approval, err := s.repo.GetBudgetApproval(ctx, decideRequest.ApprovalID)
+	switch err {
+	case nil:
+	case ports.ErrBudgetApprovalNotFound:
+		return domain.BudgetApproval{}, domain.ApprovalLevel{}, 0, ErrApprovalNotFound
+	default:
+		return domain.BudgetApproval{}, domain.ApprovalLevel{}, 0, err
+	}
+
+	if approval.Status != domain.ApprovalStatusPending {
+		return domain.BudgetApproval{}, domain.ApprovalLevel{}, 0, ErrApprovalNotPending
+	}
+	level, pending := approval.NextLevel()
+	if !pending {
+		return domain.BudgetApproval{}, domain.ApprovalLevel{}, 0, ErrApprovalNotPending
+	}
+
+	approverID, ok := actor.IDFromContext(ctx)
+	if !ok {
+		return domain.BudgetApproval{}, domain.ApprovalLevel{}, 0, ErrNotApprover
+	}
+	if approverID == approval.RequestedBy {
+		return domain.BudgetApproval{}, domain.ApprovalLevel{}, 0, ErrSelfApproval
+	}
+	for _, decision := range approval.Decisions {
+		if decision.ApproverID == approverID {
+			return domain.BudgetApproval{}, domain.ApprovalLevel{}, 0, ErrAlreadySignedOff
+		}
+	}
+
+	approver, err := s.userService.GetUser(ctx, approverID)
+	switch err {
+	case nil:
+	case userUseCase.ErrUserNotFound:
+		return domain.BudgetApproval{}, domain.ApprovalLevel{}, 0, ErrNotApprover
+	default:
+		return domain.BudgetApproval{}, domain.ApprovalLevel{}, 0, err
+	}
+	if !approver.Active || (approver.Role != level.Role && approver.Role != userDomain.RoleAdmin) {
+		return domain.BudgetApproval{}, domain.ApprovalLevel{}, 0, ErrNotApprover
+	}
+	return approval, level, approverID, nil
+}
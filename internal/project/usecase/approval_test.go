@@ -0,0 +1,280 @@
+package usecase_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	auditUseCaseMock "github.com/captainhbb/tbs-backend/internal/audit/usecase/mock"
+	budgetUseCaseMock "github.com/captainhbb/tbs-backend/internal/budget/usecase/mock"
+	costingUseCaseMock "github.com/captainhbb/tbs-backend/internal/costing/usecase/mock"
+	exchangeDomain "github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	exchangeUseCase "github.com/captainhbb/tbs-backend/internal/exchange/usecase"
+	exchangeUseCaseMock "github.com/captainhbb/tbs-backend/internal/exchange/usecase/mock"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	milestoneUseCaseMock "github.com/captainhbb/tbs-backend/internal/milestone/usecase/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	portsRepository "github.com/captainhbb/tbs-backend/internal/project/ports"
+	portsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/usecase"
+	taskUseCaseMock "github.com/captainhbb/tbs-backend/internal/task/usecase/mock"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func pendingApproval() domain.BudgetApproval {
+	return domain.BudgetApproval{
+		ID: 7,
+		ProjectID: 1,
+		RequestedBy: 1,
+		Amount: money.MustNew(20000000, "USD"),
+		Levels: []domain.ApprovalLevel{
+			{Level: 1, Role: userDomain.RoleManager},
+			{Level: 2, Role: userDomain.RoleAdmin},
+		},
+		Status: domain.ApprovalStatusPending,
+	}
+}
+
+func TestApproveBudget(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		actorID int
+		mockSetup func(repo *portsMock.MockRepository, userService *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService)
+		expectError bool
+		expectedError error
+		expectedStatus string
+	}{
+		{
+			name: "first level signs off",
+			actorID: 2,
+			mockSetup: func(repo *portsMock.MockRepository, userService *userUseCaseMock.MockUserService, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetBudgetApproval", mock.Anything, 7).Return(pendingApproval(), nil)
+				userService.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, Role: userDomain.RoleManager, Active: true}, nil)
+				repo.On("UpdateBudgetApproval", mock.Anything, mock.Anything).Return(func(_ context.Context, approval domain.BudgetApproval) (domain.BudgetApproval, error) {
+					require.Len(t, approval.Decisions, 1)
+					require.Equal(t, 1, approval.Decisions[0].Level)
+					return approval, nil
+				})
+			},
+			expectedStatus: domain.ApprovalStatusPending,
+		},
+		{
+			name: "last level approves the budget",
+			actorID: 3,
+			mockSetup: func(repo *portsMock.MockRepository, userService *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
+				approval := pendingApproval()
+				approval.Decisions = []domain.ApprovalDecision{{Level: 1, ApproverID: 2, Approved: true}}
+				repo.On("GetBudgetApproval", mock.Anything, 7).Return(approval, nil)
+				userService.On("GetUser", mock.Anything, 3).Return(userDomain.User{ID: 3, Role: userDomain.RoleAdmin, Active: true}, nil)
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, ProposedBudget: money.MustNew(20000000, "USD")}, nil)
+				repo.On("SetApprovedBudget", mock.Anything, 1, money.MustNew(20000000, "USD")).
+					Return(domain.Project{ID: 1, ProposedBudget: money.MustNew(20000000, "USD"), ApprovedBudget: money.MustNew(20000000, "USD")}, nil)
+				auditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+				repo.On("CreateRevision", mock.Anything, mock.Anything).Return(domain.Revision{}, nil)
+				repo.On("UpdateBudgetApproval", mock.Anything, mock.Anything).Return(func(_ context.Context, approval domain.BudgetApproval) (domain.BudgetApproval, error) {
+					return approval, nil
+				})
+			},
+			expectedStatus: domain.ApprovalStatusApproved,
+		},
+		{
+			name: "manager cannot sign off admin level",
+			actorID: 3,
+			mockSetup: func(repo *portsMock.MockRepository, userService *userUseCaseMock.MockUserService, _ *auditUseCaseMock.MockAuditService) {
+				approval := pendingApproval()
+				approval.Decisions = []domain.ApprovalDecision{{Level: 1, ApproverID: 2, Approved: true}}
+				repo.On("GetBudgetApproval", mock.Anything, 7).Return(approval, nil)
+				userService.On("GetUser", mock.Anything, 3).Return(userDomain.User{ID: 3, Role: userDomain.RoleManager, Active: true}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrNotApprover,
+		},
+		{
+			name: "same approver twice",
+			actorID: 2,
+			mockSetup: func(repo *portsMock.MockRepository, _ *userUseCaseMock.MockUserService, _ *auditUseCaseMock.MockAuditService) {
+				approval := pendingApproval()
+				approval.Decisions = []domain.ApprovalDecision{{Level: 1, ApproverID: 2, Approved: true}}
+				repo.On("GetBudgetApproval", mock.Anything, 7).Return(approval, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrAlreadySignedOff,
+		},
+		{
+			name: "requester cannot approve",
+			actorID: 1,
+			mockSetup: func(repo *portsMock.MockRepository, _ *userUseCaseMock.MockUserService, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetBudgetApproval", mock.Anything, 7).Return(pendingApproval(), nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrSelfApproval,
+		},
+		{
+			name: "already rejected",
+			actorID: 2,
+			mockSetup: func(repo *portsMock.MockRepository, _ *userUseCaseMock.MockUserService, _ *auditUseCaseMock.MockAuditService) {
+				approval := pendingApproval()
+				approval.Status = domain.ApprovalStatusRejected
+				repo.On("GetBudgetApproval", mock.Anything, 7).Return(approval, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrApprovalNotPending,
+		},
+		{
+			name: "approval not found",
+			actorID: 2,
+			mockSetup: func(repo *portsMock.MockRepository, _ *userUseCaseMock.MockUserService, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetBudgetApproval", mock.Anything, 7).Return(domain.BudgetApproval{}, portsRepository.ErrBudgetApprovalNotFound)
+			},
+			expectError: true,
+			expectedError: usecase.ErrApprovalNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			userServiceMock := userUseCaseMock.NewMockUserService(t)
+			auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+			service := usecase.New(repoMock, userServiceMock, membershipUseCaseMock.NewMockMembershipService(t), auditServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+			ctx := actor.WithID(context.Background(), tt.actorID)
+
+			tt.mockSetup(repoMock, userServiceMock, auditServiceMock)
+
+			approval, err := service.ApproveBudget(ctx, usecase.DecideBudgetApprovalRequest{ApprovalID: 7, Comment: "ok"})
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedStatus, approval.Status)
+			}
+		})
+	}
+}
+
+func TestRejectBudget(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	userServiceMock := userUseCaseMock.NewMockUserService(t)
+	service := usecase.New(repoMock, userServiceMock, membershipUseCaseMock.NewMockMembershipService(t), auditUseCaseMock.NewMockAuditService(t), exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+	ctx := actor.WithID(context.Background(), 2)
+
+	_, err := service.RejectBudget(ctx, usecase.DecideBudgetApprovalRequest{ApprovalID: 7})
+	require.ErrorIs(t, err, usecase.ErrCommentRequired)
+
+	repoMock.On("GetBudgetApproval", mock.Anything, 7).Return(pendingApproval(), nil)
+	userServiceMock.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, Role: userDomain.RoleManager, Active: true}, nil)
+	repoMock.On("UpdateBudgetApproval", mock.Anything, mock.Anything).Return(func(_ context.Context, approval domain.BudgetApproval) (domain.BudgetApproval, error) {
+		return approval, nil
+	})
+
+	approval, err := service.RejectBudget(ctx, usecase.DecideBudgetApprovalRequest{ApprovalID: 7, Comment: "too expensive"})
+	require.NoError(t, err)
+	require.Equal(t, domain.ApprovalStatusRejected, approval.Status)
+	require.Equal(t, "too expensive", approval.Decisions[0].Comment)
+	require.False(t, approval.Decisions[0].Approved)
+}
+
+func TestCreateProjectRoutesForeignBudgetByConvertedAmount(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	repoMock.On("ListFieldDefinitions", mock.Anything).Return(nil, nil).Maybe()
+	userServiceMock := userUseCaseMock.NewMockUserService(t)
+	auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+	exchangeServiceMock := exchangeUseCaseMock.NewMockExchangeService(t)
+	service := usecase.New(repoMock, userServiceMock, membershipUseCaseMock.NewMockMembershipService(t), auditServiceMock, exchangeServiceMock, budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+	budget := money.MustNew(5000000, "EUR")
+	userServiceMock.On("GetUser", mock.Anything, 1).Return(userDomain.User{ID: 1}, nil)
+	repoMock.On("CreateProject", mock.Anything, mock.Anything).Return(domain.Project{ID: 1, OwnerID: 1, ProposedBudget: budget}, nil)
+	auditServiceMock.On("Record", mock.Anything, mock.Anything).Return(nil)
+	repoMock.On("CreateRevision", mock.Anything, mock.Anything).Return(domain.Revision{}, nil)
+	repoMock.On("ListBudgetApprovals", mock.Anything, 1).Return([]domain.BudgetApproval{}, nil)
+	exchangeServiceMock.On("Convert", mock.Anything, mock.Anything).
+		Return(exchangeDomain.Conversion{Original: budget, Converted: money.MustNew(5500000, "USD"), Rate: big.NewRat(11, 10), RateDate: time.Now()}, nil)
+	repoMock.On("CreateBudgetApproval", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		capturedArg := args.Get(1).(domain.BudgetApproval)
+		require.Equal(t, []domain.ApprovalLevel{{Level: 1, Role: userDomain.RoleManager}}, capturedArg.Levels)
+		require.Equal(t, budget, capturedArg.Amount)
+	}).Return(domain.BudgetApproval{ID: 1}, nil)
+
+	_, err := service.CreateProject(context.Background(), usecase.CreateProjectRequest{Name: "Bridge", OwnerID: 1, ProposedBudget: budget})
+	require.NoError(t, err)
+}
+
+func TestCreateProjectWithoutExchangeRateRequiresTheLevel(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	repoMock.On("ListFieldDefinitions", mock.Anything).Return(nil, nil).Maybe()
+	userServiceMock := userUseCaseMock.NewMockUserService(t)
+	auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+	exchangeServiceMock := exchangeUseCaseMock.NewMockExchangeService(t)
+	service := usecase.New(repoMock, userServiceMock, membershipUseCaseMock.NewMockMembershipService(t), auditServiceMock, exchangeServiceMock, budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+	budget := money.MustNew(5000, "EUR")
+	userServiceMock.On("GetUser", mock.Anything, 1).Return(userDomain.User{ID: 1}, nil)
+	exchangeServiceMock.On("Convert", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		capturedArg := args.Get(1).(exchangeUseCase.ConvertRequest)
+		require.Equal(t, "USD", capturedArg.Currency)
+	}).Return(exchangeDomain.Conversion{}, exchangeUseCase.ErrRateNotFound)
+	repoMock.On("CreateProject", mock.Anything, mock.Anything).Return(domain.Project{ID: 1, OwnerID: 1, ProposedBudget: budget}, nil)
+	auditServiceMock.On("Record", mock.Anything, mock.Anything).Return(nil)
+	repoMock.On("CreateRevision", mock.Anything, mock.Anything).Return(domain.Revision{}, nil)
+	repoMock.On("ListBudgetApprovals", mock.Anything, 1).Return([]domain.BudgetApproval{}, nil)
+	repoMock.On("CreateBudgetApproval", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		capturedArg := args.Get(1).(domain.BudgetApproval)
+		require.Equal(t, []domain.ApprovalLevel{
+			{Level: 1, Role: userDomain.RoleManager},
+			{Level: 2, Role: userDomain.RoleAdmin},
+		}, capturedArg.Levels)
+		require.Equal(t, domain.ApprovalStatusPending, capturedArg.Status)
+	}).Return(domain.BudgetApproval{ID: 1}, nil)
+
+	_, err := service.CreateProject(context.Background(), usecase.CreateProjectRequest{Name: "Bridge", OwnerID: 1, ProposedBudget: budget})
+	require.NoError(t, err)
+	repoMock.AssertNotCalled(t, "SetApprovedBudget", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateProjectBelowEveryThresholdIsApprovedAtOnce(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	repoMock.On("ListFieldDefinitions", mock.Anything).Return(nil, nil).Maybe()
+	userServiceMock := userUseCaseMock.NewMockUserService(t)
+	auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+	policy := []domain.ApprovalThreshold{
+		{Level: 1, Role: userDomain.RoleAdmin, Min: money.MustNew(100000, "EUR")},
+	}
+	service := usecase.New(repoMock, userServiceMock, membershipUseCaseMock.NewMockMembershipService(t), auditServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), policy, transaction.None())
+
+	budget := money.MustNew(5000, "EUR")
+	userServiceMock.On("GetUser", mock.Anything, 1).Return(userDomain.User{ID: 1}, nil)
+	repoMock.On("CreateProject", mock.Anything, mock.Anything).Return(domain.Project{ID: 1, OwnerID: 1, ProposedBudget: budget}, nil)
+	auditServiceMock.On("Record", mock.Anything, mock.Anything).Return(nil)
+	repoMock.On("CreateRevision", mock.Anything, mock.Anything).Return(domain.Revision{}, nil)
+	repoMock.On("ListBudgetApprovals", mock.Anything, 1).Return([]domain.BudgetApproval{}, nil)
+	repoMock.On("CreateBudgetApproval", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		capturedArg := args.Get(1).(domain.BudgetApproval)
+		require.Empty(t, capturedArg.Levels)
+		require.Equal(t, domain.ApprovalStatusApproved, capturedArg.Status)
+	}).Return(domain.BudgetApproval{ID: 1}, nil)
+	repoMock.On("SetApprovedBudget", mock.Anything, 1, budget).Return(domain.Project{ID: 1, OwnerID: 1, ProposedBudget: budget, ApprovedBudget: budget}, nil).Once()
+
+	project, err := service.CreateProject(context.Background(), usecase.CreateProjectRequest{Name: "Bridge", OwnerID: 1, ProposedBudget: budget})
+	require.NoError(t, err)
+	require.Equal(t, budget, project.ApprovedBudget)
+}
@@ -1,15 +1,23 @@
 package usecase
 
-import "time"
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
 
 type CreateProjectRequest struct {
 	Name 					string
 	Description 			string
 	StartDate 				time.Time
 	EndDate 				time.Time
-	ProposedBudget 			float64
+	ProposedBudget 			money.Money
 	Status 					string
 	OwnerID 				int
+	Tags 					[]string
+	// CustomFields sets the custom field values by key. Values are validated
+	// against the field's type; empty values leave the field unset.
+	CustomFields 			map[string]string
 }
 
 type UpdateProjectRequest struct {
@@ -18,7 +26,87 @@ type UpdateProjectRequest struct {
 	Description 			string
 	StartDate 				time.Time
 	EndDate 				time.Time
-	ProposedBudget 			float64
+	ProposedBudget 			money.Money
 	Status 					string
 	OwnerID 				int
-}
\ No newline at end of file
+	Tags 					[]string
+	// CustomFields sets the custom field values by key. Values are validated
+	// against the field's type; empty values leave the field unset.
+	CustomFields 			map[string]string
+}
+
+type TransferOwnershipRequest struct {
+	ProjectID 				int
+	NewOwnerID 				int
+	RequireAcceptance 		bool
+	// ExpiresIn bounds how long a transfer waits for acceptance. Zero falls back
+	// to DefaultTransferExpiry.
+	ExpiresIn 				time.Duration
+}
+
+// RespondOwnershipTransferRequest is answered on behalf of the user acting in
+// ctx, who must be the transfer's recipient.
+type RespondOwnershipTransferRequest struct {
+	TransferID 				int
+}
+
+type RestoreProjectRevisionRequest struct {
+	ProjectID 				int
+	Number 					int
+}
+
+type DiffProjectRevisionsRequest struct {
+	ProjectID 				int
+	FromNumber 				int
+	ToNumber 				int
+}
+
+type ProjectBudgetReportRequest struct {
+	ProjectIDs 				[]int
+	Currency 				string
+	// AsOf picks the exchange rates to use. Zero means today.
+	AsOf 					time.Time
+}
+
+type ProjectSpendReportRequest struct {
+	ProjectID 				int
+	// AsOf ignores later expenses and ends the burn rate window. Zero means now.
+	AsOf 					time.Time
+}
+
+type EarnedValueRequest struct {
+	ProjectID 				int
+	// AsOf is the status date, inclusive. Zero means now.
+	AsOf 					time.Time
+}
+
+// EarnedValueSeriesRequest asks for one measurement at the end of every week
+// or month (Interval, default month) between From and To. From defaults to the
+// project start and To to today or the project end, whichever is earlier.
+type EarnedValueSeriesRequest struct {
+	ProjectID 				int
+	From 					time.Time
+	To 						time.Time
+	Interval 				string
+}
+
+type DecideBudgetApprovalRequest struct {
+	ApprovalID 				int
+	Comment 				string
+}
+
+type CreateFieldDefinitionRequest struct {
+	Key 					string
+	Name 					string
+	Type 					string
+	// Options lists the values allowed for an enum field.
+	Options 				[]string
+	Required 				bool
+}
+
+type UpdateFieldDefinitionRequest struct {
+	ID 						int
+	Name 					string
+	Options 				[]string
+	Required 				bool
+}
@@ -0,0 +1,294 @@
+package usecase
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	costingDomain "github.com/captainhbb/tbs-backend/internal/costing/domain"
+	costingUseCase "github.com/captainhbb/tbs-backend/internal/costing/usecase"
+	exchangeDomain "github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	taskDomain "github.com/captainhbb/tbs-backend/internal/task/domain"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+// earnedValueBasis is the planned and completed work earned value is measured
+// from, loaded once so that a series can be measured at many dates. Actual
+// cost comes from CostingService.ProjectActualCost at each date.
+type earnedValueBasis struct {
+	project domain.Project
+	// tasks are the leaf tasks of the work breakdown structure; parents only
+	// group their subtasks' work.
+	tasks []taskDomain.Task
+	progress map[int][]taskDomain.ProgressUpdate
+	now time.Time
+}
+
+func(s *projectService) ReportEarnedValue(ctx context.Context, earnedValueRequest EarnedValueRequest) (domain.EarnedValue, error) {
+	asOf := earnedValueRequest.AsOf
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+	cutoff := exchangeDomain.Day(asOf).AddDate(0, 0, 1)
+
+	project, err := s.earnedValueProject(ctx, earnedValueRequest.ProjectID)
+	if err != nil {
+		return domain.EarnedValue{}, err
+	}
+	basis, err := s.earnedValueBasis(ctx, project)
+	if err != nil {
+		return domain.EarnedValue{}, err
+	}
+	actualCost, err := s.actualCost(ctx, project.ID, cutoff)
+	if err != nil {
+		return domain.EarnedValue{}, err
+	}
+	earnedValue, err := basis.measure(cutoff, actualCost)
+	if err != nil {
+		return domain.EarnedValue{}, err
+	}
+	earnedValue.AsOf = asOf
+	return earnedValue, nil
+}
+
+func(s *projectService) ReportEarnedValueSeries(ctx context.Context, seriesRequest EarnedValueSeriesRequest) ([]domain.EarnedValue, error) {
+	interval := seriesRequest.Interval
+	if interval == "" {
+		interval = costingDomain.PeriodMonth
+	}
+	if !costingDomain.IsValidPeriod(interval) {
+		return nil, ErrInvalidInterval
+	}
+
+	project, err := s.earnedValueProject(ctx, seriesRequest.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	from := seriesRequest.From
+	if from.IsZero() {
+		from = project.StartDate
+	}
+	to := seriesRequest.To
+	if to.IsZero() {
+		to = time.Now()
+		if project.EndDate.Before(to) {
+			to = project.EndDate
+		}
+	}
+	end := exchangeDomain.Day(to).AddDate(0, 0, 1)
+
+	basis, err := s.earnedValueBasis(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var series []domain.EarnedValue
+	for start := costingDomain.PeriodStart(interval, from); start.Before(end); start = nextPeriod(interval, start) {
+		cutoff := nextPeriod(interval, start)
+		if cutoff.After(end) {
+			cutoff = end
+		}
+
+		actualCost, err := s.actualCost(ctx, project.ID, cutoff)
+		if err != nil {
+			return nil, err
+		}
+		earnedValue, err := basis.measure(cutoff, actualCost)
+		if err != nil {
+			return nil, err
+		}
+		earnedValue.AsOf = cutoff.AddDate(0, 0, -1)
+		series = append(series, earnedValue)
+	}
+	return series, nil
+}
+
+// earnedValueProject loads a project the acting user may view and that has
+// the dates planned value is spread over.
+func(s *projectService) earnedValueProject(ctx context.Context, projectID int) (domain.Project, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	project, err := s.repo.GetProject(ctx, projectID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	if project.StartDate.IsZero() || project.EndDate.IsZero() {
+		return domain.Project{}, ErrScheduleRequired
+	}
+	return project, nil
+}
+
+// earnedValueBasis loads the project's tasks and their progress history.
+func(s *projectService) earnedValueBasis(ctx context.Context, project domain.Project) (earnedValueBasis, error) {
+	tasks, err := s.taskService.ListProjectTasks(ctx, project.ID, taskDomain.Filter{})
+	if err != nil {
+		return earnedValueBasis{}, err
+	}
+	updates, err := s.taskService.ListProgressUpdates(ctx, project.ID)
+	if err != nil {
+		return earnedValueBasis{}, err
+	}
+
+	basis := earnedValueBasis{
+		project: project,
+		progress: make(map[int][]taskDomain.ProgressUpdate),
+		now: time.Now(),
+	}
+
+	parents := make(map[int]bool)
+	for _, task := range tasks {
+		parents[task.ParentID] = true
+	}
+	for _, task := range tasks {
+		if !parents[task.ID] {
+			basis.tasks = append(basis.tasks, task)
+		}
+	}
+	for _, update := range updates {
+		basis.progress[update.TaskID] = append(basis.progress[update.TaskID], update)
+	}
+	return basis, nil
+}
+
+// actualCost is what the project cost before cutoff, measured as in spend
+// reports and alerts.
+func(s *projectService) actualCost(ctx context.Context, projectID int, cutoff time.Time) (money.Money, error) {
+	actualCost, err := s.costingService.ProjectActualCost(ctx, costingUseCase.ActualCostRequest{
+		ProjectID: projectID,
+		To: cutoff,
+	})
+	if err != nil {
+		return money.Money{}, err
+	}
+	return actualCost.Total, nil
+}
+
+// measure computes the earned value figures for the work done before cutoff
+// against actualCost, the cost incurred by then.
+func(b earnedValueBasis) measure(cutoff time.Time, actualCost money.Money) (domain.EarnedValue, error) {
+	budget := b.project.ProposedBudget
+	start := exchangeDomain.Day(b.project.StartDate)
+	end := exchangeDomain.Day(b.project.EndDate).AddDate(0, 0, 1)
+
+	planned, earned := new(big.Rat), new(big.Rat)
+	if len(b.tasks) == 0 {
+		planned = plannedFraction(start, end, cutoff)
+	} else {
+		var totalEstimate time.Duration
+		for _, task := range b.tasks {
+			totalEstimate += task.Estimate
+		}
+
+		for _, task := range b.tasks {
+			// Without estimates every task carries the same weight.
+			weight := big.NewRat(1, int64(len(b.tasks)))
+			if totalEstimate > 0 {
+				weight = big.NewRat(int64(task.Estimate), int64(totalEstimate))
+			}
+
+			due := end
+			if !task.DueDate.IsZero() {
+				due = exchangeDomain.Day(task.DueDate).AddDate(0, 0, 1)
+			}
+			planned.Add(planned, new(big.Rat).Mul(weight, plannedFraction(start, due, cutoff)))
+			earned.Add(earned, new(big.Rat).Mul(weight, big.NewRat(int64(b.progressAt(task, cutoff)), 100)))
+		}
+	}
+
+	plannedValue, err := budget.MulRat(planned)
+	if err != nil {
+		return domain.EarnedValue{}, err
+	}
+	earnedValue, err := budget.MulRat(earned)
+	if err != nil {
+		return domain.EarnedValue{}, err
+	}
+	costVariance, err := earnedValue.Sub(actualCost)
+	if err != nil {
+		return domain.EarnedValue{}, err
+	}
+	scheduleVariance, err := earnedValue.Sub(plannedValue)
+	if err != nil {
+		return domain.EarnedValue{}, err
+	}
+
+	var cpi, spi float64
+	if !actualCost.IsZero() {
+		cpi, _ = big.NewRat(earnedValue.Amount(), actualCost.Amount()).Float64()
+	}
+	if !plannedValue.IsZero() {
+		spi, _ = big.NewRat(earnedValue.Amount(), plannedValue.Amount()).Float64()
+	}
+
+	var estimate money.Money
+	if cpi > 0 {
+		estimate, err = budget.Mul(actualCost.Amount(), earnedValue.Amount())
+		if err != nil {
+			return domain.EarnedValue{}, err
+		}
+	} else {
+		remaining, err := budget.Sub(earnedValue)
+		if err != nil {
+			return domain.EarnedValue{}, err
+		}
+		estimate, err = actualCost.Add(remaining)
+		if err != nil {
+			return domain.EarnedValue{}, err
+		}
+	}
+
+	return domain.EarnedValue{
+		ProjectID: b.project.ID,
+		BudgetAtCompletion: budget,
+		PlannedValue: plannedValue,
+		EarnedValue: earnedValue,
+		ActualCost: actualCost,
+		CostVariance: costVariance,
+		ScheduleVariance: scheduleVariance,
+		CostPerformanceIndex: cpi,
+		SchedulePerformanceIndex: spi,
+		EstimateAtCompletion: estimate,
+	}, nil
+}
+
+
+// progressAt returns the last progress recorded for task before cutoff, or its
+// current progress once cutoff is in the future.
+func(b earnedValueBasis) progressAt(task taskDomain.Task, cutoff time.Time) int {
+	if cutoff.After(b.now) {
+		return task.Progress
+	}
+
+	progress := 0
+	for _, update := range b.progress[task.ID] {
+		if !update.RecordedAt.Before(cutoff) {
+			break
+		}
+		progress = update.Progress
+	}
+	return progress
+}
+
+// plannedFraction is the share of work scheduled evenly between start and due
+// that should be done by cutoff.
+func plannedFraction(start time.Time, due time.Time, cutoff time.Time) *big.Rat {
+	if !cutoff.After(start) {
+		return new(big.Rat)
+	}
+	if !cutoff.Before(due) {
+		return big.NewRat(1, 1)
+	}
+	return big.NewRat(int64(cutoff.Sub(start)), int64(due.Sub(start)))
+}
+
+func nextPeriod(interval string, start time.Time) time.Time {
+	if interval == costingDomain.PeriodWeek {
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 1, 0)
+}
@@ -0,0 +1,165 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	auditUseCaseMock "github.com/captainhbb/tbs-backend/internal/audit/usecase/mock"
+	budgetDomain "github.com/captainhbb/tbs-backend/internal/budget/domain"
+	budgetUseCaseMock "github.com/captainhbb/tbs-backend/internal/budget/usecase/mock"
+	costingDomain "github.com/captainhbb/tbs-backend/internal/costing/domain"
+	costingUseCase "github.com/captainhbb/tbs-backend/internal/costing/usecase"
+	costingUseCaseMock "github.com/captainhbb/tbs-backend/internal/costing/usecase/mock"
+	exchangeUseCaseMock "github.com/captainhbb/tbs-backend/internal/exchange/usecase/mock"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	milestoneUseCaseMock "github.com/captainhbb/tbs-backend/internal/milestone/usecase/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/usecase"
+	taskDomain "github.com/captainhbb/tbs-backend/internal/task/domain"
+	taskUseCaseMock "github.com/captainhbb/tbs-backend/internal/task/usecase/mock"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type earnedValueMocks struct {
+	repo *portsMock.MockRepository
+	costingService *costingUseCaseMock.MockCostingService
+	taskService *taskUseCaseMock.MockTaskService
+}
+
+func newEarnedValueService(t *testing.T) (usecase.ProjectService, earnedValueMocks) {
+	m := earnedValueMocks{
+		repo: portsMock.NewMockRepository(t),
+		costingService: costingUseCaseMock.NewMockCostingService(t),
+		taskService: taskUseCaseMock.NewMockTaskService(t),
+	}
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionView).Return(nil)
+
+	service := usecase.New(m.repo, userUseCaseMock.NewMockUserService(t), membershipServiceMock, auditUseCaseMock.NewMockAuditService(t), exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), m.costingService, m.taskService, usecase.DefaultBudgetApprovalPolicy, transaction.None())
+	return service, m
+}
+
+// TestReportEarnedValue is the classic textbook case: a $100,000 project half
+// way through its schedule is 40% complete and has cost $45,000.
+func TestReportEarnedValue(t *testing.T) {
+	t.Parallel()
+
+	service, m := newEarnedValueService(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	m.repo.On("GetProject", mock.Anything, 1).Return(domain.Project{
+		ID: 1,
+		StartDate: start,
+		EndDate: start.AddDate(0, 0, 9),
+		ProposedBudget: money.MustNew(10000000, "USD"),
+	}, nil)
+	m.taskService.On("ListProjectTasks", mock.Anything, 1, taskDomain.Filter{}).Return([]taskDomain.Task{
+		{ID: 1, ProjectID: 1, WBSCode: "1", Estimate: 80 * time.Hour, Status: taskDomain.StatusInProgress, Progress: 60},
+	}, nil)
+	m.taskService.On("ListProgressUpdates", mock.Anything, 1).Return([]taskDomain.ProgressUpdate{
+		{TaskID: 1, ProjectID: 1, Progress: 40, RecordedAt: start.AddDate(0, 0, 2)},
+		{TaskID: 1, ProjectID: 1, Progress: 60, RecordedAt: start.AddDate(0, 0, 7)},
+	}, nil)
+	m.costingService.On("ProjectActualCost", mock.Anything, costingUseCase.ActualCostRequest{ProjectID: 1, To: start.AddDate(0, 0, 5)}).
+		Return(costingDomain.ActualCost{
+			ProjectID: 1,
+			Expenses: map[string]money.Money{budgetDomain.CategoryLicenses: money.MustNew(2500000, "USD")},
+			Labor: money.MustNew(2000000, "USD"),
+			Total: money.MustNew(4500000, "USD"),
+		}, nil)
+
+	earnedValue, err := service.ReportEarnedValue(context.Background(), usecase.EarnedValueRequest{ProjectID: 1, AsOf: start.AddDate(0, 0, 4)})
+	require.NoError(t, err)
+	require.Equal(t, money.MustNew(10000000, "USD"), earnedValue.BudgetAtCompletion)
+	require.Equal(t, money.MustNew(5000000, "USD"), earnedValue.PlannedValue)
+	require.Equal(t, money.MustNew(4000000, "USD"), earnedValue.EarnedValue)
+	require.Equal(t, money.MustNew(4500000, "USD"), earnedValue.ActualCost)
+	require.Equal(t, money.MustNew(-500000, "USD"), earnedValue.CostVariance)
+	require.Equal(t, money.MustNew(-1000000, "USD"), earnedValue.ScheduleVariance)
+	require.InDelta(t, 0.889, earnedValue.CostPerformanceIndex, 0.001)
+	require.InDelta(t, 0.8, earnedValue.SchedulePerformanceIndex, 1e-9)
+	require.Equal(t, money.MustNew(11250000, "USD"), earnedValue.EstimateAtCompletion)
+}
+
+func TestReportEarnedValueSeries(t *testing.T) {
+	t.Parallel()
+
+	service, m := newEarnedValueService(t)
+	// A two week project starting on a Monday.
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	secondWeek := start.AddDate(0, 0, 7)
+
+	m.repo.On("GetProject", mock.Anything, 1).Return(domain.Project{
+		ID: 1,
+		StartDate: start,
+		EndDate: start.AddDate(0, 0, 13),
+		ProposedBudget: money.MustNew(1400000, "USD"),
+	}, nil)
+	m.taskService.On("ListProjectTasks", mock.Anything, 1, taskDomain.Filter{}).Return([]taskDomain.Task{
+		{ID: 1, ProjectID: 1, WBSCode: "1", Estimate: 100 * time.Hour},
+		{ID: 2, ProjectID: 1, ParentID: 1, WBSCode: "1.1", Estimate: 10 * time.Hour, DueDate: start.AddDate(0, 0, 6), Progress: 100},
+		{ID: 3, ProjectID: 1, ParentID: 1, WBSCode: "1.2", Estimate: 10 * time.Hour, Progress: 50},
+	}, nil)
+	m.taskService.On("ListProgressUpdates", mock.Anything, 1).Return([]taskDomain.ProgressUpdate{
+		{TaskID: 2, ProjectID: 1, Progress: 100, RecordedAt: start.AddDate(0, 0, 4)},
+		{TaskID: 3, ProjectID: 1, Progress: 50, RecordedAt: start.AddDate(0, 0, 9)},
+	}, nil)
+	m.costingService.On("ProjectActualCost", mock.Anything, costingUseCase.ActualCostRequest{ProjectID: 1, To: secondWeek}).
+		Return(costingDomain.ActualCost{ProjectID: 1, Labor: money.MustNew(600000, "USD"), Total: money.MustNew(600000, "USD")}, nil)
+	m.costingService.On("ProjectActualCost", mock.Anything, costingUseCase.ActualCostRequest{ProjectID: 1, To: start.AddDate(0, 0, 14)}).
+		Return(costingDomain.ActualCost{ProjectID: 1, Labor: money.MustNew(1000000, "USD"), Total: money.MustNew(1000000, "USD")}, nil)
+
+	series, err := service.ReportEarnedValueSeries(context.Background(), usecase.EarnedValueSeriesRequest{ProjectID: 1, To: start.AddDate(0, 0, 13), Interval: costingDomain.PeriodWeek})
+	require.NoError(t, err)
+	require.Len(t, series, 2)
+
+	require.Equal(t, start.AddDate(0, 0, 6), series[0].AsOf)
+	require.Equal(t, money.MustNew(1050000, "USD"), series[0].PlannedValue)
+	require.Equal(t, money.MustNew(700000, "USD"), series[0].EarnedValue)
+	require.Equal(t, money.MustNew(600000, "USD"), series[0].ActualCost)
+	require.InDelta(t, 0.667, series[0].SchedulePerformanceIndex, 0.001)
+	require.Equal(t, money.MustNew(1200000, "USD"), series[0].EstimateAtCompletion)
+
+	require.Equal(t, start.AddDate(0, 0, 13), series[1].AsOf)
+	require.Equal(t, money.MustNew(1400000, "USD"), series[1].PlannedValue)
+	require.Equal(t, money.MustNew(1050000, "USD"), series[1].EarnedValue)
+	require.Equal(t, money.MustNew(1000000, "USD"), series[1].ActualCost)
+	require.InDelta(t, 1.05, series[1].CostPerformanceIndex, 1e-9)
+	require.Equal(t, money.MustNew(1333333, "USD"), series[1].EstimateAtCompletion)
+}
+
+func TestReportEarnedValueWithoutCosts(t *testing.T) {
+	t.Parallel()
+
+	service, m := newEarnedValueService(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	m.repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, StartDate: start, EndDate: start.AddDate(0, 0, 9), ProposedBudget: money.MustNew(10000, "USD")}, nil)
+	m.taskService.On("ListProjectTasks", mock.Anything, 1, taskDomain.Filter{}).Return([]taskDomain.Task{}, nil)
+	m.taskService.On("ListProgressUpdates", mock.Anything, 1).Return([]taskDomain.ProgressUpdate{}, nil)
+	m.costingService.On("ProjectActualCost", mock.Anything, mock.Anything).Return(costingDomain.ActualCost{ProjectID: 1, Total: money.MustNew(0, "USD")}, nil)
+
+	earnedValue, err := service.ReportEarnedValue(context.Background(), usecase.EarnedValueRequest{ProjectID: 1, AsOf: start.AddDate(0, 0, 1)})
+	require.NoError(t, err)
+	require.Equal(t, money.MustNew(2000, "USD"), earnedValue.PlannedValue)
+	require.Zero(t, earnedValue.CostPerformanceIndex)
+	require.Zero(t, earnedValue.SchedulePerformanceIndex)
+	require.Equal(t, money.MustNew(10000, "USD"), earnedValue.EstimateAtCompletion)
+}
+
+func TestReportEarnedValueNeedsSchedule(t *testing.T) {
+	t.Parallel()
+
+	service, m := newEarnedValueService(t)
+	m.repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, StartDate: time.Now(), ProposedBudget: money.MustNew(10000, "USD")}, nil)
+
+	_, err := service.ReportEarnedValue(context.Background(), usecase.EarnedValueRequest{ProjectID: 1})
+	require.ErrorIs(t, err, usecase.ErrScheduleRequired)
+}
@@ -1,8 +1,62 @@
 package usecase
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	milestoneDomain "github.com/captainhbb/tbs-backend/internal/milestone/domain"
+)
 
 
 var (
 	ErrOwnerNotFound 			= errors.New("owner not found")
-)
\ No newline at end of file
+	ErrInvalidBudget			= errors.New("budget must be a non-negative amount with a currency")
+	ErrOwnerNotEligible			= errors.New("user is not eligible to own projects")
+	ErrAlreadyOwner				= errors.New("user already owns the project")
+	ErrTransferNotFound			= errors.New("ownership transfer not found")
+	ErrTransferNotPending		= errors.New("ownership transfer is not pending")
+	ErrTransferExpired			= errors.New("ownership transfer has expired")
+	ErrTransferAlreadyPending	= errors.New("project already has a pending ownership transfer")
+	ErrTransferStale			= errors.New("project owner has changed since the transfer was requested")
+	ErrNotTransferRecipient		= errors.New("only the recipient can respond to an ownership transfer")
+	ErrRevisionNotFound			= errors.New("project revision not found")
+	ErrUnknownCurrency			= errors.New("unknown reporting currency")
+	ErrApprovalNotFound			= errors.New("budget approval not found")
+	ErrApprovalNotPending		= errors.New("budget approval is not pending")
+	ErrNotApprover				= errors.New("user may not sign off the pending approval level")
+	ErrSelfApproval				= errors.New("a budget request cannot be signed off by its requester")
+	ErrAlreadySignedOff			= errors.New("user has already signed off another level of this approval")
+	ErrCommentRequired			= errors.New("a comment is required to reject a budget")
+	ErrMilestonesOutsideProject	= errors.New("milestones fall outside the project's start and end dates")
+	ErrScheduleRequired			= errors.New("earned value needs the project's start and end dates")
+	ErrInvalidInterval			= errors.New("interval must be week or month")
+	ErrNotAdmin					= errors.New("only admins can define custom fields")
+	ErrFieldDefinitionNotFound	= errors.New("custom field not found")
+	ErrFieldKeyAlreadyExists	= errors.New("a custom field with this key already exists")
+	ErrInvalidFieldKey			= errors.New("field key must be lower-case letters, digits and underscores, starting with a letter")
+	ErrFieldNameRequired		= errors.New("custom field name is required")
+	ErrInvalidFieldType			= errors.New("field type must be text, number, date, enum or user")
+	ErrOptionsRequired			= errors.New("an enum field needs at least one option")
+	ErrUnknownField				= errors.New("unknown custom field")
+	ErrFieldRequired			= errors.New("custom field is required")
+	ErrInvalidFieldValue		= errors.New("value does not match the custom field's type")
+)
+
+// MilestonesOutsideError lists the milestones a change of project dates would
+// leave outside the project window. It matches ErrMilestonesOutsideProject.
+type MilestonesOutsideError struct {
+	Milestones []milestoneDomain.Milestone
+}
+
+func (e *MilestonesOutsideError) Error() string {
+	names := make([]string, 0, len(e.Milestones))
+	for _, milestone := range e.Milestones {
+		names = append(names, milestone.Name)
+	}
+	return fmt.Sprintf("%s: %s", ErrMilestonesOutsideProject, strings.Join(names, ", "))
+}
+
+func (e *MilestonesOutsideError) Is(target error) bool {
+	return target == ErrMilestonesOutsideProject
+}
\ No newline at end of file
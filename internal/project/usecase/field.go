@@ -0,0 +1,414 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	auditDomain "github.com/captainhbb/tbs-backend/internal/audit/domain"
+	auditUseCase "github.com/captainhbb/tbs-backend/internal/audit/usecase"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	"github.com/captainhbb/tbs-backend/internal/project/ports"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+)
+
+var fieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+func(s *projectService) ListProjects(ctx context.Context, filter domain.Filter) ([]domain.Project, error) {
+	filter.Tags = domain.NormalizeTags(filter.Tags)
+	if len(filter.CustomFields) != 0 {
+		definitions, err := s.fieldDefinitions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		filter.CustomFields, err = s.normalizeFieldValues(ctx, definitions, filter.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	projects, err := s.repo.ListProjects(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return s.membershipService.FilterVisible(ctx, projects)
+}
+
+func(s *projectService) CreateFieldDefinition(ctx context.Context, createFieldDefinitionRequest CreateFieldDefinitionRequest) (domain.FieldDefinition, error) {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return domain.FieldDefinition{}, ErrNotAdmin
+	default:
+		return domain.FieldDefinition{}, err
+	}
+
+	if !fieldKeyPattern.MatchString(createFieldDefinitionRequest.Key) {
+		return domain.FieldDefinition{}, ErrInvalidFieldKey
+	}
+	if !domain.IsValidFieldType(createFieldDefinitionRequest.Type) {
+		return domain.FieldDefinition{}, ErrInvalidFieldType
+	}
+	definition := domain.FieldDefinition{
+		Key: createFieldDefinitionRequest.Key,
+		Type: createFieldDefinitionRequest.Type,
+	}
+	err = applyFieldDefinition(&definition, createFieldDefinitionRequest.Name, createFieldDefinitionRequest.Options, createFieldDefinitionRequest.Required)
+	if err != nil {
+		return domain.FieldDefinition{}, err
+	}
+
+	var createdDefinition domain.FieldDefinition
+	err = s.transactions.Do(ctx, func(ctx context.Context) error {
+		var err error
+		createdDefinition, err = s.repo.CreateFieldDefinition(ctx, definition)
+		switch err {
+		case nil:
+		case ports.ErrFieldKeyAlreadyExists:
+			return ErrFieldKeyAlreadyExists
+		default:
+			return err
+		}
+		return s.recordFieldDefinitionChange(ctx, auditDomain.ActionCreate, nil, &createdDefinition)
+	})
+	if err != nil {
+		return domain.FieldDefinition{}, err
+	}
+	return createdDefinition, nil
+}
+
+func(s *projectService) UpdateFieldDefinition(ctx context.Context, updateFieldDefinitionRequest UpdateFieldDefinitionRequest) (domain.FieldDefinition, error) {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return domain.FieldDefinition{}, ErrNotAdmin
+	default:
+		return domain.FieldDefinition{}, err
+	}
+
+	existingDefinition, err := s.repo.GetFieldDefinition(ctx, updateFieldDefinitionRequest.ID)
+	switch err {
+	case nil:
+	case ports.ErrFieldDefinitionNotFound:
+		return domain.FieldDefinition{}, ErrFieldDefinitionNotFound
+	default:
+		return domain.FieldDefinition{}, err
+	}
+	definition := existingDefinition
+	err = applyFieldDefinition(&definition, updateFieldDefinitionRequest.Name, updateFieldDefinitionRequest.Options, updateFieldDefinitionRequest.Required)
+	if err != nil {
+		return domain.FieldDefinition{}, err
+	}
+
+	var updatedDefinition domain.FieldDefinition
+	err = s.transactions.Do(ctx, func(ctx context.Context) error {
+		var err error
+		updatedDefinition, err = s.repo.UpdateFieldDefinition(ctx, definition)
+		switch err {
+		case nil:
+		case ports.ErrFieldDefinitionNotFound:
+			return ErrFieldDefinitionNotFound
+		default:
+			return err
+		}
+		err = s.recordFieldDefinitionChange(ctx, auditDomain.ActionUpdate, &existingDefinition, &updatedDefinition)
+		if err != nil {
+			return err
+		}
+
+		// Only the options of an enum field restrict its values.
+		if updatedDefinition.Type != domain.FieldTypeEnum {
+			return nil
+		}
+		return s.scrubFieldValues(ctx, updatedDefinition.Key, &updatedDefinition)
+	})
+	if err != nil {
+		return domain.FieldDefinition{}, err
+	}
+	return updatedDefinition, nil
+}
+
+func(s *projectService) DeleteFieldDefinition(ctx context.Context, id int) error {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return ErrNotAdmin
+	default:
+		return err
+	}
+
+	definition, err := s.repo.GetFieldDefinition(ctx, id)
+	switch err {
+	case nil:
+	case ports.ErrFieldDefinitionNotFound:
+		return ErrFieldDefinitionNotFound
+	default:
+		return err
+	}
+
+	return s.transactions.Do(ctx, func(ctx context.Context) error {
+		err := s.repo.DeleteFieldDefinition(ctx, id)
+		switch err {
+		case nil:
+		case ports.ErrFieldDefinitionNotFound:
+			return ErrFieldDefinitionNotFound
+		default:
+			return err
+		}
+		err = s.recordFieldDefinitionChange(ctx, auditDomain.ActionDelete, &definition, nil)
+		if err != nil {
+			return err
+		}
+		return s.scrubFieldValues(ctx, definition.Key, nil)
+	})
+}
+
+func(s *projectService) ListFieldDefinitions(ctx context.Context) ([]domain.FieldDefinition, error) {
+	return s.repo.ListFieldDefinitions(ctx)
+}
+
+// recordFieldDefinitionChange writes the audit entry for a field definition
+// mutation. before is nil for creates and after is nil for deletes.
+func(s *projectService) recordFieldDefinitionChange(ctx context.Context, action string, before *domain.FieldDefinition, after *domain.FieldDefinition) error {
+	record := auditUseCase.RecordRequest{
+		Action: action,
+		EntityType: auditDomain.EntityFieldDefinition,
+	}
+	if before != nil {
+		record.EntityID = before.ID
+		record.Before = *before
+	}
+	if after != nil {
+		record.EntityID = after.ID
+		record.After = *after
+	}
+	return s.auditService.Record(ctx, record)
+}
+
+// scrubFieldValues removes the values of the field key that definition no
+// longer accepts from every project, or all of them when the field has been
+// deleted and definition is nil.
+func(s *projectService) scrubFieldValues(ctx context.Context, key string, definition *domain.FieldDefinition) error {
+	projects, err := s.repo.ListProjects(ctx, domain.Filter{})
+	if err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		value, ok := project.CustomFields[key]
+		if !ok {
+			continue
+		}
+		if definition != nil {
+			_, err := s.normalizeFieldValue(ctx, *definition, value)
+			switch {
+			case err == nil:
+				continue
+			case !errors.Is(err, ErrInvalidFieldValue):
+				return err
+			}
+		}
+
+		scrubbedProject := project
+		scrubbedProject.CustomFields = make(map[string]string, len(project.CustomFields))
+		for otherKey, otherValue := range project.CustomFields {
+			if otherKey != key {
+				scrubbedProject.CustomFields[otherKey] = otherValue
+			}
+		}
+		if len(scrubbedProject.CustomFields) == 0 {
+			scrubbedProject.CustomFields = nil
+		}
+
+		updatedProject, err := s.repo.UpdateProject(ctx, scrubbedProject)
+		if err != nil {
+			return err
+		}
+		err = s.recordProjectChange(ctx, auditDomain.ActionUpdate, &project, &updatedProject)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoredFields keeps the values of a restored snapshot that the current
+// field definitions still accept. Required fields left without a value keep
+// the one the project has now.
+func(s *projectService) restoredFields(ctx context.Context, values map[string]string, current map[string]string) (map[string]string, error) {
+	if len(values) == 0 && len(current) == 0 {
+		return nil, nil
+	}
+	definitions, err := s.fieldDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]string, len(values))
+	for key, value := range values {
+		definition, ok := definitions[key]
+		if !ok {
+			continue
+		}
+		canonical, err := s.normalizeFieldValue(ctx, definition, value)
+		switch {
+		case err == nil:
+			fields[key] = canonical
+		case !errors.Is(err, ErrInvalidFieldValue):
+			return nil, err
+		}
+	}
+	for _, definition := range definitions {
+		if definition.Required && fields[definition.Key] == "" && current[definition.Key] != "" {
+			fields[definition.Key] = current[definition.Key]
+		}
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// applyFieldDefinition sets the changeable parts of a definition. Only enum
+// fields keep options; theirs are trimmed and deduplicated.
+func applyFieldDefinition(definition *domain.FieldDefinition, name string, options []string, required bool) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrFieldNameRequired
+	}
+
+	var enumOptions []string
+	if definition.Type == domain.FieldTypeEnum {
+		seen := make(map[string]bool, len(options))
+		for _, option := range options {
+			option = strings.TrimSpace(option)
+			if option == "" || seen[option] {
+				continue
+			}
+			seen[option] = true
+			enumOptions = append(enumOptions, option)
+		}
+		if len(enumOptions) == 0 {
+			return ErrOptionsRequired
+		}
+	}
+
+	definition.Name = name
+	definition.Options = enumOptions
+	definition.Required = required
+	return nil
+}
+
+// projectFields validates the custom field values of a project and checks that
+// required fields are set. A new project, with a nil existing one, must set
+// every required field; an existing project only may not clear one it has, so
+// projects that predate a field being made required can still be edited. It
+// returns nil when no field is set.
+func(s *projectService) projectFields(ctx context.Context, values map[string]string, existing *domain.Project) (map[string]string, error) {
+	definitions, err := s.fieldDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := s.normalizeFieldValues(ctx, definitions, values)
+	if err != nil {
+		return nil, err
+	}
+	for _, definition := range definitions {
+		if definition.Required && fields[definition.Key] == "" && (existing == nil || existing.CustomFields[definition.Key] != "") {
+			return nil, fmt.Errorf("%w: %s", ErrFieldRequired, definition.Key)
+		}
+	}
+	return fields, nil
+}
+
+func(s *projectService) fieldDefinitions(ctx context.Context) (map[string]domain.FieldDefinition, error) {
+	definitions, err := s.repo.ListFieldDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]domain.FieldDefinition, len(definitions))
+	for _, definition := range definitions {
+		byKey[definition.Key] = definition
+	}
+	return byKey, nil
+}
+
+// normalizeFieldValues brings every value into the canonical form of its
+// field's type and drops empty ones. It returns nil when no value is left.
+func(s *projectService) normalizeFieldValues(ctx context.Context, definitions map[string]domain.FieldDefinition, values map[string]string) (map[string]string, error) {
+	var normalized map[string]string
+	for key, value := range values {
+		definition, ok := definitions[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownField, key)
+		}
+
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		canonical, err := s.normalizeFieldValue(ctx, definition, value)
+		if err != nil {
+			return nil, err
+		}
+
+		if normalized == nil {
+			normalized = make(map[string]string, len(values))
+		}
+		normalized[key] = canonical
+	}
+	return normalized, nil
+}
+
+func(s *projectService) normalizeFieldValue(ctx context.Context, definition domain.FieldDefinition, value string) (string, error) {
+	invalid := fmt.Errorf("%w: %s", ErrInvalidFieldValue, definition.Key)
+	switch definition.Type {
+	case domain.FieldTypeText:
+		return value, nil
+	case domain.FieldTypeNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return "", invalid
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), nil
+	case domain.FieldTypeDate:
+		date, err := time.Parse(domain.DateFieldLayout, value)
+		if err != nil {
+			return "", invalid
+		}
+		return date.Format(domain.DateFieldLayout), nil
+	case domain.FieldTypeEnum:
+		for _, option := range definition.Options {
+			if option == value {
+				return value, nil
+			}
+		}
+		return "", invalid
+	case domain.FieldTypeUser:
+		userID, err := strconv.Atoi(value)
+		if err != nil {
+			return "", invalid
+		}
+		_, err = s.userService.GetUser(ctx, userID)
+		switch err {
+		case nil:
+		case userUseCase.ErrUserNotFound:
+			return "", invalid
+		default:
+			return "", err
+		}
+		return strconv.Itoa(userID), nil
+	}
+	return "", invalid
+}
@@ -0,0 +1,356 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	auditDomain "github.com/captainhbb/tbs-backend/internal/audit/domain"
+	auditUseCase "github.com/captainhbb/tbs-backend/internal/audit/usecase"
+	auditUseCaseMock "github.com/captainhbb/tbs-backend/internal/audit/usecase/mock"
+	budgetUseCaseMock "github.com/captainhbb/tbs-backend/internal/budget/usecase/mock"
+	costingUseCaseMock "github.com/captainhbb/tbs-backend/internal/costing/usecase/mock"
+	exchangeUseCaseMock "github.com/captainhbb/tbs-backend/internal/exchange/usecase/mock"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	milestoneUseCaseMock "github.com/captainhbb/tbs-backend/internal/milestone/usecase/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	portsRepository "github.com/captainhbb/tbs-backend/internal/project/ports"
+	portsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/usecase"
+	taskUseCaseMock "github.com/captainhbb/tbs-backend/internal/task/usecase/mock"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var fieldDefinitions = []domain.FieldDefinition{
+	{ID: 1, Key: "cost_center", Name: "Cost center", Type: domain.FieldTypeNumber},
+	{ID: 2, Key: "kickoff", Name: "Kickoff", Type: domain.FieldTypeDate},
+	{ID: 3, Key: "phase", Name: "Phase", Type: domain.FieldTypeEnum, Options: []string{"design", "build"}, Required: true},
+	{ID: 4, Key: "sponsor", Name: "Sponsor", Type: domain.FieldTypeUser},
+	{ID: 5, Key: "note", Name: "Note", Type: domain.FieldTypeText},
+}
+
+func TestListProjects(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	userServiceMock := userUseCaseMock.NewMockUserService(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	service := usecase.New(repoMock, userServiceMock, membershipServiceMock, auditUseCaseMock.NewMockAuditService(t), exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+	repoMock.On("ListFieldDefinitions", mock.Anything).Return(fieldDefinitions, nil)
+	userServiceMock.On("GetUser", mock.Anything, 7).Return(userDomain.User{ID: 7}, nil)
+	repoMock.On("ListProjects", mock.Anything, domain.Filter{
+		Status: "active",
+		Tags: []string{"emea", "pilot"},
+		CustomFields: map[string]string{
+			"cost_center": "1200.5",
+			"kickoff": "2026-03-01",
+			"phase": "build",
+			"sponsor": "7",
+		},
+	}).Return([]domain.Project{{ID: 1}, {ID: 2}, {ID: 3}}, nil)
+	membershipServiceMock.On("FilterVisible", mock.Anything, []domain.Project{{ID: 1}, {ID: 2}, {ID: 3}}).Return([]domain.Project{{ID: 1}, {ID: 3}}, nil).Once()
+
+	projects, err := service.ListProjects(context.Background(), domain.Filter{
+		Status: "active",
+		Tags: []string{" Pilot", "EMEA", "pilot", ""},
+		CustomFields: map[string]string{
+			"cost_center": "01200.50",
+			"kickoff": "2026-03-01",
+			"phase": " build ",
+			"sponsor": "007",
+			"note": " ",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []domain.Project{{ID: 1}, {ID: 3}}, projects)
+}
+
+func TestProjectCustomFieldValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		customFields map[string]string
+		expectedError error
+	}{
+		{
+			name: "unknown field",
+			customFields: map[string]string{"phase": "design", "region": "emea"},
+			expectedError: usecase.ErrUnknownField,
+		},
+		{
+			name: "invalid number",
+			customFields: map[string]string{"phase": "design", "cost_center": "twelve"},
+			expectedError: usecase.ErrInvalidFieldValue,
+		},
+		{
+			name: "invalid date",
+			customFields: map[string]string{"phase": "design", "kickoff": "01/03/2026"},
+			expectedError: usecase.ErrInvalidFieldValue,
+		},
+		{
+			name: "value not among options",
+			customFields: map[string]string{"phase": "testing"},
+			expectedError: usecase.ErrInvalidFieldValue,
+		},
+		{
+			name: "unknown user",
+			customFields: map[string]string{"phase": "design", "sponsor": "9"},
+			expectedError: usecase.ErrInvalidFieldValue,
+		},
+		{
+			name: "required field missing",
+			customFields: map[string]string{"phase": " ", "note": "late start"},
+			expectedError: usecase.ErrFieldRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			userServiceMock := userUseCaseMock.NewMockUserService(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			service := usecase.New(repoMock, userServiceMock, membershipServiceMock, auditUseCaseMock.NewMockAuditService(t), exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+			membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+			userServiceMock.On("GetUser", mock.Anything, 1).Return(userDomain.User{ID: 1}, nil)
+			userServiceMock.On("GetUser", mock.Anything, 9).Return(userDomain.User{}, userUseCase.ErrUserNotFound).Maybe()
+			repoMock.On("ListFieldDefinitions", mock.Anything).Return(fieldDefinitions, nil)
+			repoMock.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, CustomFields: map[string]string{"phase": "design"}}, nil)
+
+			_, err := service.UpdateProject(context.Background(), usecase.UpdateProjectRequest{
+				ID: 1,
+				Name: "Bridge",
+				ProposedBudget: money.MustNew(100000, "USD"),
+				OwnerID: 1,
+				CustomFields: tt.customFields,
+			})
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestUpdateProjectPredatingRequiredField(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	userServiceMock := userUseCaseMock.NewMockUserService(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+	service := usecase.New(repoMock, userServiceMock, membershipServiceMock, auditServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+	// The project was created before phase became required and has no value
+	// for it.
+	budget := money.MustNew(100000, "USD")
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+	userServiceMock.On("GetUser", mock.Anything, 1).Return(userDomain.User{ID: 1}, nil)
+	repoMock.On("ListFieldDefinitions", mock.Anything).Return(fieldDefinitions, nil)
+	repoMock.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, Name: "Bridge", ProposedBudget: budget, OwnerID: 1, CustomFields: map[string]string{"note": "late start"}}, nil)
+	repoMock.On("UpdateProject", mock.Anything, mock.Anything).Return(func(_ context.Context, project domain.Project) domain.Project {
+		return project
+	}, nil)
+	auditServiceMock.On("Record", mock.Anything, mock.Anything).Return(nil)
+	repoMock.On("CreateRevision", mock.Anything, mock.Anything).Return(domain.Revision{}, nil)
+
+	project, err := service.UpdateProject(context.Background(), usecase.UpdateProjectRequest{
+		ID: 1,
+		Name: "Bridge renamed",
+		ProposedBudget: budget,
+		OwnerID: 1,
+		CustomFields: map[string]string{"note": "on time"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"note": "on time"}, project.CustomFields)
+}
+
+func TestCreateFieldDefinition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		actorRole string
+		input usecase.CreateFieldDefinitionRequest
+		mockSetup func(repo *portsMock.MockRepository)
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "success",
+			actorRole: userDomain.RoleAdmin,
+			input: usecase.CreateFieldDefinitionRequest{Key: "phase", Name: " Phase ", Type: domain.FieldTypeEnum, Options: []string{"design", " build", "", "design"}},
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("CreateFieldDefinition", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.FieldDefinition)
+					require.Equal(t, "Phase", capturedArg.Name)
+					require.Equal(t, []string{"design", "build"}, capturedArg.Options)
+				}).Return(func(_ context.Context, definition domain.FieldDefinition) domain.FieldDefinition {
+					definition.ID = 1
+					return definition
+				}, nil)
+			},
+		},
+		{
+			name: "not an admin",
+			actorRole: userDomain.RoleManager,
+			input: usecase.CreateFieldDefinitionRequest{Key: "region", Name: "Region", Type: domain.FieldTypeText},
+			mockSetup: func(_ *portsMock.MockRepository) {},
+			expectError: true,
+			expectedError: usecase.ErrNotAdmin,
+		},
+		{
+			name: "invalid key",
+			actorRole: userDomain.RoleAdmin,
+			input: usecase.CreateFieldDefinitionRequest{Key: "Cost Center", Name: "Cost center", Type: domain.FieldTypeNumber},
+			mockSetup: func(_ *portsMock.MockRepository) {},
+			expectError: true,
+			expectedError: usecase.ErrInvalidFieldKey,
+		},
+		{
+			name: "invalid type",
+			actorRole: userDomain.RoleAdmin,
+			input: usecase.CreateFieldDefinitionRequest{Key: "region", Name: "Region", Type: "country"},
+			mockSetup: func(_ *portsMock.MockRepository) {},
+			expectError: true,
+			expectedError: usecase.ErrInvalidFieldType,
+		},
+		{
+			name: "enum without options",
+			actorRole: userDomain.RoleAdmin,
+			input: usecase.CreateFieldDefinitionRequest{Key: "phase", Name: "Phase", Type: domain.FieldTypeEnum, Options: []string{" "}},
+			mockSetup: func(_ *portsMock.MockRepository) {},
+			expectError: true,
+			expectedError: usecase.ErrOptionsRequired,
+		},
+		{
+			name: "key already exists",
+			actorRole: userDomain.RoleAdmin,
+			input: usecase.CreateFieldDefinitionRequest{Key: "region", Name: "Region", Type: domain.FieldTypeText},
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("CreateFieldDefinition", mock.Anything, mock.Anything).Return(domain.FieldDefinition{}, portsRepository.ErrFieldKeyAlreadyExists)
+			},
+			expectError: true,
+			expectedError: usecase.ErrFieldKeyAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			userServiceMock := userUseCaseMock.NewMockUserService(t)
+			auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+			service := usecase.New(repoMock, userServiceMock, membershipUseCaseMock.NewMockMembershipService(t), auditServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+			ctx := actor.WithID(context.Background(), 2)
+
+			userServiceMock.On("RequireRole", mock.Anything, userDomain.RoleAdmin).Return(func(_ context.Context, roles ...string) error {
+				if !slices.Contains(roles, tt.actorRole) {
+					return userUseCase.ErrForbidden
+				}
+				return nil
+			})
+			tt.mockSetup(repoMock)
+			auditServiceMock.On("Record", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			definition, err := service.CreateFieldDefinition(ctx, tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+				auditServiceMock.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 1, definition.ID)
+				require.Equal(t, "phase", definition.Key)
+				auditServiceMock.AssertCalled(t, "Record", mock.Anything, auditUseCase.RecordRequest{
+					Action: auditDomain.ActionCreate,
+					EntityType: auditDomain.EntityFieldDefinition,
+					EntityID: 1,
+					After: definition,
+				})
+			}
+		})
+	}
+}
+
+func TestFieldDefinitionChangesScrubProjectValues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name			string
+		change			func(service usecase.ProjectService, ctx context.Context) error
+		mockSetup		func(repo *portsMock.MockRepository)
+		expectedAction	string
+		expectedUpdates	[]domain.Project
+	}{
+		{
+			name: "removed enum options",
+			change: func(service usecase.ProjectService, ctx context.Context) error {
+				_, err := service.UpdateFieldDefinition(ctx, usecase.UpdateFieldDefinitionRequest{ID: 3, Name: "Phase", Options: []string{"design"}})
+				return err
+			},
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("UpdateFieldDefinition", mock.Anything, mock.Anything).Return(func(_ context.Context, definition domain.FieldDefinition) domain.FieldDefinition {
+					return definition
+				}, nil)
+			},
+			expectedAction: auditDomain.ActionUpdate,
+			expectedUpdates: []domain.Project{{ID: 2, CustomFields: map[string]string{"note": "keep"}}},
+		},
+		{
+			name: "deleted field",
+			change: func(service usecase.ProjectService, ctx context.Context) error {
+				return service.DeleteFieldDefinition(ctx, 3)
+			},
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("DeleteFieldDefinition", mock.Anything, 3).Return(nil)
+			},
+			expectedAction: auditDomain.ActionDelete,
+			expectedUpdates: []domain.Project{{ID: 1}, {ID: 2, CustomFields: map[string]string{"note": "keep"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoMock := portsMock.NewMockRepository(t)
+			userServiceMock := userUseCaseMock.NewMockUserService(t)
+			auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+			service := usecase.New(repoMock, userServiceMock, membershipUseCaseMock.NewMockMembershipService(t), auditServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+			userServiceMock.On("RequireRole", mock.Anything, userDomain.RoleAdmin).Return(nil)
+			repoMock.On("GetFieldDefinition", mock.Anything, 3).Return(fieldDefinitions[2], nil)
+			tt.mockSetup(repoMock)
+			repoMock.On("ListProjects", mock.Anything, domain.Filter{}).Return([]domain.Project{
+				{ID: 1, CustomFields: map[string]string{"phase": "design"}},
+				{ID: 2, CustomFields: map[string]string{"phase": "build", "note": "keep"}},
+				{ID: 3},
+			}, nil)
+			for _, project := range tt.expectedUpdates {
+				repoMock.On("UpdateProject", mock.Anything, project).Return(project, nil).Once()
+			}
+			auditServiceMock.On("Record", mock.Anything, mock.MatchedBy(func(record auditUseCase.RecordRequest) bool {
+				return record.EntityType == auditDomain.EntityFieldDefinition
+			})).Run(func(args mock.Arguments) {
+				capturedArg := args.Get(1).(auditUseCase.RecordRequest)
+				require.Equal(t, tt.expectedAction, capturedArg.Action)
+				require.Equal(t, 3, capturedArg.EntityID)
+				require.Equal(t, fieldDefinitions[2], capturedArg.Before)
+			}).Return(nil).Once()
+			auditServiceMock.On("Record", mock.Anything, mock.MatchedBy(func(record auditUseCase.RecordRequest) bool {
+				return record.EntityType == auditDomain.EntityProject
+			})).Return(nil).Times(len(tt.expectedUpdates))
+			repoMock.On("CreateRevision", mock.Anything, mock.Anything).Return(domain.Revision{}, nil).Times(len(tt.expectedUpdates))
+
+			err := tt.change(service, actor.WithID(context.Background(), 2))
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	auditDomain "github.com/captainhbb/tbs-backend/internal/audit/domain"
+	auditUseCase "github.com/captainhbb/tbs-backend/internal/audit/usecase"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	"github.com/captainhbb/tbs-backend/internal/project/ports"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+)
+
+const DefaultTransferExpiry = 7 * 24 * time.Hour
+
+var ownerEligibleRoles = map[string]bool{
+	userDomain.RoleAdmin: true,
+	userDomain.RoleManager: true,
+}
+
+func(s *projectService) TransferOwnership(ctx context.Context, transferOwnershipRequest TransferOwnershipRequest) (domain.OwnershipTransfer, error) {
+	err := s.membershipService.Authorize(ctx, transferOwnershipRequest.ProjectID, membershipDomain.PermissionAdminister)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	project, err := s.repo.GetProject(ctx, transferOwnershipRequest.ProjectID)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	if project.OwnerID == transferOwnershipRequest.NewOwnerID {
+		return domain.OwnershipTransfer{}, ErrAlreadyOwner
+	}
+
+	err = s.checkOwnerEligible(ctx, transferOwnershipRequest.NewOwnerID)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	now := time.Now()
+	transfer := domain.OwnershipTransfer{
+		ProjectID: project.ID,
+		FromOwnerID: project.OwnerID,
+		ToOwnerID: transferOwnershipRequest.NewOwnerID,
+		RequestedAt: now,
+	}
+
+	if !transferOwnershipRequest.RequireAcceptance {
+		_, err := s.changeOwner(ctx, project, transfer.ToOwnerID, now)
+		if err != nil {
+			return domain.OwnershipTransfer{}, err
+		}
+		transfer.Status = domain.TransferStatusCompleted
+		transfer.RespondedAt = now
+		return transfer, nil
+	}
+
+	err = s.checkNoPendingTransfer(ctx, project)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	expiresIn := transferOwnershipRequest.ExpiresIn
+	if expiresIn <= 0 {
+		expiresIn = DefaultTransferExpiry
+	}
+	transfer.Status = domain.TransferStatusPending
+	transfer.ExpiresAt = now.Add(expiresIn)
+	var createdTransfer domain.OwnershipTransfer
+	err = s.transactions.Do(ctx, func(ctx context.Context) error {
+		var err error
+		createdTransfer, err = s.repo.CreateOwnershipTransfer(ctx, transfer)
+		switch err {
+		case nil:
+		case ports.ErrPendingTransferExists:
+			return ErrTransferAlreadyPending
+		default:
+			return err
+		}
+		return s.recordTransferChange(ctx, auditDomain.ActionCreate, nil, createdTransfer)
+	})
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	return createdTransfer, nil
+}
+
+func(s *projectService) AcceptOwnershipTransfer(ctx context.Context, respondRequest RespondOwnershipTransferRequest) (domain.Project, error) {
+	transfer, err := s.pendingTransferFor(ctx, respondRequest)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	err = s.checkOwnerEligible(ctx, transfer.ToOwnerID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	project, err := s.repo.GetProject(ctx, transfer.ProjectID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	if project.OwnerID != transfer.FromOwnerID {
+		err := s.cancelTransfer(ctx, transfer)
+		if err != nil {
+			return domain.Project{}, err
+		}
+		return domain.Project{}, ErrTransferStale
+	}
+
+	now := time.Now()
+	var updatedProject domain.Project
+	err = s.transactions.Do(ctx, func(ctx context.Context) error {
+		var err error
+		updatedProject, err = s.changeOwner(ctx, project, transfer.ToOwnerID, now)
+		if err != nil {
+			return err
+		}
+
+		completedTransfer := transfer
+		completedTransfer.Status = domain.TransferStatusCompleted
+		completedTransfer.RespondedAt = now
+		return s.updateTransfer(ctx, transfer, completedTransfer)
+	})
+	if err != nil {
+		return domain.Project{}, err
+	}
+	return updatedProject, nil
+}
+
+func(s *projectService) DeclineOwnershipTransfer(ctx context.Context, respondRequest RespondOwnershipTransferRequest) (domain.OwnershipTransfer, error) {
+	transfer, err := s.pendingTransferFor(ctx, respondRequest)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	declinedTransfer := transfer
+	declinedTransfer.Status = domain.TransferStatusDeclined
+	declinedTransfer.RespondedAt = time.Now()
+	err = s.updateTransfer(ctx, transfer, declinedTransfer)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	return declinedTransfer, nil
+}
+
+func(s *projectService) ListOwnershipHistory(ctx context.Context, projectID int) ([]domain.OwnerChange, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListOwnerChanges(ctx, projectID)
+}
+
+func(s *projectService) HandOverProjects(ctx context.Context, fromOwnerID int, toOwnerID int) error {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return membershipUseCase.ErrForbidden
+	default:
+		return err
+	}
+
+	projects, err := s.repo.ListProjectsByOwner(ctx, fromOwnerID)
+	if err != nil {
+		return err
+	}
+	if len(projects) == 0 {
+		return nil
+	}
+
+	err = s.checkOwnerEligible(ctx, toOwnerID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	return s.transactions.Do(ctx, func(ctx context.Context) error {
+		for _, project := range projects {
+			_, err := s.changeOwner(ctx, project, toOwnerID, now)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// pendingTransferFor loads a transfer the acting user may still respond to.
+// A transfer found past its expiry is marked expired on the way out.
+func(s *projectService) pendingTransferFor(ctx context.Context, respondRequest RespondOwnershipTransferRequest) (domain.OwnershipTransfer, error) {
+	transfer, err := s.repo.GetOwnershipTransfer(ctx, respondRequest.TransferID)
+	switch err {
+	case nil:
+	case ports.ErrOwnershipTransferNotFound:
+		return domain.OwnershipTransfer{}, ErrTransferNotFound
+	default:
+		return domain.OwnershipTransfer{}, err
+	}
+
+	actorID, ok := actor.IDFromContext(ctx)
+	if !ok || transfer.ToOwnerID != actorID {
+		return domain.OwnershipTransfer{}, ErrNotTransferRecipient
+	}
+	if transfer.Status != domain.TransferStatusPending {
+		return domain.OwnershipTransfer{}, ErrTransferNotPending
+	}
+
+	if !time.Now().Before(transfer.ExpiresAt) {
+		expiredTransfer := transfer
+		expiredTransfer.Status = domain.TransferStatusExpired
+		err := s.updateTransfer(ctx, transfer, expiredTransfer)
+		if err != nil {
+			return domain.OwnershipTransfer{}, err
+		}
+		return domain.OwnershipTransfer{}, ErrTransferExpired
+	}
+	return transfer, nil
+}
+
+// checkNoPendingTransfer fails when the project already has a transfer
+// awaiting its recipient. A pending transfer that has expired, or that was
+// requested by a previous owner, is closed instead.
+func(s *projectService) checkNoPendingTransfer(ctx context.Context, project domain.Project) error {
+	transfer, err := s.repo.GetPendingOwnershipTransfer(ctx, project.ID)
+	switch err {
+	case nil:
+	case ports.ErrOwnershipTransferNotFound:
+		return nil
+	default:
+		return err
+	}
+
+	switch {
+	case transfer.FromOwnerID != project.OwnerID:
+		return s.cancelTransfer(ctx, transfer)
+	case !time.Now().Before(transfer.ExpiresAt):
+		expiredTransfer := transfer
+		expiredTransfer.Status = domain.TransferStatusExpired
+		return s.updateTransfer(ctx, transfer, expiredTransfer)
+	}
+	return ErrTransferAlreadyPending
+}
+
+func(s *projectService) cancelTransfer(ctx context.Context, transfer domain.OwnershipTransfer) error {
+	cancelledTransfer := transfer
+	cancelledTransfer.Status = domain.TransferStatusCancelled
+	cancelledTransfer.RespondedAt = time.Now()
+	return s.updateTransfer(ctx, transfer, cancelledTransfer)
+}
+
+func(s *projectService) checkOwnerEligible(ctx context.Context, userID int) error {
+	user, err := s.userService.GetUser(ctx, userID)
+	switch err {
+	case nil:
+	case userUseCase.ErrUserNotFound:
+		return ErrOwnerNotFound
+	default:
+		return err
+	}
+
+	if !user.Active || !ownerEligibleRoles[user.Role] {
+		return ErrOwnerNotEligible
+	}
+	return nil
+}
+
+func(s *projectService) changeOwner(ctx context.Context, project domain.Project, newOwnerID int, changedAt time.Time) (domain.Project, error) {
+	var updatedProject domain.Project
+	err := s.transactions.Do(ctx, func(ctx context.Context) error {
+		var err error
+		updatedProject, err = s.repo.ChangeOwner(ctx, domain.OwnerChange{
+			ProjectID: project.ID,
+			PreviousOwnerID: project.OwnerID,
+			NewOwnerID: newOwnerID,
+			ChangedAt: changedAt,
+		})
+		if err != nil {
+			return err
+		}
+		return s.recordProjectChange(ctx, auditDomain.ActionTransferOwnership, &project, &updatedProject)
+	})
+	if err != nil {
+		return domain.Project{}, err
+	}
+	return updatedProject, nil
+}
+
+func(s *projectService) updateTransfer(ctx context.Context, before domain.OwnershipTransfer, after domain.OwnershipTransfer) error {
+	return s.transactions.Do(ctx, func(ctx context.Context) error {
+		_, err := s.repo.UpdateOwnershipTransfer(ctx, after)
+		if err != nil {
+			return err
+		}
+		return s.recordTransferChange(ctx, auditDomain.ActionUpdate, before, after)
+	})
+}
+
+func(s *projectService) recordTransferChange(ctx context.Context, action string, before any, after domain.OwnershipTransfer) error {
+	return s.auditService.Record(ctx, auditUseCase.RecordRequest{
+		Action: action,
+		EntityType: auditDomain.EntityOwnershipTransfer,
+		EntityID: after.ID,
+		Before: before,
+		After: after,
+	})
+}
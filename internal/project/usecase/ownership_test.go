@@ -0,0 +1,372 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	auditDomain "github.com/captainhbb/tbs-backend/internal/audit/domain"
+	auditUseCase "github.com/captainhbb/tbs-backend/internal/audit/usecase"
+	auditUseCaseMock "github.com/captainhbb/tbs-backend/internal/audit/usecase/mock"
+	budgetUseCaseMock "github.com/captainhbb/tbs-backend/internal/budget/usecase/mock"
+	costingUseCaseMock "github.com/captainhbb/tbs-backend/internal/costing/usecase/mock"
+	exchangeUseCaseMock "github.com/captainhbb/tbs-backend/internal/exchange/usecase/mock"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	milestoneUseCaseMock "github.com/captainhbb/tbs-backend/internal/milestone/usecase/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	portsRepository "github.com/captainhbb/tbs-backend/internal/project/ports"
+	portsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/usecase"
+	taskUseCaseMock "github.com/captainhbb/tbs-backend/internal/task/usecase/mock"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferOwnership(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		input usecase.TransferOwnershipRequest
+		mockSetup func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService)
+		expectError bool
+		expectedError error
+		expectedStatus string
+	}{
+		{
+			name: "immediate transfer",
+			input: usecase.TransferOwnershipRequest{ProjectID: 1, NewOwnerID: 2},
+			mockSetup: func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, OwnerID: 1}, nil)
+				userUserCase.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, Role: userDomain.RoleManager, Active: true}, nil)
+				repo.On("ChangeOwner", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.OwnerChange)
+					require.Equal(t, 1, capturedArg.ProjectID)
+					require.Equal(t, 1, capturedArg.PreviousOwnerID)
+					require.Equal(t, 2, capturedArg.NewOwnerID)
+				}).Return(domain.Project{ID: 1, OwnerID: 2}, nil)
+				auditService.On("Record", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(auditUseCase.RecordRequest)
+					require.Equal(t, auditDomain.ActionTransferOwnership, capturedArg.Action)
+					require.Equal(t, []auditDomain.FieldChange{{Field: "OwnerID", Before: "1", After: "2"}}, auditDomain.Diff(capturedArg.Before, capturedArg.After))
+				}).Return(nil)
+				repo.On("CreateRevision", mock.Anything, mock.Anything).Return(domain.Revision{}, nil)
+			},
+			expectedStatus: domain.TransferStatusCompleted,
+		},
+		{
+			name: "transfer awaiting acceptance",
+			input: usecase.TransferOwnershipRequest{ProjectID: 1, NewOwnerID: 2, RequireAcceptance: true},
+			mockSetup: func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, OwnerID: 1}, nil)
+				userUserCase.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, Role: userDomain.RoleAdmin, Active: true}, nil)
+				repo.On("GetPendingOwnershipTransfer", mock.Anything, 1).Return(domain.OwnershipTransfer{}, portsRepository.ErrOwnershipTransferNotFound)
+				repo.On("CreateOwnershipTransfer", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.OwnershipTransfer)
+					require.Equal(t, domain.TransferStatusPending, capturedArg.Status)
+					require.WithinDuration(t, time.Now().Add(usecase.DefaultTransferExpiry), capturedArg.ExpiresAt, time.Minute)
+				}).Return(domain.OwnershipTransfer{ID: 5, ProjectID: 1, FromOwnerID: 1, ToOwnerID: 2, Status: domain.TransferStatusPending}, nil)
+				auditService.On("Record", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(auditUseCase.RecordRequest)
+					require.Equal(t, auditDomain.EntityOwnershipTransfer, capturedArg.EntityType)
+					require.Equal(t, 5, capturedArg.EntityID)
+				}).Return(nil)
+			},
+			expectedStatus: domain.TransferStatusPending,
+		},
+		{
+			name: "another transfer is pending",
+			input: usecase.TransferOwnershipRequest{ProjectID: 1, NewOwnerID: 2, RequireAcceptance: true},
+			mockSetup: func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, OwnerID: 1}, nil)
+				userUserCase.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, Role: userDomain.RoleAdmin, Active: true}, nil)
+				repo.On("GetPendingOwnershipTransfer", mock.Anything, 1).Return(domain.OwnershipTransfer{ID: 4, ProjectID: 1, FromOwnerID: 1, ToOwnerID: 3, Status: domain.TransferStatusPending, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrTransferAlreadyPending,
+		},
+		{
+			name: "cancels a transfer requested by a previous owner",
+			input: usecase.TransferOwnershipRequest{ProjectID: 1, NewOwnerID: 2, RequireAcceptance: true},
+			mockSetup: func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, OwnerID: 1}, nil)
+				userUserCase.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, Role: userDomain.RoleAdmin, Active: true}, nil)
+				repo.On("GetPendingOwnershipTransfer", mock.Anything, 1).Return(domain.OwnershipTransfer{ID: 4, ProjectID: 1, FromOwnerID: 6, ToOwnerID: 3, Status: domain.TransferStatusPending, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+				repo.On("UpdateOwnershipTransfer", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.OwnershipTransfer)
+					require.Equal(t, 4, capturedArg.ID)
+					require.Equal(t, domain.TransferStatusCancelled, capturedArg.Status)
+				}).Return(domain.OwnershipTransfer{}, nil)
+				repo.On("CreateOwnershipTransfer", mock.Anything, mock.Anything).Return(domain.OwnershipTransfer{ID: 5, ProjectID: 1, FromOwnerID: 1, ToOwnerID: 2, Status: domain.TransferStatusPending}, nil)
+				auditService.On("Record", mock.Anything, mock.Anything).Return(nil).Twice()
+			},
+			expectedStatus: domain.TransferStatusPending,
+		},
+		{
+			name: "recipient not found",
+			input: usecase.TransferOwnershipRequest{ProjectID: 1, NewOwnerID: 2},
+			mockSetup: func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, OwnerID: 1}, nil)
+				userUserCase.On("GetUser", mock.Anything, 2).Return(userDomain.User{}, userUseCase.ErrUserNotFound)
+			},
+			expectError: true,
+			expectedError: usecase.ErrOwnerNotFound,
+		},
+		{
+			name: "recipient role not eligible",
+			input: usecase.TransferOwnershipRequest{ProjectID: 1, NewOwnerID: 2},
+			mockSetup: func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, OwnerID: 1}, nil)
+				userUserCase.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, Role: "viewer", Active: true}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrOwnerNotEligible,
+		},
+		{
+			name: "already owner",
+			input: usecase.TransferOwnershipRequest{ProjectID: 1, NewOwnerID: 1},
+			mockSetup: func(repo *portsMock.MockRepository, _ *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, OwnerID: 1}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrAlreadyOwner,
+		},
+		{
+			name: "project not found",
+			input: usecase.TransferOwnershipRequest{ProjectID: 1, NewOwnerID: 2},
+			mockSetup: func(repo *portsMock.MockRepository, _ *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{}, portsRepository.ErrProjectNotFound)
+			},
+			expectError: true,
+			expectedError: portsRepository.ErrProjectNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			userServiceMock := userUseCaseMock.NewMockUserService(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+			service := usecase.New(repoMock, userServiceMock, membershipServiceMock, auditServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+			ctx := context.Background()
+
+			membershipServiceMock.On("Authorize", mock.Anything, tt.input.ProjectID, membershipDomain.PermissionAdminister).Return(nil)
+			tt.mockSetup(repoMock, userServiceMock, auditServiceMock)
+
+			transfer, err := service.TransferOwnership(ctx, tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedStatus, transfer.Status)
+				require.Equal(t, tt.input.NewOwnerID, transfer.ToOwnerID)
+			}
+
+			userServiceMock.AssertExpectations(t)
+			repoMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAcceptOwnershipTransfer(t *testing.T) {
+	t.Parallel()
+
+	pendingTransfer := domain.OwnershipTransfer{
+		ID: 5,
+		ProjectID: 1,
+		FromOwnerID: 1,
+		ToOwnerID: 2,
+		Status: domain.TransferStatusPending,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	expiredTransfer := pendingTransfer
+	expiredTransfer.ExpiresAt = time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name string
+		actorID int
+		input usecase.RespondOwnershipTransferRequest
+		mockSetup func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService)
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "success",
+			actorID: 2,
+			input: usecase.RespondOwnershipTransferRequest{TransferID: 5},
+			mockSetup: func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetOwnershipTransfer", mock.Anything, 5).Return(pendingTransfer, nil)
+				userUserCase.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, Role: userDomain.RoleManager, Active: true}, nil)
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, OwnerID: 1}, nil)
+				repo.On("ChangeOwner", mock.Anything, mock.Anything).Return(domain.Project{ID: 1, OwnerID: 2}, nil)
+				auditService.On("Record", mock.Anything, mock.Anything).Return(nil).Twice()
+				repo.On("CreateRevision", mock.Anything, mock.Anything).Return(domain.Revision{}, nil)
+				repo.On("UpdateOwnershipTransfer", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.OwnershipTransfer)
+					require.Equal(t, domain.TransferStatusCompleted, capturedArg.Status)
+				}).Return(domain.OwnershipTransfer{}, nil)
+			},
+		},
+		{
+			name: "owner changed since the request",
+			actorID: 2,
+			input: usecase.RespondOwnershipTransferRequest{TransferID: 5},
+			mockSetup: func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetOwnershipTransfer", mock.Anything, 5).Return(pendingTransfer, nil)
+				userUserCase.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, Role: userDomain.RoleManager, Active: true}, nil)
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, OwnerID: 4}, nil)
+				repo.On("UpdateOwnershipTransfer", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.OwnershipTransfer)
+					require.Equal(t, domain.TransferStatusCancelled, capturedArg.Status)
+				}).Return(domain.OwnershipTransfer{}, nil)
+				auditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrTransferStale,
+		},
+		{
+			name: "not the recipient",
+			actorID: 3,
+			input: usecase.RespondOwnershipTransferRequest{TransferID: 5},
+			mockSetup: func(repo *portsMock.MockRepository, _ *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetOwnershipTransfer", mock.Anything, 5).Return(pendingTransfer, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrNotTransferRecipient,
+		},
+		{
+			name: "no acting user",
+			input: usecase.RespondOwnershipTransferRequest{TransferID: 5},
+			mockSetup: func(repo *portsMock.MockRepository, _ *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetOwnershipTransfer", mock.Anything, 5).Return(pendingTransfer, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrNotTransferRecipient,
+		},
+		{
+			name: "expired",
+			actorID: 2,
+			input: usecase.RespondOwnershipTransferRequest{TransferID: 5},
+			mockSetup: func(repo *portsMock.MockRepository, _ *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetOwnershipTransfer", mock.Anything, 5).Return(expiredTransfer, nil)
+				repo.On("UpdateOwnershipTransfer", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.OwnershipTransfer)
+					require.Equal(t, domain.TransferStatusExpired, capturedArg.Status)
+				}).Return(domain.OwnershipTransfer{}, nil)
+				auditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrTransferExpired,
+		},
+		{
+			name: "transfer not found",
+			actorID: 2,
+			input: usecase.RespondOwnershipTransferRequest{TransferID: 6},
+			mockSetup: func(repo *portsMock.MockRepository, _ *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetOwnershipTransfer", mock.Anything, 6).Return(domain.OwnershipTransfer{}, portsRepository.ErrOwnershipTransferNotFound)
+			},
+			expectError: true,
+			expectedError: usecase.ErrTransferNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			userServiceMock := userUseCaseMock.NewMockUserService(t)
+			auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+			service := usecase.New(repoMock, userServiceMock, membershipUseCaseMock.NewMockMembershipService(t), auditServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+			ctx := context.Background()
+			if tt.actorID != 0 {
+				ctx = actor.WithID(ctx, tt.actorID)
+			}
+
+			tt.mockSetup(repoMock, userServiceMock, auditServiceMock)
+
+			project, err := service.AcceptOwnershipTransfer(ctx, tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.actorID, project.OwnerID)
+			}
+
+			userServiceMock.AssertExpectations(t)
+			repoMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeclineOwnershipTransfer(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+	service := usecase.New(repoMock, userUseCaseMock.NewMockUserService(t), membershipUseCaseMock.NewMockMembershipService(t), auditServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+	repoMock.On("GetOwnershipTransfer", mock.Anything, 5).Return(domain.OwnershipTransfer{
+		ID: 5,
+		ProjectID: 1,
+		FromOwnerID: 1,
+		ToOwnerID: 2,
+		Status: domain.TransferStatusPending,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+
+	_, err := service.DeclineOwnershipTransfer(actor.WithID(context.Background(), 3), usecase.RespondOwnershipTransferRequest{TransferID: 5})
+	require.ErrorIs(t, err, usecase.ErrNotTransferRecipient)
+
+	repoMock.On("UpdateOwnershipTransfer", mock.Anything, mock.Anything).Return(domain.OwnershipTransfer{}, nil).Once()
+	auditServiceMock.On("Record", mock.Anything, mock.Anything).Return(nil).Once()
+
+	transfer, err := service.DeclineOwnershipTransfer(actor.WithID(context.Background(), 2), usecase.RespondOwnershipTransferRequest{TransferID: 5})
+	require.NoError(t, err)
+	require.Equal(t, domain.TransferStatusDeclined, transfer.Status)
+}
+
+func TestHandOverProjects(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	userServiceMock := userUseCaseMock.NewMockUserService(t)
+	auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+	service := usecase.New(repoMock, userServiceMock, membershipUseCaseMock.NewMockMembershipService(t), auditServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+	userServiceMock.On("RequireRole", mock.Anything, userDomain.RoleAdmin).Return(nil)
+	repoMock.On("ListProjectsByOwner", mock.Anything, 3).Return([]domain.Project{{ID: 10, OwnerID: 3}, {ID: 11, OwnerID: 3}}, nil)
+	userServiceMock.On("GetUser", mock.Anything, 4).Return(userDomain.User{ID: 4, Role: userDomain.RoleManager, Active: true}, nil)
+	repoMock.On("ChangeOwner", mock.Anything, mock.Anything).Return(func(_ context.Context, change domain.OwnerChange) (domain.Project, error) {
+		require.Equal(t, 3, change.PreviousOwnerID)
+		require.Equal(t, 4, change.NewOwnerID)
+		return domain.Project{ID: change.ProjectID, OwnerID: change.NewOwnerID}, nil
+	}).Twice()
+	auditServiceMock.On("Record", mock.Anything, mock.MatchedBy(func(record auditUseCase.RecordRequest) bool {
+		return record.Action == auditDomain.ActionTransferOwnership
+	})).Return(nil).Twice()
+	repoMock.On("CreateRevision", mock.Anything, mock.Anything).Return(domain.Revision{}, nil).Twice()
+
+	err := service.HandOverProjects(context.Background(), 3, 4)
+	require.NoError(t, err)
+}
+
+func TestHandOverProjectsIsForAdmins(t *testing.T) {
+	t.Parallel()
+
+	userServiceMock := userUseCaseMock.NewMockUserService(t)
+	service := usecase.New(portsMock.NewMockRepository(t), userServiceMock, membershipUseCaseMock.NewMockMembershipService(t), auditUseCaseMock.NewMockAuditService(t), exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+	userServiceMock.On("RequireRole", mock.Anything, userDomain.RoleAdmin).Return(userUseCase.ErrForbidden)
+
+	err := service.HandOverProjects(actor.WithID(context.Background(), 4), 3, 4)
+	require.ErrorIs(t, err, membershipUseCase.ErrForbidden)
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	exchangeDomain "github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	exchangeUseCase "github.com/captainhbb/tbs-backend/internal/exchange/usecase"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+func(s *projectService) ReportProjectBudgets(ctx context.Context, reportRequest ProjectBudgetReportRequest) (domain.BudgetReport, error) {
+	total, err := money.Zero(reportRequest.Currency)
+	if err != nil {
+		return domain.BudgetReport{}, ErrUnknownCurrency
+	}
+
+	asOf := reportRequest.AsOf
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+
+	report := domain.BudgetReport{
+		Currency: reportRequest.Currency,
+		AsOf: exchangeDomain.Day(asOf),
+		Lines: make([]domain.BudgetReportLine, 0, len(reportRequest.ProjectIDs)),
+	}
+	for _, projectID := range reportRequest.ProjectIDs {
+		err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+		if err != nil {
+			return domain.BudgetReport{}, err
+		}
+
+		project, err := s.repo.GetProject(ctx, projectID)
+		if err != nil {
+			return domain.BudgetReport{}, err
+		}
+
+		conversion, err := s.exchangeService.Convert(ctx, exchangeUseCase.ConvertRequest{
+			Amount: project.ProposedBudget,
+			Currency: reportRequest.Currency,
+			On: asOf,
+		})
+		if err != nil {
+			return domain.BudgetReport{}, err
+		}
+
+		total, err = total.Add(conversion.Converted)
+		if err != nil {
+			return domain.BudgetReport{}, err
+		}
+		report.Lines = append(report.Lines, domain.BudgetReportLine{
+			ProjectID: project.ID,
+			ProjectName: project.Name,
+			ProposedBudget: project.ProposedBudget,
+			ReportedBudget: conversion.Converted,
+			Rate: conversion.Rate,
+			RateDate: conversion.RateDate,
+		})
+	}
+	report.Total = total
+	return report, nil
+}
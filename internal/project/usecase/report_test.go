@@ -0,0 +1,95 @@
+package usecase_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	auditUseCaseMock "github.com/captainhbb/tbs-backend/internal/audit/usecase/mock"
+	budgetUseCaseMock "github.com/captainhbb/tbs-backend/internal/budget/usecase/mock"
+	costingUseCaseMock "github.com/captainhbb/tbs-backend/internal/costing/usecase/mock"
+	exchangeDomain "github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	exchangeUseCase "github.com/captainhbb/tbs-backend/internal/exchange/usecase"
+	exchangeUseCaseMock "github.com/captainhbb/tbs-backend/internal/exchange/usecase/mock"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	milestoneUseCaseMock "github.com/captainhbb/tbs-backend/internal/milestone/usecase/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/usecase"
+	taskUseCaseMock "github.com/captainhbb/tbs-backend/internal/task/usecase/mock"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportProjectBudgets(t *testing.T) {
+	t.Parallel()
+
+	asOf := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		input usecase.ProjectBudgetReportRequest
+		mockSetup func(repo *portsMock.MockRepository, exchangeService *exchangeUseCaseMock.MockExchangeService)
+		expectedTotal money.Money
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "converts every project into the reporting currency",
+			input: usecase.ProjectBudgetReportRequest{ProjectIDs: []int{1, 2}, Currency: "EUR", AsOf: asOf},
+			mockSetup: func(repo *portsMock.MockRepository, exchangeService *exchangeUseCaseMock.MockExchangeService) {
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, Name: "Bridge", ProposedBudget: money.MustNew(10956, "USD")}, nil)
+				repo.On("GetProject", mock.Anything, 2).Return(domain.Project{ID: 2, Name: "Tunnel", ProposedBudget: money.MustNew(5000, "EUR")}, nil)
+				exchangeService.On("Convert", mock.Anything, exchangeUseCase.ConvertRequest{Amount: money.MustNew(10956, "USD"), Currency: "EUR", On: asOf}).
+					Return(exchangeDomain.Conversion{Original: money.MustNew(10956, "USD"), Converted: money.MustNew(10000, "EUR"), Rate: big.NewRat(10000, 10956), RateDate: asOf}, nil)
+				exchangeService.On("Convert", mock.Anything, exchangeUseCase.ConvertRequest{Amount: money.MustNew(5000, "EUR"), Currency: "EUR", On: asOf}).
+					Return(exchangeDomain.Conversion{Original: money.MustNew(5000, "EUR"), Converted: money.MustNew(5000, "EUR"), Rate: big.NewRat(1, 1), RateDate: asOf}, nil)
+			},
+			expectedTotal: money.MustNew(15000, "EUR"),
+		},
+		{
+			name: "missing rate",
+			input: usecase.ProjectBudgetReportRequest{ProjectIDs: []int{1}, Currency: "EUR", AsOf: asOf},
+			mockSetup: func(repo *portsMock.MockRepository, exchangeService *exchangeUseCaseMock.MockExchangeService) {
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, ProposedBudget: money.MustNew(10956, "USD")}, nil)
+				exchangeService.On("Convert", mock.Anything, mock.Anything).Return(exchangeDomain.Conversion{}, exchangeUseCase.ErrRateNotFound)
+			},
+			expectError: true,
+			expectedError: exchangeUseCase.ErrRateNotFound,
+		},
+		{
+			name: "unknown reporting currency",
+			input: usecase.ProjectBudgetReportRequest{ProjectIDs: []int{1}, Currency: "XYZ", AsOf: asOf},
+			mockSetup: func(_ *portsMock.MockRepository, _ *exchangeUseCaseMock.MockExchangeService) {},
+			expectError: true,
+			expectedError: usecase.ErrUnknownCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			exchangeServiceMock := exchangeUseCaseMock.NewMockExchangeService(t)
+			service := usecase.New(repoMock, userUseCaseMock.NewMockUserService(t), membershipServiceMock, auditUseCaseMock.NewMockAuditService(t), exchangeServiceMock, budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+			membershipServiceMock.On("Authorize", mock.Anything, mock.Anything, membershipDomain.PermissionView).Return(nil).Maybe()
+			tt.mockSetup(repoMock, exchangeServiceMock)
+
+			report, err := service.ReportProjectBudgets(context.Background(), tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedTotal, report.Total)
+				require.Len(t, report.Lines, len(tt.input.ProjectIDs))
+				require.Equal(t, asOf, report.AsOf)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+
+	auditDomain "github.com/captainhbb/tbs-backend/internal/audit/domain"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	"github.com/captainhbb/tbs-backend/internal/project/ports"
+)
+
+func(s *projectService) ListProjectRevisions(ctx context.Context, projectID int) ([]domain.Revision, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListRevisions(ctx, projectID)
+}
+
+func(s *projectService) GetProjectRevision(ctx context.Context, projectID int, number int) (domain.Revision, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return domain.Revision{}, err
+	}
+	return s.getRevision(ctx, projectID, number)
+}
+
+// RestoreProjectRevision writes a previous snapshot back over the project. The
+// owner is left untouched since ownership only changes through a transfer.
+func(s *projectService) RestoreProjectRevision(ctx context.Context, restoreRequest RestoreProjectRevisionRequest) (domain.Project, error) {
+	err := s.membershipService.Authorize(ctx, restoreRequest.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	revision, err := s.getRevision(ctx, restoreRequest.ProjectID, restoreRequest.Number)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	existingProject, err := s.repo.GetProject(ctx, restoreRequest.ProjectID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	project := revision.Snapshot
+	project.ID = existingProject.ID
+	project.OwnerID = existingProject.OwnerID
+	project.ApprovedBudget = existingProject.ApprovedBudget
+	project.CustomFields, err = s.restoredFields(ctx, revision.Snapshot.CustomFields, existingProject.CustomFields)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	err = s.checkMilestonesFit(ctx, existingProject, project)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	var approvalLevels []domain.ApprovalLevel
+	if existingProject.ProposedBudget != project.ProposedBudget {
+		approvalLevels, err = s.requiredApprovalLevels(ctx, project.ProposedBudget)
+		if err != nil {
+			return domain.Project{}, err
+		}
+	}
+
+	var restoredProject domain.Project
+	err = s.transactions.Do(ctx, func(ctx context.Context) error {
+		var err error
+		restoredProject, err = s.repo.UpdateProject(ctx, project)
+		if err != nil {
+			return err
+		}
+
+		err = s.recordProjectChange(ctx, auditDomain.ActionUpdate, &existingProject, &restoredProject)
+		if err != nil {
+			return err
+		}
+
+		if existingProject.ProposedBudget != restoredProject.ProposedBudget {
+			restoredProject, err = s.requestBudgetApproval(ctx, restoredProject, approvalLevels)
+		}
+		return err
+	})
+	if err != nil {
+		return domain.Project{}, err
+	}
+	return restoredProject, nil
+}
+
+func(s *projectService) DiffProjectRevisions(ctx context.Context, diffRequest DiffProjectRevisionsRequest) ([]auditDomain.FieldChange, error) {
+	err := s.membershipService.Authorize(ctx, diffRequest.ProjectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := s.getRevision(ctx, diffRequest.ProjectID, diffRequest.FromNumber)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getRevision(ctx, diffRequest.ProjectID, diffRequest.ToNumber)
+	if err != nil {
+		return nil, err
+	}
+	return auditDomain.Diff(from.Snapshot, to.Snapshot), nil
+}
+
+func(s *projectService) getRevision(ctx context.Context, projectID int, number int) (domain.Revision, error) {
+	revision, err := s.repo.GetRevision(ctx, projectID, number)
+	switch err {
+	case ports.ErrRevisionNotFound:
+		return domain.Revision{}, ErrRevisionNotFound
+	}
+	return revision, err
+}
@@ -0,0 +1,134 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	auditDomain "github.com/captainhbb/tbs-backend/internal/audit/domain"
+	auditUseCaseMock "github.com/captainhbb/tbs-backend/internal/audit/usecase/mock"
+	budgetUseCaseMock "github.com/captainhbb/tbs-backend/internal/budget/usecase/mock"
+	costingUseCaseMock "github.com/captainhbb/tbs-backend/internal/costing/usecase/mock"
+	exchangeUseCaseMock "github.com/captainhbb/tbs-backend/internal/exchange/usecase/mock"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	milestoneUseCaseMock "github.com/captainhbb/tbs-backend/internal/milestone/usecase/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	portsRepository "github.com/captainhbb/tbs-backend/internal/project/ports"
+	portsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/usecase"
+	taskUseCaseMock "github.com/captainhbb/tbs-backend/internal/task/usecase/mock"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreProjectRevision(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		input usecase.RestoreProjectRevisionRequest
+		mockSetup func(repo *portsMock.MockRepository, auditService *auditUseCaseMock.MockAuditService)
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "success",
+			input: usecase.RestoreProjectRevisionRequest{ProjectID: 1, Number: 2},
+			mockSetup: func(repo *portsMock.MockRepository, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetRevision", mock.Anything, 1, 2).Return(domain.Revision{
+					ProjectID: 1,
+					Number: 2,
+					Snapshot: domain.Project{ID: 1, Name: "Good Name", Description: "Good Description", OwnerID: 5},
+				}, nil)
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, Name: "Bad Name", Description: "Bad Description", OwnerID: 7}, nil)
+				repo.On("UpdateProject", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.Project)
+					require.Equal(t, "Good Name", capturedArg.Name)
+					require.Equal(t, 7, capturedArg.OwnerID)
+				}).Return(domain.Project{ID: 1, Name: "Good Name", Description: "Good Description", OwnerID: 7}, nil)
+				auditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+				repo.On("CreateRevision", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.Revision)
+					require.Equal(t, "Good Name", capturedArg.Snapshot.Name)
+				}).Return(domain.Revision{ProjectID: 1, Number: 4}, nil)
+			},
+		},
+		{
+			name: "values no field accepts any more are dropped",
+			input: usecase.RestoreProjectRevisionRequest{ProjectID: 1, Number: 2},
+			mockSetup: func(repo *portsMock.MockRepository, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetRevision", mock.Anything, 1, 2).Return(domain.Revision{
+					ProjectID: 1,
+					Number: 2,
+					Snapshot: domain.Project{ID: 1, Name: "Good Name", OwnerID: 7, CustomFields: map[string]string{"phase": "pilot", "region": "emea", "cost_center": "1200"}},
+				}, nil)
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, Name: "Bad Name", OwnerID: 7, CustomFields: map[string]string{"phase": "build"}}, nil)
+				repo.On("ListFieldDefinitions", mock.Anything).Return(fieldDefinitions, nil)
+				repo.On("UpdateProject", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.Project)
+					require.Equal(t, map[string]string{"phase": "build", "cost_center": "1200"}, capturedArg.CustomFields)
+				}).Return(domain.Project{ID: 1, Name: "Good Name", OwnerID: 7}, nil)
+				auditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+				repo.On("CreateRevision", mock.Anything, mock.Anything).Return(domain.Revision{ProjectID: 1, Number: 4}, nil)
+			},
+		},
+		{
+			name: "revision not found",
+			input: usecase.RestoreProjectRevisionRequest{ProjectID: 1, Number: 9},
+			mockSetup: func(repo *portsMock.MockRepository, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetRevision", mock.Anything, 1, 9).Return(domain.Revision{}, portsRepository.ErrRevisionNotFound)
+			},
+			expectError: true,
+			expectedError: usecase.ErrRevisionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+			service := usecase.New(repoMock, userUseCaseMock.NewMockUserService(t), membershipServiceMock, auditServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+			ctx := context.Background()
+
+			membershipServiceMock.On("Authorize", mock.Anything, tt.input.ProjectID, membershipDomain.PermissionEdit).Return(nil)
+			tt.mockSetup(repoMock, auditServiceMock)
+
+			project, err := service.RestoreProjectRevision(ctx, tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "Good Name", project.Name)
+			}
+		})
+	}
+}
+
+func TestDiffProjectRevisions(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	service := usecase.New(repoMock, userUseCaseMock.NewMockUserService(t), membershipServiceMock, auditUseCaseMock.NewMockAuditService(t), exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionView).Return(nil)
+	repoMock.On("GetRevision", mock.Anything, 1, 1).Return(domain.Revision{
+		Number: 1,
+		Snapshot: domain.Project{ID: 1, Name: "Bridge", Status: "draft", OwnerID: 1},
+	}, nil)
+	repoMock.On("GetRevision", mock.Anything, 1, 3).Return(domain.Revision{
+		Number: 3,
+		Snapshot: domain.Project{ID: 1, Name: "Bridge", Status: "active", OwnerID: 2},
+	}, nil)
+
+	changes, err := service.DiffProjectRevisions(context.Background(), usecase.DiffProjectRevisionsRequest{ProjectID: 1, FromNumber: 1, ToNumber: 3})
+	require.NoError(t, err)
+	require.Equal(t, []auditDomain.FieldChange{
+		{Field: "OwnerID", Before: "1", After: "2"},
+		{Field: "Status", Before: "draft", After: "active"},
+	}, changes)
+}
@@ -2,33 +2,104 @@ package usecase
 
 import (
 	"context"
+	"time"
 
+	auditDomain "github.com/captainhbb/tbs-backend/internal/audit/domain"
+	auditUseCase "github.com/captainhbb/tbs-backend/internal/audit/usecase"
+	budgetUseCase "github.com/captainhbb/tbs-backend/internal/budget/usecase"
+	costingUseCase "github.com/captainhbb/tbs-backend/internal/costing/usecase"
+	exchangeUseCase "github.com/captainhbb/tbs-backend/internal/exchange/usecase"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	milestoneDomain "github.com/captainhbb/tbs-backend/internal/milestone/domain"
+	milestoneUseCase "github.com/captainhbb/tbs-backend/internal/milestone/usecase"
 	"github.com/captainhbb/tbs-backend/internal/project/domain"
 	"github.com/captainhbb/tbs-backend/internal/project/ports"
+	taskUseCase "github.com/captainhbb/tbs-backend/internal/task/usecase"
 	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
 )
 
-
+//go:generate mockery --dir . --name ProjectService --structname MockProjectService --filename mock_project_service.go --output ./mock --outpkg mock
 type ProjectService interface {
 	CreateProject(ctx context.Context, project CreateProjectRequest) (domain.Project, error)
 	GetProject(ctx context.Context, id int) (domain.Project, error)
 	UpdateProject(ctx context.Context, project UpdateProjectRequest) (domain.Project, error)
 	DeleteProject(ctx context.Context, id int) error
+	TransferOwnership(ctx context.Context, transfer TransferOwnershipRequest) (domain.OwnershipTransfer, error)
+	AcceptOwnershipTransfer(ctx context.Context, response RespondOwnershipTransferRequest) (domain.Project, error)
+	DeclineOwnershipTransfer(ctx context.Context, response RespondOwnershipTransferRequest) (domain.OwnershipTransfer, error)
+	ListOwnershipHistory(ctx context.Context, projectID int) ([]domain.OwnerChange, error)
+	// HandOverProjects moves every project owned by fromOwnerID to toOwnerID
+	// as immediate ownership transfers, recording owner history and a
+	// revision for each. It is called by the user service when an owner
+	// leaves and is restricted to global admins.
+	HandOverProjects(ctx context.Context, fromOwnerID int, toOwnerID int) error
+	ListProjectRevisions(ctx context.Context, projectID int) ([]domain.Revision, error)
+	GetProjectRevision(ctx context.Context, projectID int, number int) (domain.Revision, error)
+	RestoreProjectRevision(ctx context.Context, restore RestoreProjectRevisionRequest) (domain.Project, error)
+	DiffProjectRevisions(ctx context.Context, diff DiffProjectRevisionsRequest) ([]auditDomain.FieldChange, error)
+	ReportProjectBudgets(ctx context.Context, report ProjectBudgetReportRequest) (domain.BudgetReport, error)
+	ReportProjectSpend(ctx context.Context, report ProjectSpendReportRequest) (domain.SpendReport, error)
+	// ReportEarnedValue spreads each leaf task's share of the proposed budget,
+	// weighted by estimate, evenly from the project start to the task's due date
+	// (or the project end) as planned value, and earns it by task progress.
+	ReportEarnedValue(ctx context.Context, report EarnedValueRequest) (domain.EarnedValue, error)
+	ReportEarnedValueSeries(ctx context.Context, report EarnedValueSeriesRequest) ([]domain.EarnedValue, error)
+	ListBudgetApprovals(ctx context.Context, projectID int) ([]domain.BudgetApproval, error)
+	ApproveBudget(ctx context.Context, decision DecideBudgetApprovalRequest) (domain.BudgetApproval, error)
+	RejectBudget(ctx context.Context, decision DecideBudgetApprovalRequest) (domain.BudgetApproval, error)
+	// ListProjects returns the projects matching filter that the acting user
+	// may view, checked for all of them at once. Filter tags and custom field
+	// values are normalized like those stored on projects.
+	ListProjects(ctx context.Context, filter domain.Filter) ([]domain.Project, error)
+	// CreateFieldDefinition, UpdateFieldDefinition and DeleteFieldDefinition
+	// are restricted to global admins and audited. Updating and deleting a
+	// field remove the values it no longer accepts from every project, and
+	// restoring a revision drops them too.
+	CreateFieldDefinition(ctx context.Context, definition CreateFieldDefinitionRequest) (domain.FieldDefinition, error)
+	UpdateFieldDefinition(ctx context.Context, definition UpdateFieldDefinitionRequest) (domain.FieldDefinition, error)
+	DeleteFieldDefinition(ctx context.Context, id int) error
+	ListFieldDefinitions(ctx context.Context) ([]domain.FieldDefinition, error)
 }
 
 type projectService struct {
 	repo ports.Repository
 	userService userUseCase.UserService
+	membershipService membershipUseCase.MembershipService
+	auditService auditUseCase.AuditService
+	exchangeService exchangeUseCase.ExchangeService
+	budgetService budgetUseCase.BudgetService
+	milestoneService milestoneUseCase.MilestoneService
+	costingService costingUseCase.CostingService
+	taskService taskUseCase.TaskService
+	approvalPolicy []domain.ApprovalThreshold
+	transactions transaction.Manager
 }
 
-func New(repo ports.Repository, userService userUseCase.UserService) ProjectService {
+func New(repo ports.Repository, userService userUseCase.UserService, membershipService membershipUseCase.MembershipService, auditService auditUseCase.AuditService, exchangeService exchangeUseCase.ExchangeService, budgetService budgetUseCase.BudgetService, milestoneService milestoneUseCase.MilestoneService, costingService costingUseCase.CostingService, taskService taskUseCase.TaskService, approvalPolicy []domain.ApprovalThreshold, transactions transaction.Manager) ProjectService {
 	return &projectService{
 		repo: repo,
 		userService: userService,
+		membershipService: membershipService,
+		auditService: auditService,
+		exchangeService: exchangeService,
+		budgetService: budgetService,
+		milestoneService: milestoneService,
+		costingService: costingService,
+		taskService: taskService,
+		approvalPolicy: approvalPolicy,
+		transactions: transactions,
 	}
 }
 
 func(s *projectService) CreateProject(ctx context.Context, createProjectRequest CreateProjectRequest) (domain.Project, error) {
+	if !isValidBudget(createProjectRequest.ProposedBudget) {
+		return domain.Project{}, ErrInvalidBudget
+	}
+
 	_, err := s.userService.GetUser(ctx, createProjectRequest.OwnerID)
 	switch err {
 	case userUseCase.ErrUserNotFound:
@@ -43,16 +114,57 @@ func(s *projectService) CreateProject(ctx context.Context, createProjectRequest
 		ProposedBudget: createProjectRequest.ProposedBudget,
 		Status: createProjectRequest.Status,
 		OwnerID: createProjectRequest.OwnerID,
+		Tags: domain.NormalizeTags(createProjectRequest.Tags),
+	}
+	project.CustomFields, err = s.projectFields(ctx, createProjectRequest.CustomFields, nil)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	approvalLevels, err := s.requiredApprovalLevels(ctx, project.ProposedBudget)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	var createdProject domain.Project
+	err = s.transactions.Do(ctx, func(ctx context.Context) error {
+		var err error
+		createdProject, err = s.repo.CreateProject(ctx, project)
+		if err != nil {
+			return err
+		}
+
+		err = s.recordProjectChange(ctx, auditDomain.ActionCreate, nil, &createdProject)
+		if err != nil {
+			return err
+		}
+		createdProject, err = s.requestBudgetApproval(ctx, createdProject, approvalLevels)
+		return err
+	})
+	if err != nil {
+		return domain.Project{}, err
 	}
-	return s.repo.CreateProject(ctx, project)
+	return createdProject, nil
 }
 
 func(s *projectService) GetProject(ctx context.Context, id int) (domain.Project, error) {
+	err := s.membershipService.Authorize(ctx, id, membershipDomain.PermissionView)
+	if err != nil {
+		return domain.Project{}, err
+	}
 	return s.repo.GetProject(ctx, id)
 }
 
 func(s *projectService) UpdateProject(ctx context.Context, updateProjectRequest UpdateProjectRequest) (domain.Project, error) {
-	_, err := s.userService.GetUser(ctx, updateProjectRequest.OwnerID)
+	if !isValidBudget(updateProjectRequest.ProposedBudget) {
+		return domain.Project{}, ErrInvalidBudget
+	}
+
+	err := s.membershipService.Authorize(ctx, updateProjectRequest.ID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	_, err = s.userService.GetUser(ctx, updateProjectRequest.OwnerID)
 	switch err {
 	case userUseCase.ErrUserNotFound:
 		return domain.Project{}, ErrOwnerNotFound
@@ -65,10 +177,135 @@ func(s *projectService) UpdateProject(ctx context.Context, updateProjectRequest
 		StartDate: updateProjectRequest.StartDate,
 		EndDate: updateProjectRequest.EndDate,
 		ProposedBudget: updateProjectRequest.ProposedBudget,
+		Tags: domain.NormalizeTags(updateProjectRequest.Tags),
+	}
+
+	existingProject, err := s.repo.GetProject(ctx, project.ID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	project.CustomFields, err = s.projectFields(ctx, updateProjectRequest.CustomFields, &existingProject)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	project.ApprovedBudget = existingProject.ApprovedBudget
+
+	err = s.checkMilestonesFit(ctx, existingProject, project)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	var approvalLevels []domain.ApprovalLevel
+	if existingProject.ProposedBudget != project.ProposedBudget {
+		approvalLevels, err = s.requiredApprovalLevels(ctx, project.ProposedBudget)
+		if err != nil {
+			return domain.Project{}, err
+		}
+	}
+
+	var updatedProject domain.Project
+	err = s.transactions.Do(ctx, func(ctx context.Context) error {
+		var err error
+		updatedProject, err = s.repo.UpdateProject(ctx, project)
+		if err != nil {
+			return err
+		}
+
+		err = s.recordProjectChange(ctx, auditDomain.ActionUpdate, &existingProject, &updatedProject)
+		if err != nil {
+			return err
+		}
+
+		if existingProject.ProposedBudget != updatedProject.ProposedBudget {
+			updatedProject, err = s.requestBudgetApproval(ctx, updatedProject, approvalLevels)
+		}
+		return err
+	})
+	if err != nil {
+		return domain.Project{}, err
 	}
-	return s.repo.UpdateProject(ctx, project)
+	return updatedProject, nil
 }
 
 func(s *projectService) DeleteProject(ctx context.Context, id int) error {
-	return s.repo.DeleteProject(ctx, id)
+	err := s.membershipService.Authorize(ctx, id, membershipDomain.PermissionAdminister)
+	if err != nil {
+		return err
+	}
+
+	existingProject, err := s.repo.GetProject(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.transactions.Do(ctx, func(ctx context.Context) error {
+		err := s.repo.DeleteProject(ctx, id)
+		if err != nil {
+			return err
+		}
+		return s.recordProjectChange(ctx, auditDomain.ActionDelete, &existingProject, nil)
+	})
+}
+
+// recordProjectChange writes the audit entry for a project mutation and, unless the
+// project was deleted, stores its new state as a revision. before is nil for
+// creates and after is nil for deletes.
+func(s *projectService) recordProjectChange(ctx context.Context, action string, before *domain.Project, after *domain.Project) error {
+	record := auditUseCase.RecordRequest{
+		Action: action,
+		EntityType: auditDomain.EntityProject,
+	}
+	if before != nil {
+		record.EntityID = before.ID
+		record.Before = *before
+	}
+	if after != nil {
+		record.EntityID = after.ID
+		record.After = *after
+	}
+
+	err := s.auditService.Record(ctx, record)
+	if err != nil {
+		return err
+	}
+	if after == nil {
+		return nil
+	}
+
+	actorID, _ := actor.IDFromContext(ctx)
+	_, err = s.repo.CreateRevision(ctx, domain.Revision{
+		ProjectID: after.ID,
+		Snapshot: *after,
+		ActorID: actorID,
+		CreatedAt: time.Now(),
+	})
+	return err
+}
+
+// checkMilestonesFit reports the milestones that would fall outside the project
+// window if its dates changed from existing to updated.
+func(s *projectService) checkMilestonesFit(ctx context.Context, existing domain.Project, updated domain.Project) error {
+	if existing.StartDate.Equal(updated.StartDate) && existing.EndDate.Equal(updated.EndDate) {
+		return nil
+	}
+
+	milestones, err := s.milestoneService.ListMilestones(ctx, existing.ID)
+	if err != nil {
+		return err
+	}
+
+	var outside []milestoneDomain.Milestone
+	for _, milestone := range milestones {
+		if !milestone.Within(updated.StartDate, updated.EndDate) {
+			outside = append(outside, milestone)
+		}
+	}
+	if len(outside) > 0 {
+		return &MilestonesOutsideError{Milestones: outside}
+	}
+	return nil
+}
+
+func isValidBudget(budget money.Money) bool {
+	return budget.Currency() != "" && !budget.IsNegative()
 }
\ No newline at end of file
@@ -5,13 +5,27 @@ import (
 	"testing"
 	"time"
 
+	auditDomain "github.com/captainhbb/tbs-backend/internal/audit/domain"
+	auditUseCase "github.com/captainhbb/tbs-backend/internal/audit/usecase"
+	auditUseCaseMock "github.com/captainhbb/tbs-backend/internal/audit/usecase/mock"
+	budgetUseCaseMock "github.com/captainhbb/tbs-backend/internal/budget/usecase/mock"
+	costingUseCaseMock "github.com/captainhbb/tbs-backend/internal/costing/usecase/mock"
+	exchangeUseCaseMock "github.com/captainhbb/tbs-backend/internal/exchange/usecase/mock"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	milestoneDomain "github.com/captainhbb/tbs-backend/internal/milestone/domain"
+	milestoneUseCaseMock "github.com/captainhbb/tbs-backend/internal/milestone/usecase/mock"
 	"github.com/captainhbb/tbs-backend/internal/project/domain"
 	portsRepository "github.com/captainhbb/tbs-backend/internal/project/ports"
 	portsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
-	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/usecase"
+	taskUseCaseMock "github.com/captainhbb/tbs-backend/internal/task/usecase/mock"
 	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
 	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
-	"github.com/captainhbb/tbs-backend/internal/project/usecase"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -23,7 +37,7 @@ func TestCreateProject(t *testing.T) {
 	tests := []struct {
 		name string
 		input usecase.CreateProjectRequest
-		mockSetup func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService)
+		mockSetup func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService)
 		expectError bool
 		expectedError error
 	}{
@@ -34,12 +48,34 @@ func TestCreateProject(t *testing.T) {
 				Description: "Test Description",
 				StartDate: time.Now(),
 				EndDate: time.Now().Add(time.Hour * 24 * 30),
-				ProposedBudget: 1000000,
+				ProposedBudget: money.MustNew(100000000, "USD"),
 				Status: "active",
 				OwnerID: 1,
 			},
-			mockSetup: func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService) {
+			mockSetup: func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
 				userUserCase.On("GetUser", mock.Anything, 1).Return(userDomain.User{ID: 1}, nil)
+				auditService.On("Record", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(auditUseCase.RecordRequest)
+					require.Equal(t, auditDomain.ActionCreate, capturedArg.Action)
+					require.Equal(t, auditDomain.EntityProject, capturedArg.EntityType)
+					require.Equal(t, 1, capturedArg.EntityID)
+					require.Nil(t, capturedArg.Before)
+				}).Return(nil)
+				repo.On("CreateRevision", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.Revision)
+					require.Equal(t, 1, capturedArg.ProjectID)
+					require.Equal(t, "Test Project1", capturedArg.Snapshot.Name)
+				}).Return(domain.Revision{ID: 1, ProjectID: 1, Number: 1}, nil)
+				repo.On("ListBudgetApprovals", mock.Anything, 1).Return([]domain.BudgetApproval{}, nil)
+				repo.On("CreateBudgetApproval", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.BudgetApproval)
+					require.Equal(t, domain.ApprovalStatusPending, capturedArg.Status)
+					require.Equal(t, money.MustNew(100000000, "USD"), capturedArg.Amount)
+					require.Equal(t, []domain.ApprovalLevel{
+						{Level: 1, Role: userDomain.RoleManager},
+						{Level: 2, Role: userDomain.RoleAdmin},
+					}, capturedArg.Levels)
+				}).Return(domain.BudgetApproval{ID: 1}, nil)
 				repo.On("CreateProject", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
 					capturedArg := args.Get(1).(domain.Project)
 					require.Equal(t, "Test Project1", capturedArg.Name)
@@ -49,7 +85,7 @@ func TestCreateProject(t *testing.T) {
 					Description: "Test Description",
 					StartDate: time.Now(),
 					EndDate: time.Now().Add(time.Hour * 24 * 30),
-					ProposedBudget: 1000000,
+					ProposedBudget: money.MustNew(100000000, "USD"),
 					Status: "active",
 					OwnerID: 1,
 				}, nil)
@@ -63,27 +99,56 @@ func TestCreateProject(t *testing.T) {
 				Description:    "Test Description",
 				StartDate:      time.Now(),
 				EndDate:        time.Now().Add(time.Hour * 24 * 30),
-				ProposedBudget: 500000,
+				ProposedBudget: money.MustNew(50000000, "USD"),
 				Status:         "active",
 				OwnerID:        2,
 			},
-			mockSetup: func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService) {
+			mockSetup: func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, _ *auditUseCaseMock.MockAuditService) {
 				userUserCase.On("GetUser", mock.Anything, 2).Return(userDomain.User{}, userUseCase.ErrUserNotFound)
 			},
 			expectError: true,
 			expectedError: usecase.ErrOwnerNotFound,
 		},
+		{
+			name: "budget without currency",
+			input: usecase.CreateProjectRequest{
+				Name:           "Test Project3",
+				StartDate:      time.Now(),
+				EndDate:        time.Now().Add(time.Hour * 24 * 30),
+				Status:         "active",
+				OwnerID:        1,
+			},
+			mockSetup: func(_ *portsMock.MockRepository, _ *userUseCaseMock.MockUserService, _ *auditUseCaseMock.MockAuditService) {},
+			expectError: true,
+			expectedError: usecase.ErrInvalidBudget,
+		},
+		{
+			name: "negative budget",
+			input: usecase.CreateProjectRequest{
+				Name:           "Test Project4",
+				StartDate:      time.Now(),
+				EndDate:        time.Now().Add(time.Hour * 24 * 30),
+				ProposedBudget: money.MustNew(-100, "USD"),
+				Status:         "active",
+				OwnerID:        1,
+			},
+			mockSetup: func(_ *portsMock.MockRepository, _ *userUseCaseMock.MockUserService, _ *auditUseCaseMock.MockAuditService) {},
+			expectError: true,
+			expectedError: usecase.ErrInvalidBudget,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repoMock := portsMock.NewMockRepository(t)
+			repoMock.On("ListFieldDefinitions", mock.Anything).Return(nil, nil).Maybe()
 			userServiceMock := userUseCaseMock.NewMockUserService(t)
-			service := usecase.New(repoMock, userServiceMock)
+			auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+			service := usecase.New(repoMock, userServiceMock, membershipUseCaseMock.NewMockMembershipService(t), auditServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
 
 			ctx := context.Background()
 
-			tt.mockSetup(repoMock, userServiceMock)
+			tt.mockSetup(repoMock, userServiceMock, auditServiceMock)
 
 			createdProject, err := service.CreateProject(ctx, tt.input)
 			if tt.expectError {
@@ -126,7 +191,7 @@ func TestGetProject(t *testing.T) {
 					Description: "Test Description",
 					StartDate: time.Now(),
 					EndDate: time.Now().Add(time.Hour * 24 * 30),
-					ProposedBudget: 1000000,
+					ProposedBudget: money.MustNew(100000000, "USD"),
 					Status: "active",
 					OwnerID: 1,
 				}, nil)
@@ -148,10 +213,12 @@ func TestGetProject(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repoMock := portsMock.NewMockRepository(t)
 			userUseCaseMock :=userUseCaseMock.NewMockUserService(t)
-			service := usecase.New(repoMock, userUseCaseMock)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			service := usecase.New(repoMock, userUseCaseMock, membershipServiceMock, auditUseCaseMock.NewMockAuditService(t), exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
 
 			ctx := context.Background()
 
+			membershipServiceMock.On("Authorize", mock.Anything, tt.input, membershipDomain.PermissionView).Return(nil)
 			tt.mockSetup(repoMock)
 
 			project, err := service.GetProject(ctx, tt.input)
@@ -179,7 +246,7 @@ func TestUpdateProject(t *testing.T) {
 	tests := []struct {
 		name string
 		input usecase.UpdateProjectRequest
-		mockSetup func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService)
+		mockSetup func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService)
 		expectError bool
 		expectedError error
 	}{
@@ -191,12 +258,35 @@ func TestUpdateProject(t *testing.T) {
 				Description: "Test Description",
 				StartDate: time.Now(),
 				EndDate: time.Now().Add(time.Hour * 24 * 30),
-				ProposedBudget: 1000000,
+				ProposedBudget: money.MustNew(100000000, "USD"),
 				Status: "active",
 				OwnerID: 1,
 			},
-			mockSetup: func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService) {
+			mockSetup: func(repo *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, auditService *auditUseCaseMock.MockAuditService) {
 				userUserCase.On("GetUser", mock.Anything, 1).Return(userDomain.User{ID: 1}, nil)
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{
+					ID: 1,
+					Name: "Old Name",
+					Description: "Test Description",
+					Status: "active",
+					OwnerID: 1,
+				}, nil)
+				auditService.On("Record", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(auditUseCase.RecordRequest)
+					require.Equal(t, auditDomain.ActionUpdate, capturedArg.Action)
+					require.Equal(t, "Old Name", capturedArg.Before.(domain.Project).Name)
+					require.Equal(t, "Test Project1", capturedArg.After.(domain.Project).Name)
+				}).Return(nil)
+				repo.On("CreateRevision", mock.Anything, mock.Anything).Return(domain.Revision{ID: 2, ProjectID: 1, Number: 2}, nil)
+				repo.On("ListBudgetApprovals", mock.Anything, 1).Return([]domain.BudgetApproval{
+					{ID: 3, ProjectID: 1, Status: domain.ApprovalStatusPending},
+				}, nil)
+				repo.On("UpdateBudgetApproval", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.BudgetApproval)
+					require.Equal(t, 3, capturedArg.ID)
+					require.Equal(t, domain.ApprovalStatusSuperseded, capturedArg.Status)
+				}).Return(domain.BudgetApproval{}, nil)
+				repo.On("CreateBudgetApproval", mock.Anything, mock.Anything).Return(domain.BudgetApproval{ID: 4}, nil)
 				repo.On("UpdateProject", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
 					capturedArg := args.Get(1).(domain.Project)
 					require.Equal(t, 1, capturedArg.ID)
@@ -206,7 +296,7 @@ func TestUpdateProject(t *testing.T) {
 					Description: "Test Description",
 					StartDate: time.Now(),
 					EndDate: time.Now().Add(time.Hour * 24 * 30),
-					ProposedBudget: 1000000,
+					ProposedBudget: money.MustNew(100000000, "USD"),
 					Status: "active",
 					OwnerID: 1,
 				}, nil)
@@ -221,11 +311,11 @@ func TestUpdateProject(t *testing.T) {
 				Description: "Attempt update with missing owner",
 				StartDate: time.Now(),
 				EndDate: time.Now().Add(time.Hour * 24 * 30),
-				ProposedBudget: 500000,
+				ProposedBudget: money.MustNew(50000000, "USD"),
 				Status: "active",
 				OwnerID: 42, 
 			},
-			mockSetup: func(_ *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService) {
+			mockSetup: func(_ *portsMock.MockRepository, userUserCase *userUseCaseMock.MockUserService, _ *auditUseCaseMock.MockAuditService) {
 				userUserCase.On("GetUser", mock.Anything, 42).Return(userDomain.User{}, userUseCase.ErrUserNotFound)
 			},
 			expectError: true,
@@ -235,12 +325,18 @@ func TestUpdateProject(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repoMock := portsMock.NewMockRepository(t)
+			repoMock.On("ListFieldDefinitions", mock.Anything).Return(nil, nil).Maybe()
 			userUseCaseMock :=userUseCaseMock.NewMockUserService(t)
-			service := usecase.New(repoMock, userUseCaseMock)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+			milestoneServiceMock := milestoneUseCaseMock.NewMockMilestoneService(t)
+			service := usecase.New(repoMock, userUseCaseMock, membershipServiceMock, auditServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneServiceMock, costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
 
 			ctx := context.Background()
 
-			tt.mockSetup(repoMock, userUseCaseMock)
+			membershipServiceMock.On("Authorize", mock.Anything, tt.input.ID, membershipDomain.PermissionEdit).Return(nil)
+			milestoneServiceMock.On("ListMilestones", mock.Anything, tt.input.ID).Return([]milestoneDomain.Milestone{}, nil).Maybe()
+			tt.mockSetup(repoMock, userUseCaseMock, auditServiceMock)
 
 			updatedProject, err := service.UpdateProject(ctx, tt.input)
 			if tt.expectError {
@@ -263,29 +359,76 @@ func TestUpdateProject(t *testing.T) {
 	}
 }
 
+func TestUpdateProjectReportsMilestonesOutsideNewDates(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	repoMock.On("ListFieldDefinitions", mock.Anything).Return(nil, nil).Maybe()
+	userServiceMock := userUseCaseMock.NewMockUserService(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	milestoneServiceMock := milestoneUseCaseMock.NewMockMilestoneService(t)
+	service := usecase.New(repoMock, userServiceMock, membershipServiceMock, auditUseCaseMock.NewMockAuditService(t), exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneServiceMock, costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	budget := money.MustNew(100000, "USD")
+
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+	userServiceMock.On("GetUser", mock.Anything, 1).Return(userDomain.User{ID: 1}, nil)
+	repoMock.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, StartDate: start, EndDate: end, ProposedBudget: budget, OwnerID: 1}, nil)
+	milestoneServiceMock.On("ListMilestones", mock.Anything, 1).Return([]milestoneDomain.Milestone{
+		{ID: 1, ProjectID: 1, Name: "Kick-off", TargetDate: start},
+		{ID: 2, ProjectID: 1, Name: "Beta", TargetDate: time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 3, ProjectID: 1, Name: "Launch", TargetDate: end},
+	}, nil)
+
+	_, err := service.UpdateProject(context.Background(), usecase.UpdateProjectRequest{
+		ID: 1,
+		Name: "Shorter",
+		StartDate: start,
+		EndDate: time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC),
+		ProposedBudget: budget,
+		OwnerID: 1,
+	})
+	require.ErrorIs(t, err, usecase.ErrMilestonesOutsideProject)
+
+	var outsideErr *usecase.MilestonesOutsideError
+	require.ErrorAs(t, err, &outsideErr)
+	require.Len(t, outsideErr.Milestones, 2)
+	require.Equal(t, "Beta", outsideErr.Milestones[0].Name)
+	require.Equal(t, "Launch", outsideErr.Milestones[1].Name)
+}
+
 func TestDeleteProject(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name string
 		input int
-		mockSetup func(repo *portsMock.MockRepository)
+		mockSetup func(repo *portsMock.MockRepository, auditService *auditUseCaseMock.MockAuditService)
 		expectError bool
 		expectedError error
 	}{
 		{
 			name: "success",
 			input: 1,
-			mockSetup: func(repo *portsMock.MockRepository) {
+			mockSetup: func(repo *portsMock.MockRepository, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, Name: "Test Project1", OwnerID: 1}, nil)
 				repo.On("DeleteProject", mock.Anything, 1).Return(nil)
+				auditService.On("Record", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(auditUseCase.RecordRequest)
+					require.Equal(t, auditDomain.ActionDelete, capturedArg.Action)
+					require.Equal(t, 1, capturedArg.EntityID)
+					require.Nil(t, capturedArg.After)
+				}).Return(nil)
 			},
 			expectError: false,
 		},
 		{
 			name: "project not found",
 			input: 1,
-			mockSetup: func(repo *portsMock.MockRepository) {
-				repo.On("DeleteProject", mock.Anything, 1).Return(portsRepository.ErrProjectNotFound)
+			mockSetup: func(repo *portsMock.MockRepository, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetProject", mock.Anything, 1).Return(domain.Project{}, portsRepository.ErrProjectNotFound)
 			},
 			expectError: true,
 			expectedError: portsRepository.ErrProjectNotFound,
@@ -295,11 +438,14 @@ func TestDeleteProject(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repoMock := portsMock.NewMockRepository(t)
 			userUseCaseMock :=userUseCaseMock.NewMockUserService(t)
-			service := usecase.New(repoMock, userUseCaseMock)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+			service := usecase.New(repoMock, userUseCaseMock, membershipServiceMock, auditServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
 
 			ctx := context.Background()
 
-			tt.mockSetup(repoMock)
+			membershipServiceMock.On("Authorize", mock.Anything, tt.input, membershipDomain.PermissionAdminister).Return(nil)
+			tt.mockSetup(repoMock, auditServiceMock)
 
 
 			err := service.DeleteProject(ctx, tt.input)
@@ -311,4 +457,31 @@ func TestDeleteProject(t *testing.T) {
 			repoMock.AssertExpectations(t)
 		})
 	}
+}
+
+func TestProjectAccessDenied(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	userServiceMock := userUseCaseMock.NewMockUserService(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	service := usecase.New(repoMock, userServiceMock, membershipServiceMock, auditUseCaseMock.NewMockAuditService(t), exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+	ctx := context.Background()
+
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionView).Return(membershipUseCase.ErrForbidden)
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(membershipUseCase.ErrForbidden)
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionAdminister).Return(membershipUseCase.ErrForbidden)
+
+	_, err := service.GetProject(ctx, 1)
+	require.ErrorIs(t, err, membershipUseCase.ErrForbidden)
+
+	_, err = service.UpdateProject(ctx, usecase.UpdateProjectRequest{ID: 1, OwnerID: 1, ProposedBudget: money.MustNew(0, "USD")})
+	require.ErrorIs(t, err, membershipUseCase.ErrForbidden)
+
+	err = service.DeleteProject(ctx, 1)
+	require.ErrorIs(t, err, membershipUseCase.ErrForbidden)
+
+	repoMock.AssertExpectations(t)
+	userServiceMock.AssertExpectations(t)
 }
\ No newline at end of file
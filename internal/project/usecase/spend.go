@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	budgetDomain "github.com/captainhbb/tbs-backend/internal/budget/domain"
+	costingUseCase "github.com/captainhbb/tbs-backend/internal/costing/usecase"
+	exchangeDomain "github.com/captainhbb/tbs-backend/internal/exchange/domain"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+const day = 24 * time.Hour
+
+func(s *projectService) ReportProjectSpend(ctx context.Context, spendRequest ProjectSpendReportRequest) (domain.SpendReport, error) {
+	err := s.membershipService.Authorize(ctx, spendRequest.ProjectID, membershipDomain.PermissionView)
+	if err != nil {
+		return domain.SpendReport{}, err
+	}
+
+	project, err := s.repo.GetProject(ctx, spendRequest.ProjectID)
+	if err != nil {
+		return domain.SpendReport{}, err
+	}
+
+	asOf := spendRequest.AsOf
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+	currency := project.ProposedBudget.Currency()
+
+	items, err := s.budgetService.ListLineItems(ctx, project.ID)
+	if err != nil {
+		return domain.SpendReport{}, err
+	}
+	actualCost, err := s.costingService.ProjectActualCost(ctx, costingUseCase.ActualCostRequest{
+		ProjectID: project.ID,
+		To: exchangeDomain.Day(asOf).AddDate(0, 0, 1),
+	})
+	if err != nil {
+		return domain.SpendReport{}, err
+	}
+
+	planned := make(map[string]money.Money)
+	for _, item := range items {
+		planned[item.Category], err = addTo(planned[item.Category], item.Planned)
+		if err != nil {
+			return domain.SpendReport{}, err
+		}
+	}
+
+	spent := make(map[string]money.Money)
+	for category, amount := range actualCost.Expenses {
+		spent[category] = amount
+	}
+	if !actualCost.Labor.IsZero() {
+		spent[budgetDomain.CategoryLabor], err = addTo(spent[budgetDomain.CategoryLabor], actualCost.Labor)
+		if err != nil {
+			return domain.SpendReport{}, err
+		}
+	}
+
+	days := elapsedDays(project, asOf)
+	total, err := measureSpend("", project.ProposedBudget, actualCost.Total, days)
+	if err != nil {
+		return domain.SpendReport{}, err
+	}
+	report := domain.SpendReport{
+		ProjectID: project.ID,
+		AsOf: asOf,
+		Budget: total.Budget,
+		Actual: total.Actual,
+		Remaining: total.Remaining,
+		PercentConsumed: total.PercentConsumed,
+		BurnRate: total.BurnRate,
+		LaborCost: actualCost.Labor,
+	}
+
+	categories := make([]string, 0, len(planned))
+	for category := range planned {
+		categories = append(categories, category)
+	}
+	for category := range spent {
+		if _, ok := planned[category]; !ok {
+			categories = append(categories, category)
+		}
+	}
+	sort.Strings(categories)
+
+	for _, category := range categories {
+		categorySpend, err := measureSpend(category, orZero(planned[category], currency), orZero(spent[category], currency), days)
+		if err != nil {
+			return domain.SpendReport{}, err
+		}
+		report.Categories = append(report.Categories, categorySpend)
+	}
+	return report, nil
+}
+
+// measureSpend derives the remaining budget, percent consumed and daily burn
+// rate from a budget and the amount spent against it over days.
+func measureSpend(category string, budget money.Money, actual money.Money, days int64) (domain.CategorySpend, error) {
+	remaining, err := budget.Sub(actual)
+	if err != nil {
+		return domain.CategorySpend{}, err
+	}
+
+	var percent float64
+	if !budget.IsZero() {
+		percent, _ = big.NewRat(actual.Amount() * 100, budget.Amount()).Float64()
+	}
+
+	burnRate := money.MustNew(0, actual.Currency())
+	if days > 0 {
+		burnRate, err = actual.Mul(1, days)
+		if err != nil {
+			return domain.CategorySpend{}, err
+		}
+	}
+	return domain.CategorySpend{
+		Category: category,
+		Budget: budget,
+		Actual: actual,
+		Remaining: remaining,
+		PercentConsumed: percent,
+		BurnRate: burnRate,
+	}, nil
+}
+
+// elapsedDays counts the calendar days from the project start through asOf,
+// capped at the project end. Projects without a start date have no burn rate.
+func elapsedDays(project domain.Project, asOf time.Time) int64 {
+	if project.StartDate.IsZero() {
+		return 0
+	}
+
+	end := exchangeDomain.Day(asOf)
+	if !project.EndDate.IsZero() && project.EndDate.Before(end) {
+		end = exchangeDomain.Day(project.EndDate)
+	}
+	start := exchangeDomain.Day(project.StartDate)
+	if end.Before(start) {
+		return 0
+	}
+	return int64(end.Sub(start) / day) + 1
+}
+
+func addTo(total money.Money, amount money.Money) (money.Money, error) {
+	if total.Currency() == "" {
+		return amount, nil
+	}
+	return total.Add(amount)
+}
+
+func orZero(amount money.Money, currency string) money.Money {
+	if amount.Currency() == "" {
+		return money.MustNew(0, currency)
+	}
+	return amount
+}
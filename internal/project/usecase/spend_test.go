@@ -0,0 +1,108 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	auditUseCaseMock "github.com/captainhbb/tbs-backend/internal/audit/usecase/mock"
+	budgetDomain "github.com/captainhbb/tbs-backend/internal/budget/domain"
+	budgetUseCaseMock "github.com/captainhbb/tbs-backend/internal/budget/usecase/mock"
+	costingDomain "github.com/captainhbb/tbs-backend/internal/costing/domain"
+	costingUseCase "github.com/captainhbb/tbs-backend/internal/costing/usecase"
+	costingUseCaseMock "github.com/captainhbb/tbs-backend/internal/costing/usecase/mock"
+	exchangeUseCaseMock "github.com/captainhbb/tbs-backend/internal/exchange/usecase/mock"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	milestoneUseCaseMock "github.com/captainhbb/tbs-backend/internal/milestone/usecase/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/usecase"
+	taskUseCaseMock "github.com/captainhbb/tbs-backend/internal/task/usecase/mock"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportProjectSpend(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	exchangeServiceMock := exchangeUseCaseMock.NewMockExchangeService(t)
+	budgetServiceMock := budgetUseCaseMock.NewMockBudgetService(t)
+	costingServiceMock := costingUseCaseMock.NewMockCostingService(t)
+	service := usecase.New(repoMock, userUseCaseMock.NewMockUserService(t), membershipServiceMock, auditUseCaseMock.NewMockAuditService(t), exchangeServiceMock, budgetServiceMock, milestoneUseCaseMock.NewMockMilestoneService(t), costingServiceMock, taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	asOf := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionView).Return(nil)
+	repoMock.On("GetProject", mock.Anything, 1).Return(domain.Project{
+		ID: 1,
+		StartDate: start,
+		EndDate: start.AddDate(0, 6, 0),
+		ProposedBudget: money.MustNew(1000000, "USD"),
+	}, nil)
+	budgetServiceMock.On("ListLineItems", mock.Anything, 1).Return([]budgetDomain.LineItem{
+		{ID: 1, ProjectID: 1, Category: budgetDomain.CategoryLabor, Planned: money.MustNew(800000, "USD")},
+		{ID: 2, ProjectID: 1, Category: budgetDomain.CategoryTravel, Planned: money.MustNew(200000, "USD")},
+	}, nil)
+	costingServiceMock.On("ProjectActualCost", mock.Anything, costingUseCase.ActualCostRequest{ProjectID: 1, To: time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)}).
+		Return(costingDomain.ActualCost{
+			ProjectID: 1,
+			Expenses: map[string]money.Money{
+				budgetDomain.CategoryLabor: money.MustNew(150000, "USD"),
+				budgetDomain.CategoryTravel: money.MustNew(50000, "USD"),
+			},
+			Labor: money.MustNew(50000, "USD"),
+			Total: money.MustNew(250000, "USD"),
+		}, nil)
+
+	report, err := service.ReportProjectSpend(context.Background(), usecase.ProjectSpendReportRequest{ProjectID: 1, AsOf: asOf})
+	require.NoError(t, err)
+	require.Equal(t, money.MustNew(250000, "USD"), report.Actual)
+	require.Equal(t, money.MustNew(750000, "USD"), report.Remaining)
+	require.InDelta(t, 25.0, report.PercentConsumed, 1e-9)
+	require.Equal(t, money.MustNew(25000, "USD"), report.BurnRate)
+	require.Equal(t, money.MustNew(50000, "USD"), report.LaborCost)
+
+	require.Len(t, report.Categories, 2)
+	require.Equal(t, budgetDomain.CategoryLabor, report.Categories[0].Category)
+	require.Equal(t, money.MustNew(200000, "USD"), report.Categories[0].Actual)
+	require.Equal(t, money.MustNew(600000, "USD"), report.Categories[0].Remaining)
+	require.InDelta(t, 25.0, report.Categories[0].PercentConsumed, 1e-9)
+	require.Equal(t, budgetDomain.CategoryTravel, report.Categories[1].Category)
+	require.Equal(t, money.MustNew(50000, "USD"), report.Categories[1].Actual)
+	require.InDelta(t, 25.0, report.Categories[1].PercentConsumed, 1e-9)
+	require.Equal(t, money.MustNew(5000, "USD"), report.Categories[1].BurnRate)
+}
+
+func TestReportProjectSpendUnplannedCategory(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	budgetServiceMock := budgetUseCaseMock.NewMockBudgetService(t)
+	costingServiceMock := costingUseCaseMock.NewMockCostingService(t)
+	service := usecase.New(repoMock, userUseCaseMock.NewMockUserService(t), membershipServiceMock, auditUseCaseMock.NewMockAuditService(t), exchangeUseCaseMock.NewMockExchangeService(t), budgetServiceMock, milestoneUseCaseMock.NewMockMilestoneService(t), costingServiceMock, taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionView).Return(nil)
+	repoMock.On("GetProject", mock.Anything, 1).Return(domain.Project{ID: 1, ProposedBudget: money.MustNew(1000, "USD")}, nil)
+	budgetServiceMock.On("ListLineItems", mock.Anything, 1).Return([]budgetDomain.LineItem{}, nil)
+	costingServiceMock.On("ProjectActualCost", mock.Anything, mock.Anything).Return(costingDomain.ActualCost{
+		ProjectID: 1,
+		Expenses: map[string]money.Money{budgetDomain.CategoryHardware: money.MustNew(300, "USD")},
+		Labor: money.MustNew(0, "USD"),
+		Total: money.MustNew(300, "USD"),
+	}, nil)
+
+	report, err := service.ReportProjectSpend(context.Background(), usecase.ProjectSpendReportRequest{ProjectID: 1})
+	require.NoError(t, err)
+	require.Equal(t, money.MustNew(0, "USD"), report.BurnRate)
+	require.Len(t, report.Categories, 1)
+	require.Equal(t, money.MustNew(-300, "USD"), report.Categories[0].Remaining)
+	require.Zero(t, report.Categories[0].PercentConsumed)
+}
@@ -0,0 +1,166 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	auditUseCaseMock "github.com/captainhbb/tbs-backend/internal/audit/usecase/mock"
+	budgetUseCaseMock "github.com/captainhbb/tbs-backend/internal/budget/usecase/mock"
+	costingUseCaseMock "github.com/captainhbb/tbs-backend/internal/costing/usecase/mock"
+	exchangeUseCaseMock "github.com/captainhbb/tbs-backend/internal/exchange/usecase/mock"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	milestoneUseCaseMock "github.com/captainhbb/tbs-backend/internal/milestone/usecase/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	"github.com/captainhbb/tbs-backend/internal/project/domain"
+	"github.com/captainhbb/tbs-backend/internal/project/ports"
+	portsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/project/usecase"
+	taskUseCaseMock "github.com/captainhbb/tbs-backend/internal/task/usecase/mock"
+	userScoped "github.com/captainhbb/tbs-backend/internal/user/adapters/scoped"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userPortsMock "github.com/captainhbb/tbs-backend/internal/user/ports/mock"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTenantIsolation runs the project service on top of the tenant scoped
+// repositories with a caller in tenant 1. Membership checks are waved through
+// so that only the tenant boundary stands between the caller and the data.
+// Project 10 and user 1 belong to tenant 1; project 20, its approval 7 and
+// user 2 belong to tenant 2.
+func TestTenantIsolation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name			string
+		call			func(service usecase.ProjectService, ctx context.Context) error
+		mockSetup		func(repo *portsMock.MockRepository, auditService *auditUseCaseMock.MockAuditService)
+		expectedError	error
+	}{
+		{
+			name: "reads a project of the same tenant",
+			call: func(service usecase.ProjectService, ctx context.Context) error {
+				project, err := service.GetProject(ctx, 10)
+				require.Equal(t, 10, project.ID)
+				return err
+			},
+		},
+		{
+			name: "cannot read a project of another tenant",
+			call: func(service usecase.ProjectService, ctx context.Context) error {
+				_, err := service.GetProject(ctx, 20)
+				return err
+			},
+			expectedError: ports.ErrProjectNotFound,
+		},
+		{
+			name: "cannot update a project of another tenant",
+			call: func(service usecase.ProjectService, ctx context.Context) error {
+				_, err := service.UpdateProject(ctx, usecase.UpdateProjectRequest{
+					ID: 20,
+					Name: "taken over",
+					ProposedBudget: money.MustNew(100000, "USD"),
+					OwnerID: 1,
+				})
+				return err
+			},
+			expectedError: ports.ErrProjectNotFound,
+		},
+		{
+			name: "cannot delete a project of another tenant",
+			call: func(service usecase.ProjectService, ctx context.Context) error {
+				return service.DeleteProject(ctx, 20)
+			},
+			expectedError: ports.ErrProjectNotFound,
+		},
+		{
+			name: "cannot list revisions of a project of another tenant",
+			call: func(service usecase.ProjectService, ctx context.Context) error {
+				_, err := service.ListProjectRevisions(ctx, 20)
+				return err
+			},
+			expectedError: ports.ErrProjectNotFound,
+		},
+		{
+			name: "cannot decide a budget approval of another tenant",
+			call: func(service usecase.ProjectService, ctx context.Context) error {
+				_, err := service.ApproveBudget(ctx, usecase.DecideBudgetApprovalRequest{ApprovalID: 7})
+				return err
+			},
+			mockSetup: func(repo *portsMock.MockRepository, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetBudgetApproval", mock.Anything, 7).Return(domain.BudgetApproval{ID: 7, ProjectID: 20, Status: domain.ApprovalStatusPending}, nil)
+			},
+			expectedError: usecase.ErrApprovalNotFound,
+		},
+		{
+			name: "cannot hand a project to an owner of another tenant",
+			call: func(service usecase.ProjectService, ctx context.Context) error {
+				_, err := service.CreateProject(ctx, usecase.CreateProjectRequest{
+					Name: "new",
+					ProposedBudget: money.MustNew(100000, "USD"),
+					OwnerID: 2,
+				})
+				return err
+			},
+			expectedError: usecase.ErrOwnerNotFound,
+		},
+		{
+			name: "creates projects within the caller's tenant",
+			call: func(service usecase.ProjectService, ctx context.Context) error {
+				project, err := service.CreateProject(ctx, usecase.CreateProjectRequest{
+					Name: "new",
+					ProposedBudget: money.MustNew(100000, "USD"),
+					OwnerID: 1,
+				})
+				require.Equal(t, 1, project.TenantID)
+				return err
+			},
+			mockSetup: func(repo *portsMock.MockRepository, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("CreateProject", mock.Anything, mock.Anything).Return(func(_ context.Context, project domain.Project) (domain.Project, error) {
+					project.ID = 11
+					return project, nil
+				})
+				repo.On("GetProject", mock.Anything, 11).Return(domain.Project{ID: 11, TenantID: 1, OwnerID: 1}, nil).Maybe()
+				repo.On("CreateRevision", mock.Anything, mock.Anything).Return(domain.Revision{}, nil)
+				repo.On("ListBudgetApprovals", mock.Anything, 11).Return([]domain.BudgetApproval{}, nil)
+				repo.On("CreateBudgetApproval", mock.Anything, mock.Anything).Return(domain.BudgetApproval{ID: 8}, nil)
+				auditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoMock := portsMock.NewMockRepository(t)
+			repoMock.On("ListFieldDefinitions", mock.Anything).Return(nil, nil).Maybe()
+			userRepoMock := userPortsMock.NewMockRepository(t)
+			auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			membershipServiceMock.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			repoMock.On("GetProject", mock.Anything, 10).Return(domain.Project{ID: 10, TenantID: 1, OwnerID: 1}, nil).Maybe()
+			repoMock.On("GetProject", mock.Anything, 20).Return(domain.Project{ID: 20, TenantID: 2, OwnerID: 2}, nil).Maybe()
+			userRepoMock.On("GetUser", mock.Anything, 1).Return(userDomain.User{ID: 1, TenantID: 1, Active: true}, nil).Maybe()
+			userRepoMock.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, TenantID: 2, Active: true}, nil).Maybe()
+			if tt.mockSetup != nil {
+				tt.mockSetup(repoMock, auditServiceMock)
+			}
+
+			repo := scoped.New(repoMock)
+			userService := userUseCase.New(userScoped.New(userRepoMock), repo, auditServiceMock, userUseCaseMock.NewMockProjectHandover(t), transaction.None())
+			service := usecase.New(repo, userService, membershipServiceMock, auditServiceMock, exchangeUseCaseMock.NewMockExchangeService(t), budgetUseCaseMock.NewMockBudgetService(t), milestoneUseCaseMock.NewMockMilestoneService(t), costingUseCaseMock.NewMockCostingService(t), taskUseCaseMock.NewMockTaskService(t), usecase.DefaultBudgetApprovalPolicy, transaction.None())
+			err := tt.call(service, tenant.WithID(context.Background(), 1))
+			require.ErrorIs(t, err, tt.expectedError)
+			repoMock.AssertNotCalled(t, "UpdateProject", mock.Anything, mock.Anything)
+			repoMock.AssertNotCalled(t, "DeleteProject", mock.Anything, mock.Anything)
+			repoMock.AssertNotCalled(t, "ListRevisions", mock.Anything, mock.Anything)
+			repoMock.AssertNotCalled(t, "UpdateBudgetApproval", mock.Anything, mock.Anything)
+		})
+	}
+}
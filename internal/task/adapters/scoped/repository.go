@@ -0,0 +1,161 @@
+// Package scoped confines a task repository to the tenant carried in the
+// request context, through the project each task belongs to. Tasks,
+// dependencies and progress updates of projects in other tenants are reported
+// as not found. Platform calls see every tenant, and requests carrying neither
+// see no tasks at all.
+package scoped
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/task/domain"
+	"github.com/captainhbb/tbs-backend/internal/task/ports"
+	projectScoped "github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+)
+
+type repository struct {
+	next			ports.Repository
+	projectRepo		projectPorts.Repository
+}
+
+// New wraps next so that every query is confined to the projects of the
+// tenant in ctx, which projectRepo looks up.
+func New(next ports.Repository, projectRepo projectPorts.Repository) ports.Repository {
+	return &repository{next: next, projectRepo: projectRepo}
+}
+
+func(r *repository) CreateTask(ctx context.Context, task domain.Task) (domain.Task, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, task.ProjectID)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	return r.next.CreateTask(ctx, task)
+}
+
+func(r *repository) GetTask(ctx context.Context, id int) (domain.Task, error) {
+	task, err := r.next.GetTask(ctx, id)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	err = projectScoped.CheckProject(ctx, r.projectRepo, task.ProjectID)
+	switch err {
+	case nil:
+	case projectPorts.ErrProjectNotFound:
+		return domain.Task{}, ports.ErrTaskNotFound
+	default:
+		return domain.Task{}, err
+	}
+	return task, nil
+}
+
+// UpdateTask keeps the task in the project it belongs to.
+func(r *repository) UpdateTask(ctx context.Context, task domain.Task) (domain.Task, error) {
+	existingTask, err := r.GetTask(ctx, task.ID)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	task.ProjectID = existingTask.ProjectID
+	return r.next.UpdateTask(ctx, task)
+}
+
+func(r *repository) DeleteTask(ctx context.Context, id int) error {
+	_, err := r.GetTask(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeleteTask(ctx, id)
+}
+
+// ListTasks checks the filter's project, or leaves out the tasks of projects
+// in other tenants when it names none.
+func(r *repository) ListTasks(ctx context.Context, filter domain.Filter) ([]domain.Task, error) {
+	if filter.ProjectID != 0 {
+		err := projectScoped.CheckProject(ctx, r.projectRepo, filter.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		return r.next.ListTasks(ctx, filter)
+	}
+
+	tasks, err := r.next.ListTasks(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	visible := make([]domain.Task, 0, len(tasks))
+	checked := make(map[int]bool)
+	for _, task := range tasks {
+		projectVisible, ok := checked[task.ProjectID]
+		if !ok {
+			err := projectScoped.CheckProject(ctx, r.projectRepo, task.ProjectID)
+			switch err {
+			case nil:
+				projectVisible = true
+			case projectPorts.ErrProjectNotFound:
+			default:
+				return nil, err
+			}
+			checked[task.ProjectID] = projectVisible
+		}
+		if projectVisible {
+			visible = append(visible, task)
+		}
+	}
+	return visible, nil
+}
+
+func(r *repository) CreateDependency(ctx context.Context, dependency domain.Dependency) (domain.Dependency, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, dependency.ProjectID)
+	if err != nil {
+		return domain.Dependency{}, err
+	}
+	return r.next.CreateDependency(ctx, dependency)
+}
+
+func(r *repository) GetDependency(ctx context.Context, id int) (domain.Dependency, error) {
+	dependency, err := r.next.GetDependency(ctx, id)
+	if err != nil {
+		return domain.Dependency{}, err
+	}
+	err = projectScoped.CheckProject(ctx, r.projectRepo, dependency.ProjectID)
+	switch err {
+	case nil:
+	case projectPorts.ErrProjectNotFound:
+		return domain.Dependency{}, ports.ErrDependencyNotFound
+	default:
+		return domain.Dependency{}, err
+	}
+	return dependency, nil
+}
+
+func(r *repository) DeleteDependency(ctx context.Context, id int) error {
+	_, err := r.GetDependency(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeleteDependency(ctx, id)
+}
+
+func(r *repository) ListDependencies(ctx context.Context, projectID int) ([]domain.Dependency, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListDependencies(ctx, projectID)
+}
+
+func(r *repository) CreateProgressUpdate(ctx context.Context, update domain.ProgressUpdate) (domain.ProgressUpdate, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, update.ProjectID)
+	if err != nil {
+		return domain.ProgressUpdate{}, err
+	}
+	return r.next.CreateProgressUpdate(ctx, update)
+}
+
+func(r *repository) ListProgressUpdates(ctx context.Context, projectID int) ([]domain.ProgressUpdate, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListProgressUpdates(ctx, projectID)
+}
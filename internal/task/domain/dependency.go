@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	DependencyFinishToStart		= "finish_to_start"
+	DependencyStartToStart		= "start_to_start"
+)
+
+// Dependency constrains SuccessorID to start no earlier than Lag after the
+// predecessor finishes (finish-to-start) or starts (start-to-start). A negative
+// lag is a lead.
+type Dependency struct {
+	ID 					int
+	ProjectID			int
+	PredecessorID		int
+	SuccessorID			int
+	Type				string
+	Lag					time.Duration
+}
+
+func IsValidDependencyType(dependencyType string) bool {
+	return dependencyType == DependencyFinishToStart || dependencyType == DependencyStartToStart
+}
+
+// HasCycle reports whether the dependencies contain a cycle.
+func HasCycle(dependencies []Dependency) bool {
+	_, ok := topologicalOrder(nil, dependencies)
+	return !ok
+}
+
+// topologicalOrder orders taskIDs plus every task named by a dependency so
+// that predecessors come first. It returns false if the dependencies form a
+// cycle.
+func topologicalOrder(taskIDs []int, dependencies []Dependency) ([]int, bool) {
+	inDegree := make(map[int]int)
+	successors := make(map[int][]int)
+	var nodes []int
+	addNode := func(id int) {
+		if _, ok := inDegree[id]; !ok {
+			inDegree[id] = 0
+			nodes = append(nodes, id)
+		}
+	}
+
+	for _, id := range taskIDs {
+		addNode(id)
+	}
+	for _, dependency := range dependencies {
+		addNode(dependency.PredecessorID)
+		addNode(dependency.SuccessorID)
+		successors[dependency.PredecessorID] = append(successors[dependency.PredecessorID], dependency.SuccessorID)
+		inDegree[dependency.SuccessorID]++
+	}
+
+	var queue []int
+	for _, id := range nodes {
+		if inDegree[id] == 0 {
+			queue = append(queue, id)
+		}
+	}
+
+	order := make([]int, 0, len(nodes))
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		order = append(order, id)
+		for _, successor := range successors[id] {
+			inDegree[successor]--
+			if inDegree[successor] == 0 {
+				queue = append(queue, successor)
+			}
+		}
+	}
+	return order, len(order) == len(nodes)
+}
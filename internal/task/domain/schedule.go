@@ -0,0 +1,228 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// ScheduledTask holds the critical path figures of one task. Durations are the
+// task estimates applied as elapsed time. Summary is set for tasks with
+// children, whose figures span those of their leaf tasks instead.
+type ScheduledTask struct {
+	TaskID				int
+	WBSCode				string
+	Summary				bool
+	Duration			time.Duration
+	EarliestStart		time.Time
+	EarliestFinish		time.Time
+	LatestStart			time.Time
+	LatestFinish		time.Time
+	Slack				time.Duration
+	Critical			bool
+}
+
+// Schedule is the outcome of the critical path method for a project.
+// CriticalPath lists the zero-slack leaf tasks in the order they start.
+type Schedule struct {
+	ProjectID			int
+	Start				time.Time
+	Finish				time.Time
+	Tasks				[]ScheduledTask
+	CriticalPath		[]int
+	// ExceedsEndDate is set when Finish is after the project's end date, by
+	// Overrun.
+	ExceedsEndDate		bool
+	Overrun				time.Duration
+}
+
+// ComputeSchedule runs the forward and backward passes of the critical path
+// method over the leaf tasks of tasks starting at start. A dependency on a
+// task with children applies to each of its leaf tasks, and a task with
+// children is scheduled from the earliest of its leaf tasks to the latest. It
+// returns false if the dependencies form a cycle.
+func ComputeSchedule(start time.Time, tasks []Task, dependencies []Dependency) (Schedule, bool) {
+	byID := make(map[int]Task, len(tasks))
+	for _, task := range tasks {
+		byID[task.ID] = task
+	}
+	children := childTasks(tasks)
+	taskIDs := make([]int, 0, len(tasks))
+	for _, task := range tasks {
+		if len(children[task.ID]) == 0 {
+			taskIDs = append(taskIDs, task.ID)
+		}
+	}
+	leaves := leafTasks(children)
+	dependencies = expandDependencies(dependencies, leaves)
+
+	order, ok := topologicalOrder(taskIDs, dependencies)
+	if !ok {
+		return Schedule{}, false
+	}
+
+	predecessors := make(map[int][]Dependency)
+	successors := make(map[int][]Dependency)
+	for _, dependency := range dependencies {
+		predecessors[dependency.SuccessorID] = append(predecessors[dependency.SuccessorID], dependency)
+		successors[dependency.PredecessorID] = append(successors[dependency.PredecessorID], dependency)
+	}
+
+	scheduled := make(map[int]*ScheduledTask, len(order))
+	finish := start
+	for _, id := range order {
+		task := byID[id]
+		entry := &ScheduledTask{TaskID: id, WBSCode: task.WBSCode, Duration: task.Estimate, EarliestStart: start}
+		for _, dependency := range predecessors[id] {
+			predecessor, ok := scheduled[dependency.PredecessorID]
+			if !ok {
+				continue
+			}
+			earliest := predecessor.EarliestFinish.Add(dependency.Lag)
+			if dependency.Type == DependencyStartToStart {
+				earliest = predecessor.EarliestStart.Add(dependency.Lag)
+			}
+			if earliest.After(entry.EarliestStart) {
+				entry.EarliestStart = earliest
+			}
+		}
+		entry.EarliestFinish = entry.EarliestStart.Add(entry.Duration)
+		if entry.EarliestFinish.After(finish) {
+			finish = entry.EarliestFinish
+		}
+		scheduled[id] = entry
+	}
+
+	for i := len(order) - 1; i >= 0; i-- {
+		entry := scheduled[order[i]]
+		entry.LatestFinish = finish
+		for _, dependency := range successors[entry.TaskID] {
+			successor, ok := scheduled[dependency.SuccessorID]
+			if !ok {
+				continue
+			}
+			latest := successor.LatestStart.Add(-dependency.Lag)
+			if dependency.Type == DependencyStartToStart {
+				latest = latest.Add(entry.Duration)
+			}
+			if latest.Before(entry.LatestFinish) {
+				entry.LatestFinish = latest
+			}
+		}
+		entry.LatestStart = entry.LatestFinish.Add(-entry.Duration)
+		entry.Slack = entry.LatestStart.Sub(entry.EarliestStart)
+		entry.Critical = entry.Slack == 0
+	}
+
+	critical := make([]ScheduledTask, 0)
+	for _, id := range taskIDs {
+		if scheduled[id].Critical {
+			critical = append(critical, *scheduled[id])
+		}
+	}
+
+	schedule := Schedule{Start: start, Finish: finish, Tasks: make([]ScheduledTask, 0, len(tasks))}
+	for _, task := range tasks {
+		entry, ok := scheduled[task.ID]
+		if !ok {
+			summary := summarize(task, leaves(task.ID), scheduled)
+			entry = &summary
+		}
+		schedule.Tasks = append(schedule.Tasks, *entry)
+	}
+
+	sort.SliceStable(critical, func(i, j int) bool {
+		return critical[i].EarliestStart.Before(critical[j].EarliestStart)
+	})
+	for _, entry := range critical {
+		schedule.CriticalPath = append(schedule.CriticalPath, entry.TaskID)
+	}
+	return schedule, true
+}
+
+// HasLeafCycle reports whether the dependencies contain a cycle once those on
+// tasks with children are applied to their leaf tasks, as ComputeSchedule
+// does.
+func HasLeafCycle(tasks []Task, dependencies []Dependency) bool {
+	return HasCycle(expandDependencies(dependencies, leafTasks(childTasks(tasks))))
+}
+
+func childTasks(tasks []Task) map[int][]int {
+	children := make(map[int][]int)
+	for _, task := range tasks {
+		if task.ParentID != 0 {
+			children[task.ParentID] = append(children[task.ParentID], task.ID)
+		}
+	}
+	return children
+}
+
+// leafTasks returns a lookup of the leaf tasks below a task, given the
+// children of every task. A task without children is its own leaf.
+func leafTasks(children map[int][]int) func(id int) []int {
+	memo := make(map[int][]int)
+	var leaves func(id int) []int
+	leaves = func(id int) []int {
+		if len(children[id]) == 0 {
+			return []int{id}
+		}
+		if cached, ok := memo[id]; ok {
+			return cached
+		}
+		var found []int
+		for _, child := range children[id] {
+			found = append(found, leaves(child)...)
+		}
+		memo[id] = found
+		return found
+	}
+	return leaves
+}
+
+// expandDependencies replaces every dependency with one between each pair of
+// leaf tasks below its predecessor and successor. Pairs linking a leaf to
+// itself, which a dependency between a task and its own ancestor would
+// produce, are dropped.
+func expandDependencies(dependencies []Dependency, leaves func(id int) []int) []Dependency {
+	expanded := make([]Dependency, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		for _, predecessorID := range leaves(dependency.PredecessorID) {
+			for _, successorID := range leaves(dependency.SuccessorID) {
+				if predecessorID == successorID {
+					continue
+				}
+				leafDependency := dependency
+				leafDependency.PredecessorID = predecessorID
+				leafDependency.SuccessorID = successorID
+				expanded = append(expanded, leafDependency)
+			}
+		}
+	}
+	return expanded
+}
+
+// summarize derives the figures of a task with children from its scheduled
+// leaf tasks. It is as critical as its most critical leaf.
+func summarize(task Task, leafIDs []int, scheduled map[int]*ScheduledTask) ScheduledTask {
+	summary := ScheduledTask{TaskID: task.ID, WBSCode: task.WBSCode, Summary: true}
+	for i, id := range leafIDs {
+		leaf := scheduled[id]
+		if i == 0 || leaf.EarliestStart.Before(summary.EarliestStart) {
+			summary.EarliestStart = leaf.EarliestStart
+		}
+		if i == 0 || leaf.EarliestFinish.After(summary.EarliestFinish) {
+			summary.EarliestFinish = leaf.EarliestFinish
+		}
+		if i == 0 || leaf.LatestStart.Before(summary.LatestStart) {
+			summary.LatestStart = leaf.LatestStart
+		}
+		if i == 0 || leaf.LatestFinish.After(summary.LatestFinish) {
+			summary.LatestFinish = leaf.LatestFinish
+		}
+		if i == 0 || leaf.Slack < summary.Slack {
+			summary.Slack = leaf.Slack
+		}
+	}
+	summary.Duration = summary.EarliestFinish.Sub(summary.EarliestStart)
+	summary.Critical = len(leafIDs) > 0 && summary.Slack == 0
+	return summary
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	StatusTodo					= "todo"
+	StatusInProgress			= "in_progress"
+	StatusBlocked				= "blocked"
+	StatusDone					= "done"
+)
+
+var statuses = map[string]bool{
+	StatusTodo: true,
+	StatusInProgress: true,
+	StatusBlocked: true,
+	StatusDone: true,
+}
+
+// Task is a node of a project's work breakdown structure. Root tasks have no
+// parent; WBSCode is the dotted path of sibling positions, e.g. "2.1.3".
+// Progress is the percentage of the task's work that is complete.
+type Task struct {
+	ID 					int
+	ProjectID			int
+	ParentID			int
+	WBSCode				string
+	Name				string
+	Description			string
+	AssigneeID			int
+	Estimate			time.Duration
+	Status				string
+	Progress			int
+	DueDate				time.Time
+}
+
+// ProgressUpdate records that a task's progress changed to Progress at
+// RecordedAt, so that progress can be looked up as of an earlier date.
+type ProgressUpdate struct {
+	ID 					int
+	TaskID				int
+	ProjectID			int
+	Progress			int
+	RecordedAt			time.Time
+}
+
+// Filter narrows a task query. Zero-valued fields do not filter.
+type Filter struct {
+	ProjectID			int
+	AssigneeID			int
+	ParentID			int
+	Status				string
+	// DueBefore is exclusive.
+	DueBefore			time.Time
+}
+
+// Matches reports whether task satisfies every non-zero field of the filter.
+func (f Filter) Matches(task Task) bool {
+	if f.ProjectID != 0 && f.ProjectID != task.ProjectID {
+		return false
+	}
+	if f.AssigneeID != 0 && f.AssigneeID != task.AssigneeID {
+		return false
+	}
+	if f.ParentID != 0 && f.ParentID != task.ParentID {
+		return false
+	}
+	if f.Status != "" && f.Status != task.Status {
+		return false
+	}
+	if !f.DueBefore.IsZero() && (task.DueDate.IsZero() || !task.DueDate.Before(f.DueBefore)) {
+		return false
+	}
+	return true
+}
+
+func IsValidStatus(status string) bool {
+	return statuses[status]
+}
+
+// ChildWBSCode returns the code of the position-th child of parentCode. An
+// empty parentCode denotes the project root.
+func ChildWBSCode(parentCode string, position int) string {
+	if parentCode == "" {
+		return strconv.Itoa(position)
+	}
+	return parentCode + "." + strconv.Itoa(position)
+}
+
+// WBSPosition returns the last segment of code, i.e. the task's position among
+// its siblings.
+func WBSPosition(code string) int {
+	position, _ := strconv.Atoi(code[strings.LastIndex(code, ".")+1:])
+	return position
+}
@@ -0,0 +1,8 @@
+package ports
+
+import "errors"
+
+var (
+	ErrTaskNotFound				= errors.New("task not found")
+	ErrDependencyNotFound		= errors.New("task dependency not found")
+)
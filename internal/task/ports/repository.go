@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/task/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	CreateTask(ctx context.Context, task domain.Task) (domain.Task, error)
+	GetTask(ctx context.Context, id int) (domain.Task, error)
+	UpdateTask(ctx context.Context, task domain.Task) (domain.Task, error)
+	// DeleteTask removes the task together with the dependencies that name it.
+	DeleteTask(ctx context.Context, id int) error
+	// ListTasks returns the tasks matching filter ordered by WBS code.
+	ListTasks(ctx context.Context, filter domain.Filter) ([]domain.Task, error)
+	CreateDependency(ctx context.Context, dependency domain.Dependency) (domain.Dependency, error)
+	GetDependency(ctx context.Context, id int) (domain.Dependency, error)
+	DeleteDependency(ctx context.Context, id int) error
+	ListDependencies(ctx context.Context, projectID int) ([]domain.Dependency, error)
+	CreateProgressUpdate(ctx context.Context, update domain.ProgressUpdate) (domain.ProgressUpdate, error)
+	// ListProgressUpdates returns the progress history of the project's tasks
+	// ordered by RecordedAt.
+	ListProgressUpdates(ctx context.Context, projectID int) ([]domain.ProgressUpdate, error)
+}
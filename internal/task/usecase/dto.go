@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"time"
+)
+
+type CreateTaskRequest struct {
+	ProjectID 				int
+	// ParentID places the task under another task of the same project. Zero
+	// creates a root task.
+	ParentID 				int
+	Name 					string
+	Description 			string
+	AssigneeID 				int
+	Estimate 				time.Duration
+	// Status defaults to todo.
+	Status 					string
+	// Progress is the percentage complete; done tasks are always at 100.
+	Progress 				int
+	DueDate 				time.Time
+}
+
+type UpdateTaskRequest struct {
+	ID 						int
+	Name 					string
+	Description 			string
+	AssigneeID 				int
+	Estimate 				time.Duration
+	Status 					string
+	Progress 				int
+	DueDate 				time.Time
+}
+
+type AddDependencyRequest struct {
+	PredecessorID 			int
+	SuccessorID 			int
+	// Type defaults to finish-to-start.
+	Type 					string
+	Lag 					time.Duration
+}
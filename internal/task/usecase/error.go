@@ -0,0 +1,21 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrTaskNotFound				= errors.New("task not found")
+	ErrProjectNotFound			= errors.New("project not found")
+	ErrAssigneeNotFound			= errors.New("assignee not found")
+	ErrInvalidParent			= errors.New("parent task must belong to the same project")
+	ErrTaskHasChildren			= errors.New("task has subtasks")
+	ErrNameRequired				= errors.New("task name is required")
+	ErrInvalidStatus			= errors.New("invalid task status")
+	ErrInvalidEstimate			= errors.New("estimate must not be negative")
+	ErrInvalidProgress			= errors.New("progress must be between 0 and 100 percent")
+	ErrDueDateOutsideProject	= errors.New("due date must fall within the project's start and end dates")
+	ErrDependencyNotFound		= errors.New("task dependency not found")
+	ErrInvalidDependency		= errors.New("dependencies must link two different tasks of the same project")
+	ErrInvalidDependencyType	= errors.New("invalid dependency type")
+	ErrDependencyExists			= errors.New("tasks are already linked")
+	ErrDependencyCycle			= errors.New("dependency would create a cycle")
+)
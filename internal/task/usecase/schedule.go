@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	"github.com/captainhbb/tbs-backend/internal/task/domain"
+	"github.com/captainhbb/tbs-backend/internal/task/ports"
+)
+
+func(s *taskService) AddDependency(ctx context.Context, addDependencyRequest AddDependencyRequest) (domain.Dependency, error) {
+	dependency := domain.Dependency{
+		PredecessorID: addDependencyRequest.PredecessorID,
+		SuccessorID: addDependencyRequest.SuccessorID,
+		Type: addDependencyRequest.Type,
+		Lag: addDependencyRequest.Lag,
+	}
+	if dependency.Type == "" {
+		dependency.Type = domain.DependencyFinishToStart
+	}
+	if !domain.IsValidDependencyType(dependency.Type) {
+		return domain.Dependency{}, ErrInvalidDependencyType
+	}
+	if dependency.PredecessorID == dependency.SuccessorID {
+		return domain.Dependency{}, ErrInvalidDependency
+	}
+
+	predecessor, err := s.getTask(ctx, dependency.PredecessorID)
+	if err != nil {
+		return domain.Dependency{}, err
+	}
+	successor, err := s.getTask(ctx, dependency.SuccessorID)
+	if err != nil {
+		return domain.Dependency{}, err
+	}
+	if predecessor.ProjectID != successor.ProjectID {
+		return domain.Dependency{}, ErrInvalidDependency
+	}
+	dependency.ProjectID = predecessor.ProjectID
+
+	err = s.membershipService.Authorize(ctx, dependency.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Dependency{}, err
+	}
+
+	dependencies, err := s.repo.ListDependencies(ctx, dependency.ProjectID)
+	if err != nil {
+		return domain.Dependency{}, err
+	}
+	for _, existing := range dependencies {
+		if existing.PredecessorID == dependency.PredecessorID && existing.SuccessorID == dependency.SuccessorID {
+			return domain.Dependency{}, ErrDependencyExists
+		}
+	}
+	tasks, err := s.repo.ListTasks(ctx, domain.Filter{ProjectID: dependency.ProjectID})
+	if err != nil {
+		return domain.Dependency{}, err
+	}
+	if domain.HasLeafCycle(tasks, append(dependencies, dependency)) {
+		return domain.Dependency{}, ErrDependencyCycle
+	}
+	return s.repo.CreateDependency(ctx, dependency)
+}
+
+func(s *taskService) RemoveDependency(ctx context.Context, id int) error {
+	dependency, err := s.repo.GetDependency(ctx, id)
+	switch err {
+	case nil:
+	case ports.ErrDependencyNotFound:
+		return ErrDependencyNotFound
+	default:
+		return err
+	}
+
+	err = s.membershipService.Authorize(ctx, dependency.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteDependency(ctx, id)
+	switch err {
+	case ports.ErrDependencyNotFound:
+		return ErrDependencyNotFound
+	}
+	return err
+}
+
+func(s *taskService) ListDependencies(ctx context.Context, projectID int) ([]domain.Dependency, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListDependencies(ctx, projectID)
+}
+
+func(s *taskService) ScheduleProject(ctx context.Context, projectID int) (domain.Schedule, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return domain.Schedule{}, err
+	}
+
+	project, err := s.getProject(ctx, projectID)
+	if err != nil {
+		return domain.Schedule{}, err
+	}
+	tasks, err := s.repo.ListTasks(ctx, domain.Filter{ProjectID: projectID})
+	if err != nil {
+		return domain.Schedule{}, err
+	}
+	dependencies, err := s.repo.ListDependencies(ctx, projectID)
+	if err != nil {
+		return domain.Schedule{}, err
+	}
+
+	start := project.StartDate
+	if start.IsZero() {
+		start = time.Now()
+	}
+	schedule, ok := domain.ComputeSchedule(start, tasks, dependencies)
+	if !ok {
+		return domain.Schedule{}, ErrDependencyCycle
+	}
+	schedule.ProjectID = projectID
+
+	if !project.EndDate.IsZero() && schedule.Finish.After(project.EndDate) {
+		schedule.ExceedsEndDate = true
+		schedule.Overrun = schedule.Finish.Sub(project.EndDate)
+	}
+	return schedule, nil
+}
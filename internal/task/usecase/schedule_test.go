@@ -0,0 +1,184 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/task/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/task/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/task/usecase"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const day = 24 * time.Hour
+
+func TestScheduleProject(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	projectRepoMock := projectPortsMock.NewMockRepository(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	service := usecase.New(repoMock, projectRepoMock, userUseCaseMock.NewMockUserService(t), membershipServiceMock)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionView).Return(nil)
+	projectRepoMock.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, StartDate: start, EndDate: start.Add(6 * day)}, nil)
+	repoMock.On("ListTasks", mock.Anything, domain.Filter{ProjectID: 1}).Return([]domain.Task{
+		{ID: 1, ProjectID: 1, WBSCode: "1", Estimate: 2 * day},
+		{ID: 2, ProjectID: 1, WBSCode: "2", Estimate: 3 * day},
+		{ID: 3, ProjectID: 1, WBSCode: "3", Estimate: 1 * day},
+		{ID: 4, ProjectID: 1, WBSCode: "4", Estimate: 2 * day},
+		{ID: 5, ProjectID: 1, WBSCode: "5", Estimate: 4 * day},
+	}, nil)
+	repoMock.On("ListDependencies", mock.Anything, 1).Return([]domain.Dependency{
+		{PredecessorID: 1, SuccessorID: 2, Type: domain.DependencyFinishToStart},
+		{PredecessorID: 1, SuccessorID: 3, Type: domain.DependencyFinishToStart},
+		{PredecessorID: 2, SuccessorID: 4, Type: domain.DependencyFinishToStart},
+		{PredecessorID: 3, SuccessorID: 4, Type: domain.DependencyFinishToStart},
+		{PredecessorID: 1, SuccessorID: 5, Type: domain.DependencyStartToStart, Lag: day},
+	}, nil)
+
+	schedule, err := service.ScheduleProject(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, start.Add(7 * day), schedule.Finish)
+	require.Equal(t, []int{1, 2, 4}, schedule.CriticalPath)
+	require.True(t, schedule.ExceedsEndDate)
+	require.Equal(t, day, schedule.Overrun)
+
+	byID := make(map[int]domain.ScheduledTask)
+	for _, entry := range schedule.Tasks {
+		byID[entry.TaskID] = entry
+	}
+	require.Equal(t, start.Add(2 * day), byID[3].EarliestStart)
+	require.Equal(t, start.Add(4 * day), byID[3].LatestStart)
+	require.Equal(t, 2 * day, byID[3].Slack)
+	require.Equal(t, start.Add(day), byID[5].EarliestStart)
+	require.Equal(t, start.Add(3 * day), byID[5].LatestStart)
+	require.False(t, byID[5].Critical)
+}
+
+func TestScheduleProjectSchedulesLeafTasks(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	projectRepoMock := projectPortsMock.NewMockRepository(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	service := usecase.New(repoMock, projectRepoMock, userUseCaseMock.NewMockUserService(t), membershipServiceMock)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionView).Return(nil)
+	projectRepoMock.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, StartDate: start}, nil)
+	repoMock.On("ListTasks", mock.Anything, domain.Filter{ProjectID: 1}).Return([]domain.Task{
+		{ID: 1, ProjectID: 1, WBSCode: "1", Estimate: 20 * day},
+		{ID: 2, ProjectID: 1, ParentID: 1, WBSCode: "1.1", Estimate: 2 * day},
+		{ID: 3, ProjectID: 1, ParentID: 1, WBSCode: "1.2", Estimate: 3 * day},
+		{ID: 4, ProjectID: 1, WBSCode: "2", Estimate: 1 * day},
+	}, nil)
+	repoMock.On("ListDependencies", mock.Anything, 1).Return([]domain.Dependency{
+		{PredecessorID: 2, SuccessorID: 3, Type: domain.DependencyFinishToStart},
+		{PredecessorID: 1, SuccessorID: 4, Type: domain.DependencyFinishToStart},
+	}, nil)
+
+	schedule, err := service.ScheduleProject(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, start.Add(6 * day), schedule.Finish)
+	require.Equal(t, []int{2, 3, 4}, schedule.CriticalPath)
+
+	byID := make(map[int]domain.ScheduledTask)
+	for _, entry := range schedule.Tasks {
+		byID[entry.TaskID] = entry
+	}
+	require.Len(t, schedule.Tasks, 4)
+	require.True(t, byID[1].Summary)
+	require.Equal(t, start, byID[1].EarliestStart)
+	require.Equal(t, start.Add(5 * day), byID[1].EarliestFinish)
+	require.Equal(t, 5 * day, byID[1].Duration)
+	require.True(t, byID[1].Critical)
+	require.Equal(t, start.Add(5 * day), byID[4].EarliestStart)
+}
+
+func TestAddDependency(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		input usecase.AddDependencyRequest
+		tasks []domain.Task
+		existing []domain.Dependency
+		expectedError error
+	}{
+		{
+			name: "finish to start by default",
+			input: usecase.AddDependencyRequest{PredecessorID: 1, SuccessorID: 2, Lag: day},
+		},
+		{
+			name: "cycle",
+			input: usecase.AddDependencyRequest{PredecessorID: 2, SuccessorID: 1},
+			existing: []domain.Dependency{
+				{PredecessorID: 1, SuccessorID: 3},
+				{PredecessorID: 3, SuccessorID: 2},
+			},
+			expectedError: usecase.ErrDependencyCycle,
+		},
+		{
+			name: "cycle through a parent's children",
+			input: usecase.AddDependencyRequest{PredecessorID: 2, SuccessorID: 1},
+			tasks: []domain.Task{
+				{ID: 4, ProjectID: 1},
+				{ID: 1, ProjectID: 1, ParentID: 4},
+				{ID: 2, ProjectID: 1, ParentID: 4},
+			},
+			existing: []domain.Dependency{{PredecessorID: 1, SuccessorID: 4}},
+			expectedError: usecase.ErrDependencyCycle,
+		},
+		{
+			name: "duplicate",
+			input: usecase.AddDependencyRequest{PredecessorID: 1, SuccessorID: 2},
+			existing: []domain.Dependency{{PredecessorID: 1, SuccessorID: 2}},
+			expectedError: usecase.ErrDependencyExists,
+		},
+		{
+			name: "unknown type",
+			input: usecase.AddDependencyRequest{PredecessorID: 1, SuccessorID: 2, Type: "finish_to_finish"},
+			expectedError: usecase.ErrInvalidDependencyType,
+		},
+		{
+			name: "self dependency",
+			input: usecase.AddDependencyRequest{PredecessorID: 1, SuccessorID: 1},
+			expectedError: usecase.ErrInvalidDependency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			service := usecase.New(repoMock, projectPortsMock.NewMockRepository(t), userUseCaseMock.NewMockUserService(t), membershipServiceMock)
+
+			repoMock.On("GetTask", mock.Anything, 1).Return(domain.Task{ID: 1, ProjectID: 1}, nil).Maybe()
+			repoMock.On("GetTask", mock.Anything, 2).Return(domain.Task{ID: 2, ProjectID: 1}, nil).Maybe()
+			membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil).Maybe()
+			repoMock.On("ListDependencies", mock.Anything, 1).Return(tt.existing, nil).Maybe()
+			repoMock.On("ListTasks", mock.Anything, domain.Filter{ProjectID: 1}).Return(tt.tasks, nil).Maybe()
+			repoMock.On("CreateDependency", mock.Anything, mock.Anything).Return(func(_ context.Context, dependency domain.Dependency) (domain.Dependency, error) {
+				return dependency, nil
+			}).Maybe()
+
+			dependency, err := service.AddDependency(context.Background(), tt.input)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, domain.DependencyFinishToStart, dependency.Type)
+				require.Equal(t, 1, dependency.ProjectID)
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	"github.com/captainhbb/tbs-backend/internal/task/domain"
+	"github.com/captainhbb/tbs-backend/internal/task/ports"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+)
+
+//go:generate mockery --dir . --name TaskService --structname MockTaskService --filename mock_task_service.go --output ./mock --outpkg mock
+type TaskService interface {
+	CreateTask(ctx context.Context, task CreateTaskRequest) (domain.Task, error)
+	GetTask(ctx context.Context, id int) (domain.Task, error)
+	UpdateTask(ctx context.Context, task UpdateTaskRequest) (domain.Task, error)
+	DeleteTask(ctx context.Context, id int) error
+	ListProjectTasks(ctx context.Context, projectID int, filter domain.Filter) ([]domain.Task, error)
+	// ListAssigneeTasks returns the tasks assigned to a user across every project
+	// the acting user may view.
+	ListAssigneeTasks(ctx context.Context, assigneeID int, filter domain.Filter) ([]domain.Task, error)
+	AddDependency(ctx context.Context, dependency AddDependencyRequest) (domain.Dependency, error)
+	RemoveDependency(ctx context.Context, id int) error
+	ListDependencies(ctx context.Context, projectID int) ([]domain.Dependency, error)
+	// ScheduleProject computes the critical path of the project's leaf tasks
+	// from the project start date. Tasks with children span their leaf tasks.
+	ScheduleProject(ctx context.Context, projectID int) (domain.Schedule, error)
+	ListProgressUpdates(ctx context.Context, projectID int) ([]domain.ProgressUpdate, error)
+}
+
+type taskService struct {
+	repo ports.Repository
+	projectRepo projectPorts.Repository
+	userService userUseCase.UserService
+	membershipService membershipUseCase.MembershipService
+}
+
+func New(repo ports.Repository, projectRepo projectPorts.Repository, userService userUseCase.UserService, membershipService membershipUseCase.MembershipService) TaskService {
+	return &taskService{
+		repo: repo,
+		projectRepo: projectRepo,
+		userService: userService,
+		membershipService: membershipService,
+	}
+}
+
+func(s *taskService) CreateTask(ctx context.Context, createTaskRequest CreateTaskRequest) (domain.Task, error) {
+	task := domain.Task{
+		ProjectID: createTaskRequest.ProjectID,
+		ParentID: createTaskRequest.ParentID,
+		Name: createTaskRequest.Name,
+		Description: createTaskRequest.Description,
+		AssigneeID: createTaskRequest.AssigneeID,
+		Estimate: createTaskRequest.Estimate,
+		Status: createTaskRequest.Status,
+		Progress: createTaskRequest.Progress,
+		DueDate: createTaskRequest.DueDate,
+	}
+	if task.Status == "" {
+		task.Status = domain.StatusTodo
+	}
+	if task.Status == domain.StatusDone {
+		task.Progress = 100
+	}
+
+	err := s.membershipService.Authorize(ctx, task.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	err = s.validate(ctx, task)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	parentCode := ""
+	if task.ParentID != 0 {
+		parent, err := s.getTask(ctx, task.ParentID)
+		switch err {
+		case nil:
+		case ErrTaskNotFound:
+			return domain.Task{}, ErrInvalidParent
+		default:
+			return domain.Task{}, err
+		}
+		if parent.ProjectID != task.ProjectID {
+			return domain.Task{}, ErrInvalidParent
+		}
+		parentCode = parent.WBSCode
+	}
+
+	siblings, err := s.repo.ListTasks(ctx, domain.Filter{ProjectID: task.ProjectID})
+	if err != nil {
+		return domain.Task{}, err
+	}
+	position := 0
+	for _, sibling := range siblings {
+		if sibling.ParentID == task.ParentID && domain.WBSPosition(sibling.WBSCode) > position {
+			position = domain.WBSPosition(sibling.WBSCode)
+		}
+	}
+	task.WBSCode = domain.ChildWBSCode(parentCode, position + 1)
+
+	createdTask, err := s.repo.CreateTask(ctx, task)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	if createdTask.Progress != 0 {
+		err = s.recordProgress(ctx, createdTask)
+		if err != nil {
+			return domain.Task{}, err
+		}
+	}
+	return createdTask, nil
+}
+
+func(s *taskService) GetTask(ctx context.Context, id int) (domain.Task, error) {
+	task, err := s.getTask(ctx, id)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	err = s.membershipService.Authorize(ctx, task.ProjectID, membershipDomain.PermissionView)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	return task, nil
+}
+
+func(s *taskService) UpdateTask(ctx context.Context, updateTaskRequest UpdateTaskRequest) (domain.Task, error) {
+	existingTask, err := s.getTask(ctx, updateTaskRequest.ID)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	err = s.membershipService.Authorize(ctx, existingTask.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	task := existingTask
+	task.Name = updateTaskRequest.Name
+	task.Description = updateTaskRequest.Description
+	task.AssigneeID = updateTaskRequest.AssigneeID
+	task.Estimate = updateTaskRequest.Estimate
+	task.Status = updateTaskRequest.Status
+	task.Progress = updateTaskRequest.Progress
+	task.DueDate = updateTaskRequest.DueDate
+	if task.Status == domain.StatusDone {
+		task.Progress = 100
+	}
+
+	err = s.validate(ctx, task)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	updatedTask, err := s.repo.UpdateTask(ctx, task)
+	switch err {
+	case nil:
+	case ports.ErrTaskNotFound:
+		return domain.Task{}, ErrTaskNotFound
+	default:
+		return domain.Task{}, err
+	}
+
+	if updatedTask.Progress != existingTask.Progress {
+		err = s.recordProgress(ctx, updatedTask)
+		if err != nil {
+			return domain.Task{}, err
+		}
+	}
+	return updatedTask, nil
+}
+
+func(s *taskService) DeleteTask(ctx context.Context, id int) error {
+	task, err := s.getTask(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.membershipService.Authorize(ctx, task.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	children, err := s.repo.ListTasks(ctx, domain.Filter{ProjectID: task.ProjectID, ParentID: task.ID})
+	if err != nil {
+		return err
+	}
+	if len(children) > 0 {
+		return ErrTaskHasChildren
+	}
+
+	err = s.repo.DeleteTask(ctx, id)
+	switch err {
+	case ports.ErrTaskNotFound:
+		return ErrTaskNotFound
+	}
+	return err
+}
+
+func(s *taskService) ListProjectTasks(ctx context.Context, projectID int, filter domain.Filter) ([]domain.Task, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.ProjectID = projectID
+	return s.repo.ListTasks(ctx, filter)
+}
+
+func(s *taskService) ListAssigneeTasks(ctx context.Context, assigneeID int, filter domain.Filter) ([]domain.Task, error) {
+	filter.AssigneeID = assigneeID
+	tasks, err := s.repo.ListTasks(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make(map[int]bool)
+	filtered := make([]domain.Task, 0, len(tasks))
+	for _, task := range tasks {
+		allowed, checked := visible[task.ProjectID]
+		if !checked {
+			err := s.membershipService.Authorize(ctx, task.ProjectID, membershipDomain.PermissionView)
+			switch err {
+			case nil:
+				allowed = true
+			case membershipUseCase.ErrForbidden:
+				allowed = false
+			default:
+				return nil, err
+			}
+			visible[task.ProjectID] = allowed
+		}
+		if allowed {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered, nil
+}
+
+func(s *taskService) validate(ctx context.Context, task domain.Task) error {
+	if task.Name == "" {
+		return ErrNameRequired
+	}
+	if !domain.IsValidStatus(task.Status) {
+		return ErrInvalidStatus
+	}
+	if task.Estimate < 0 {
+		return ErrInvalidEstimate
+	}
+	if task.Progress < 0 || task.Progress > 100 {
+		return ErrInvalidProgress
+	}
+
+	project, err := s.getProject(ctx, task.ProjectID)
+	if err != nil {
+		return err
+	}
+	if !task.DueDate.IsZero() && !withinProject(project, task.DueDate) {
+		return ErrDueDateOutsideProject
+	}
+
+	if task.AssigneeID != 0 {
+		_, err := s.userService.GetUser(ctx, task.AssigneeID)
+		switch err {
+		case userUseCase.ErrUserNotFound:
+			return ErrAssigneeNotFound
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func(s *taskService) ListProgressUpdates(ctx context.Context, projectID int) ([]domain.ProgressUpdate, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListProgressUpdates(ctx, projectID)
+}
+
+func(s *taskService) recordProgress(ctx context.Context, task domain.Task) error {
+	_, err := s.repo.CreateProgressUpdate(ctx, domain.ProgressUpdate{
+		TaskID: task.ID,
+		ProjectID: task.ProjectID,
+		Progress: task.Progress,
+		RecordedAt: time.Now(),
+	})
+	return err
+}
+
+func(s *taskService) getTask(ctx context.Context, id int) (domain.Task, error) {
+	task, err := s.repo.GetTask(ctx, id)
+	switch err {
+	case ports.ErrTaskNotFound:
+		return domain.Task{}, ErrTaskNotFound
+	}
+	return task, err
+}
+
+func(s *taskService) getProject(ctx context.Context, projectID int) (projectDomain.Project, error) {
+	project, err := s.projectRepo.GetProject(ctx, projectID)
+	switch err {
+	case projectPorts.ErrProjectNotFound:
+		return projectDomain.Project{}, ErrProjectNotFound
+	}
+	return project, err
+}
+
+// withinProject reports whether date falls inside the project's window. An
+// unset start or end date leaves that side open.
+func withinProject(project projectDomain.Project, date time.Time) bool {
+	if !project.StartDate.IsZero() && date.Before(project.StartDate) {
+		return false
+	}
+	if !project.EndDate.IsZero() && date.After(project.EndDate) {
+		return false
+	}
+	return true
+}
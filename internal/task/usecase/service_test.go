@@ -0,0 +1,264 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/task/domain"
+	"github.com/captainhbb/tbs-backend/internal/task/ports"
+	portsMock "github.com/captainhbb/tbs-backend/internal/task/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/task/usecase"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	projectStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	projectEnd = time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+)
+
+func TestCreateTask(t *testing.T) {
+	t.Parallel()
+
+	existingTasks := []domain.Task{
+		{ID: 1, ProjectID: 1, WBSCode: "1"},
+		{ID: 2, ProjectID: 1, WBSCode: "2"},
+		{ID: 3, ProjectID: 1, ParentID: 2, WBSCode: "2.1"},
+	}
+
+	tests := []struct {
+		name string
+		input usecase.CreateTaskRequest
+		mockSetup func(repo *portsMock.MockRepository, userService *userUseCaseMock.MockUserService)
+		expectedWBSCode string
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "root task",
+			input: usecase.CreateTaskRequest{ProjectID: 1, Name: "Design", DueDate: projectStart.AddDate(0, 1, 0)},
+			mockSetup: func(repo *portsMock.MockRepository, _ *userUseCaseMock.MockUserService) {
+				repo.On("ListTasks", mock.Anything, domain.Filter{ProjectID: 1}).Return(existingTasks, nil)
+				repo.On("CreateTask", mock.Anything, mock.Anything).Return(func(_ context.Context, task domain.Task) (domain.Task, error) {
+					return task, nil
+				})
+			},
+			expectedWBSCode: "3",
+		},
+		{
+			name: "subtask with assignee",
+			input: usecase.CreateTaskRequest{ProjectID: 1, ParentID: 2, Name: "Build", AssigneeID: 5, Estimate: 8 * time.Hour},
+			mockSetup: func(repo *portsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				userService.On("GetUser", mock.Anything, 5).Return(userDomain.User{ID: 5}, nil)
+				repo.On("GetTask", mock.Anything, 2).Return(existingTasks[1], nil)
+				repo.On("ListTasks", mock.Anything, domain.Filter{ProjectID: 1}).Return(existingTasks, nil)
+				repo.On("CreateTask", mock.Anything, mock.Anything).Return(func(_ context.Context, task domain.Task) (domain.Task, error) {
+					return task, nil
+				})
+			},
+			expectedWBSCode: "2.2",
+		},
+		{
+			name: "parent from another project",
+			input: usecase.CreateTaskRequest{ProjectID: 1, ParentID: 9, Name: "Build"},
+			mockSetup: func(repo *portsMock.MockRepository, _ *userUseCaseMock.MockUserService) {
+				repo.On("GetTask", mock.Anything, 9).Return(domain.Task{ID: 9, ProjectID: 2, WBSCode: "1"}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrInvalidParent,
+		},
+		{
+			name: "due date after project end",
+			input: usecase.CreateTaskRequest{ProjectID: 1, Name: "Launch", DueDate: projectEnd.AddDate(0, 0, 1)},
+			mockSetup: func(_ *portsMock.MockRepository, _ *userUseCaseMock.MockUserService) {},
+			expectError: true,
+			expectedError: usecase.ErrDueDateOutsideProject,
+		},
+		{
+			name: "unknown assignee",
+			input: usecase.CreateTaskRequest{ProjectID: 1, Name: "Launch", AssigneeID: 42},
+			mockSetup: func(_ *portsMock.MockRepository, userService *userUseCaseMock.MockUserService) {
+				userService.On("GetUser", mock.Anything, 42).Return(userDomain.User{}, userUseCase.ErrUserNotFound)
+			},
+			expectError: true,
+			expectedError: usecase.ErrAssigneeNotFound,
+		},
+		{
+			name: "invalid status",
+			input: usecase.CreateTaskRequest{ProjectID: 1, Name: "Launch", Status: "someday"},
+			mockSetup: func(_ *portsMock.MockRepository, _ *userUseCaseMock.MockUserService) {},
+			expectError: true,
+			expectedError: usecase.ErrInvalidStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			userServiceMock := userUseCaseMock.NewMockUserService(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			service := usecase.New(repoMock, projectRepoMock, userServiceMock, membershipServiceMock)
+
+			membershipServiceMock.On("Authorize", mock.Anything, tt.input.ProjectID, membershipDomain.PermissionEdit).Return(nil)
+			projectRepoMock.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, StartDate: projectStart, EndDate: projectEnd}, nil).Maybe()
+			tt.mockSetup(repoMock, userServiceMock)
+
+			task, err := service.CreateTask(context.Background(), tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedWBSCode, task.WBSCode)
+				require.Equal(t, domain.StatusTodo, task.Status)
+			}
+		})
+	}
+}
+
+func TestUpdateTaskRecordsProgress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		input usecase.UpdateTaskRequest
+		expectedProgress int
+		expectRecorded bool
+		expectedError error
+	}{
+		{
+			name: "progress changed",
+			input: usecase.UpdateTaskRequest{ID: 2, Name: "Build", Status: domain.StatusInProgress, Progress: 60},
+			expectedProgress: 60,
+			expectRecorded: true,
+		},
+		{
+			name: "done completes the task",
+			input: usecase.UpdateTaskRequest{ID: 2, Name: "Build", Status: domain.StatusDone, Progress: 80},
+			expectedProgress: 100,
+			expectRecorded: true,
+		},
+		{
+			name: "progress unchanged",
+			input: usecase.UpdateTaskRequest{ID: 2, Name: "Build rename", Status: domain.StatusInProgress, Progress: 40},
+			expectedProgress: 40,
+		},
+		{
+			name: "over 100 percent",
+			input: usecase.UpdateTaskRequest{ID: 2, Name: "Build", Status: domain.StatusInProgress, Progress: 120},
+			expectedError: usecase.ErrInvalidProgress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			service := usecase.New(repoMock, projectRepoMock, userUseCaseMock.NewMockUserService(t), membershipServiceMock)
+
+			membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+			projectRepoMock.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, StartDate: projectStart, EndDate: projectEnd}, nil).Maybe()
+			repoMock.On("GetTask", mock.Anything, 2).Return(domain.Task{ID: 2, ProjectID: 1, WBSCode: "2", Name: "Build", Status: domain.StatusInProgress, Progress: 40}, nil)
+			repoMock.On("UpdateTask", mock.Anything, mock.Anything).Return(func(_ context.Context, task domain.Task) (domain.Task, error) {
+				return task, nil
+			}).Maybe()
+			if tt.expectRecorded {
+				repoMock.On("CreateProgressUpdate", mock.Anything, mock.MatchedBy(func(update domain.ProgressUpdate) bool {
+					return update.TaskID == 2 && update.ProjectID == 1 && update.Progress == tt.expectedProgress
+				})).Return(domain.ProgressUpdate{ID: 1}, nil)
+			}
+
+			task, err := service.UpdateTask(context.Background(), tt.input)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedProgress, task.Progress)
+			}
+		})
+	}
+}
+
+func TestDeleteTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		mockSetup func(repo *portsMock.MockRepository)
+		expectedError error
+	}{
+		{
+			name: "leaf task",
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("GetTask", mock.Anything, 2).Return(domain.Task{ID: 2, ProjectID: 1, WBSCode: "2"}, nil)
+				repo.On("ListTasks", mock.Anything, domain.Filter{ProjectID: 1, ParentID: 2}).Return([]domain.Task{}, nil)
+				repo.On("DeleteTask", mock.Anything, 2).Return(nil)
+			},
+		},
+		{
+			name: "task with subtasks",
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("GetTask", mock.Anything, 2).Return(domain.Task{ID: 2, ProjectID: 1, WBSCode: "2"}, nil)
+				repo.On("ListTasks", mock.Anything, domain.Filter{ProjectID: 1, ParentID: 2}).Return([]domain.Task{{ID: 3, ParentID: 2}}, nil)
+			},
+			expectedError: usecase.ErrTaskHasChildren,
+		},
+		{
+			name: "not found",
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("GetTask", mock.Anything, 2).Return(domain.Task{}, ports.ErrTaskNotFound)
+			},
+			expectedError: usecase.ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			service := usecase.New(repoMock, projectPortsMock.NewMockRepository(t), userUseCaseMock.NewMockUserService(t), membershipServiceMock)
+
+			membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil).Maybe()
+			tt.mockSetup(repoMock)
+
+			err := service.DeleteTask(context.Background(), 2)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestListAssigneeTasksHidesForbiddenProjects(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	service := usecase.New(repoMock, projectPortsMock.NewMockRepository(t), userUseCaseMock.NewMockUserService(t), membershipServiceMock)
+
+	repoMock.On("ListTasks", mock.Anything, domain.Filter{AssigneeID: 5, Status: domain.StatusInProgress}).Return([]domain.Task{
+		{ID: 1, ProjectID: 1, AssigneeID: 5},
+		{ID: 2, ProjectID: 2, AssigneeID: 5},
+		{ID: 3, ProjectID: 1, AssigneeID: 5},
+	}, nil)
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionView).Return(nil).Once()
+	membershipServiceMock.On("Authorize", mock.Anything, 2, membershipDomain.PermissionView).Return(membershipUseCase.ErrForbidden).Once()
+
+	tasks, err := service.ListAssigneeTasks(context.Background(), 5, domain.Filter{Status: domain.StatusInProgress})
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	require.Equal(t, 1, tasks[0].ID)
+	require.Equal(t, 3, tasks[1].ID)
+}
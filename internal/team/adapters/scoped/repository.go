@@ -0,0 +1,153 @@
+// Package scoped confines a team repository to the tenant carried in the
+// request context. Teams of other tenants, with their members and grants, and
+// grants on projects of other tenants are reported as not found. Platform
+// calls see every tenant, and requests carrying neither see no teams at all.
+package scoped
+
+import (
+	"context"
+
+	projectScoped "github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	"github.com/captainhbb/tbs-backend/internal/team/domain"
+	"github.com/captainhbb/tbs-backend/internal/team/ports"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+)
+
+type repository struct {
+	next			ports.Repository
+	projectRepo		projectPorts.Repository
+}
+
+// New wraps next so that every query is filtered by the tenant in ctx and new
+// teams are created within it, which fails with tenant.ErrTenantRequired when
+// ctx carries none. Grants are confined to the tenant's projects, which
+// projectRepo looks up.
+func New(next ports.Repository, projectRepo projectPorts.Repository) ports.Repository {
+	return &repository{next: next, projectRepo: projectRepo}
+}
+
+func(r *repository) CreateTeam(ctx context.Context, team domain.Team) (domain.Team, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	team.TenantID = tenantID
+	return r.next.CreateTeam(ctx, team)
+}
+
+func(r *repository) GetTeam(ctx context.Context, id int) (domain.Team, error) {
+	team, err := r.next.GetTeam(ctx, id)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	if !tenant.Visible(ctx, team.TenantID) {
+		return domain.Team{}, ports.ErrTeamNotFound
+	}
+	return team, nil
+}
+
+// UpdateTeam keeps the team in the tenant it belongs to.
+func(r *repository) UpdateTeam(ctx context.Context, team domain.Team) (domain.Team, error) {
+	existingTeam, err := r.GetTeam(ctx, team.ID)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	team.TenantID = existingTeam.TenantID
+	return r.next.UpdateTeam(ctx, team)
+}
+
+func(r *repository) DeleteTeam(ctx context.Context, id int) error {
+	_, err := r.GetTeam(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeleteTeam(ctx, id)
+}
+
+func(r *repository) ListTeams(ctx context.Context) ([]domain.Team, error) {
+	teams, err := r.next.ListTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return visibleTeams(ctx, teams), nil
+}
+
+func(r *repository) AddMember(ctx context.Context, member domain.Member) (domain.Member, error) {
+	_, err := r.GetTeam(ctx, member.TeamID)
+	if err != nil {
+		return domain.Member{}, err
+	}
+	return r.next.AddMember(ctx, member)
+}
+
+func(r *repository) RemoveMember(ctx context.Context, teamID int, userID int) error {
+	_, err := r.GetTeam(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	return r.next.RemoveMember(ctx, teamID, userID)
+}
+
+func(r *repository) ListMembers(ctx context.Context, teamID int) ([]domain.Member, error) {
+	_, err := r.GetTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListMembers(ctx, teamID)
+}
+
+func(r *repository) ListTeamsByUser(ctx context.Context, userID int) ([]domain.Team, error) {
+	teams, err := r.next.ListTeamsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return visibleTeams(ctx, teams), nil
+}
+
+// SaveGrant needs both the team and the project to be in the request's tenant.
+func(r *repository) SaveGrant(ctx context.Context, grant domain.Grant) (domain.Grant, error) {
+	_, err := r.GetTeam(ctx, grant.TeamID)
+	if err != nil {
+		return domain.Grant{}, err
+	}
+	err = projectScoped.CheckProject(ctx, r.projectRepo, grant.ProjectID)
+	if err != nil {
+		return domain.Grant{}, err
+	}
+	return r.next.SaveGrant(ctx, grant)
+}
+
+func(r *repository) RemoveGrant(ctx context.Context, teamID int, projectID int) error {
+	_, err := r.GetTeam(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	return r.next.RemoveGrant(ctx, teamID, projectID)
+}
+
+func(r *repository) ListGrants(ctx context.Context, teamID int) ([]domain.Grant, error) {
+	_, err := r.GetTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListGrants(ctx, teamID)
+}
+
+func(r *repository) ListProjectGrants(ctx context.Context, projectID int) ([]domain.Grant, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.next.ListProjectGrants(ctx, projectID)
+}
+
+func visibleTeams(ctx context.Context, teams []domain.Team) []domain.Team {
+	visible := make([]domain.Team, 0, len(teams))
+	for _, team := range teams {
+		if tenant.Visible(ctx, team.TenantID) {
+			visible = append(visible, team)
+		}
+	}
+	return visible
+}
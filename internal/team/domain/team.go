@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+)
+
+// Team is a group of users that can be granted a role on projects as a whole.
+// The lead is always one of its members.
+type Team struct {
+	ID 					int
+	TenantID			int
+	Name				string
+	LeadID				int
+	CreatedAt			time.Time
+}
+
+type Member struct {
+	TeamID				int
+	UserID				int
+	AddedAt				time.Time
+}
+
+// Grant gives every member of the team Role on the project. Role is one of the
+// project roles of the membership domain.
+type Grant struct {
+	TeamID				int
+	ProjectID			int
+	Role				string
+	GrantedAt			time.Time
+}
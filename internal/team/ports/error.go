@@ -0,0 +1,10 @@
+package ports
+
+import "errors"
+
+var (
+	ErrTeamNotFound				= errors.New("team not found")
+	ErrMemberNotFound			= errors.New("team member not found")
+	ErrMemberAlreadyExists		= errors.New("team member already exists")
+	ErrGrantNotFound			= errors.New("team grant not found")
+)
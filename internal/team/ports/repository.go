@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/team/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	CreateTeam(ctx context.Context, team domain.Team) (domain.Team, error)
+	GetTeam(ctx context.Context, id int) (domain.Team, error)
+	UpdateTeam(ctx context.Context, team domain.Team) (domain.Team, error)
+	// DeleteTeam also drops the team's members and grants.
+	DeleteTeam(ctx context.Context, id int) error
+	ListTeams(ctx context.Context) ([]domain.Team, error)
+	AddMember(ctx context.Context, member domain.Member) (domain.Member, error)
+	RemoveMember(ctx context.Context, teamID int, userID int) error
+	ListMembers(ctx context.Context, teamID int) ([]domain.Member, error)
+	// ListTeamsByUser returns the teams the user is a member of.
+	ListTeamsByUser(ctx context.Context, userID int) ([]domain.Team, error)
+	// SaveGrant creates the grant or replaces the role of an existing one.
+	SaveGrant(ctx context.Context, grant domain.Grant) (domain.Grant, error)
+	RemoveGrant(ctx context.Context, teamID int, projectID int) error
+	ListGrants(ctx context.Context, teamID int) ([]domain.Grant, error)
+	ListProjectGrants(ctx context.Context, projectID int) ([]domain.Grant, error)
+}
@@ -0,0 +1,30 @@
+package usecase
+
+type CreateTeamRequest struct {
+	Name 					string
+	LeadID 					int
+}
+
+type UpdateTeamRequest struct {
+	ID 						int
+	Name 					string
+	LeadID 					int
+}
+
+type TeamMemberRequest struct {
+	TeamID 					int
+	UserID 					int
+}
+
+// GrantProjectRequest gives every member of the team Role on the project, or
+// changes the role of an existing grant.
+type GrantProjectRequest struct {
+	TeamID 					int
+	ProjectID 				int
+	Role 					string
+}
+
+type RevokeProjectRequest struct {
+	TeamID 					int
+	ProjectID 				int
+}
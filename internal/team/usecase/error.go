@@ -0,0 +1,18 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrTeamNotFound				= errors.New("team not found")
+	ErrNameRequired				= errors.New("a team needs a name")
+	ErrLeadNotFound				= errors.New("team lead not found")
+	ErrUserNotFound				= errors.New("user not found")
+	ErrMemberNotFound			= errors.New("user is not a member of the team")
+	ErrMemberAlreadyExists		= errors.New("user is already a member of the team")
+	ErrLeadCannotLeave			= errors.New("the team lead cannot leave the team")
+	ErrInvalidRole				= errors.New("invalid project role")
+	ErrGrantNotFound			= errors.New("team has no role on the project")
+	ErrForbidden				= errors.New("only admins and the team lead can manage the team")
+	ErrViewForbidden			= errors.New("only admins, managers and team members can see the team")
+	ErrActorRequired			= errors.New("an acting user is required")
+)
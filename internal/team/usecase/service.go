@@ -0,0 +1,439 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	"github.com/captainhbb/tbs-backend/internal/team/domain"
+	"github.com/captainhbb/tbs-backend/internal/team/ports"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+)
+
+//go:generate mockery --dir . --name TeamService --structname MockTeamService --filename mock_team_service.go --output ./mock --outpkg mock
+type TeamService interface {
+	// CreateTeam is restricted to global admins and managers. The lead becomes
+	// the team's first member.
+	CreateTeam(ctx context.Context, team CreateTeamRequest) (domain.Team, error)
+	// GetTeam and ListTeamMembers are restricted to global admins, managers and
+	// the team's members.
+	GetTeam(ctx context.Context, id int) (domain.Team, error)
+	// UpdateTeam, DeleteTeam, AddTeamMember and RemoveTeamMember are restricted
+	// to global admins and the team lead.
+	UpdateTeam(ctx context.Context, team UpdateTeamRequest) (domain.Team, error)
+	// DeleteTeam revokes the team's grants, withdrawing every project membership
+	// held through them, before deleting it.
+	DeleteTeam(ctx context.Context, id int) error
+	// ListTeams returns every team to global admins and managers, and the teams
+	// they belong to to other users.
+	ListTeams(ctx context.Context) ([]domain.Team, error)
+	// AddTeamMember and RemoveTeamMember bring the user's project memberships in
+	// line with the team's grants. Adding a member hands out the team's roles,
+	// so it also needs the manage members permission on every granted project.
+	AddTeamMember(ctx context.Context, member TeamMemberRequest) error
+	RemoveTeamMember(ctx context.Context, member TeamMemberRequest) error
+	ListTeamMembers(ctx context.Context, teamID int) ([]userDomain.User, error)
+	// GrantProject and RevokeProject need the manage members permission on the
+	// project and sync the membership of every team member.
+	GrantProject(ctx context.Context, grant GrantProjectRequest) (domain.Grant, error)
+	RevokeProject(ctx context.Context, grant RevokeProjectRequest) error
+	ListProjectGrants(ctx context.Context, projectID int) ([]domain.Grant, error)
+}
+
+type teamService struct {
+	repo ports.Repository
+	userService userUseCase.UserService
+	membershipService membershipUseCase.MembershipService
+}
+
+func New(repo ports.Repository, userService userUseCase.UserService, membershipService membershipUseCase.MembershipService) TeamService {
+	return &teamService{
+		repo: repo,
+		userService: userService,
+		membershipService: membershipService,
+	}
+}
+
+func(s *teamService) CreateTeam(ctx context.Context, createTeamRequest CreateTeamRequest) (domain.Team, error) {
+	name := strings.TrimSpace(createTeamRequest.Name)
+	if name == "" {
+		return domain.Team{}, ErrNameRequired
+	}
+
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin, userDomain.RoleManager)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return domain.Team{}, ErrForbidden
+	default:
+		return domain.Team{}, err
+	}
+	err = s.checkUser(ctx, createTeamRequest.LeadID, ErrLeadNotFound)
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	createdTeam, err := s.repo.CreateTeam(ctx, domain.Team{
+		Name: name,
+		LeadID: createTeamRequest.LeadID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	_, err = s.repo.AddMember(ctx, domain.Member{
+		TeamID: createdTeam.ID,
+		UserID: createdTeam.LeadID,
+		AddedAt: createdTeam.CreatedAt,
+	})
+	if err != nil {
+		return domain.Team{}, err
+	}
+	return createdTeam, nil
+}
+
+func(s *teamService) GetTeam(ctx context.Context, id int) (domain.Team, error) {
+	return s.authorizeView(ctx, id)
+}
+
+func(s *teamService) UpdateTeam(ctx context.Context, updateTeamRequest UpdateTeamRequest) (domain.Team, error) {
+	name := strings.TrimSpace(updateTeamRequest.Name)
+	if name == "" {
+		return domain.Team{}, ErrNameRequired
+	}
+
+	team, err := s.authorize(ctx, updateTeamRequest.ID)
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	if updateTeamRequest.LeadID != team.LeadID {
+		err = s.checkUser(ctx, updateTeamRequest.LeadID, ErrLeadNotFound)
+		if err != nil {
+			return domain.Team{}, err
+		}
+		err = s.addMember(ctx, team.ID, updateTeamRequest.LeadID)
+		switch err {
+		case nil, ErrMemberAlreadyExists:
+		default:
+			return domain.Team{}, err
+		}
+	}
+
+	team.Name = name
+	team.LeadID = updateTeamRequest.LeadID
+	updatedTeam, err := s.repo.UpdateTeam(ctx, team)
+	switch err {
+	case ports.ErrTeamNotFound:
+		return domain.Team{}, ErrTeamNotFound
+	}
+	return updatedTeam, err
+}
+
+func(s *teamService) DeleteTeam(ctx context.Context, id int) error {
+	_, err := s.authorize(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Each grant is revoked and synced on its own, so a failure leaves the team
+	// with the remaining grants and every membership in line with them.
+	grants, err := s.repo.ListGrants(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, grant := range grants {
+		err = s.repo.RemoveGrant(ctx, id, grant.ProjectID)
+		switch err {
+		case nil, ports.ErrGrantNotFound:
+		default:
+			return err
+		}
+		err = s.syncProject(ctx, id, grant.ProjectID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.repo.DeleteTeam(ctx, id)
+	switch err {
+	case ports.ErrTeamNotFound:
+		return ErrTeamNotFound
+	}
+	return err
+}
+
+func(s *teamService) ListTeams(ctx context.Context) ([]domain.Team, error) {
+	actorID, seesAll, err := s.viewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if seesAll {
+		return s.repo.ListTeams(ctx)
+	}
+	return s.repo.ListTeamsByUser(ctx, actorID)
+}
+
+func(s *teamService) AddTeamMember(ctx context.Context, teamMemberRequest TeamMemberRequest) error {
+	_, err := s.authorize(ctx, teamMemberRequest.TeamID)
+	if err != nil {
+		return err
+	}
+	err = s.checkUser(ctx, teamMemberRequest.UserID, ErrUserNotFound)
+	if err != nil {
+		return err
+	}
+	return s.addMember(ctx, teamMemberRequest.TeamID, teamMemberRequest.UserID)
+}
+
+func(s *teamService) RemoveTeamMember(ctx context.Context, teamMemberRequest TeamMemberRequest) error {
+	team, err := s.authorize(ctx, teamMemberRequest.TeamID)
+	if err != nil {
+		return err
+	}
+	if team.LeadID == teamMemberRequest.UserID {
+		return ErrLeadCannotLeave
+	}
+
+	err = s.repo.RemoveMember(ctx, teamMemberRequest.TeamID, teamMemberRequest.UserID)
+	switch err {
+	case nil:
+	case ports.ErrMemberNotFound:
+		return ErrMemberNotFound
+	default:
+		return err
+	}
+	return s.syncUser(ctx, teamMemberRequest.TeamID, teamMemberRequest.UserID)
+}
+
+func(s *teamService) ListTeamMembers(ctx context.Context, teamID int) ([]userDomain.User, error) {
+	_, err := s.authorizeView(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.ListMembers(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]userDomain.User, 0, len(members))
+	for _, member := range members {
+		user, err := s.userService.GetUser(ctx, member.UserID)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func(s *teamService) GrantProject(ctx context.Context, grantProjectRequest GrantProjectRequest) (domain.Grant, error) {
+	if !membershipDomain.IsValidRole(grantProjectRequest.Role) {
+		return domain.Grant{}, ErrInvalidRole
+	}
+
+	err := s.membershipService.Authorize(ctx, grantProjectRequest.ProjectID, membershipDomain.PermissionManageMembers)
+	if err != nil {
+		return domain.Grant{}, err
+	}
+	_, err = s.getTeam(ctx, grantProjectRequest.TeamID)
+	if err != nil {
+		return domain.Grant{}, err
+	}
+
+	grant, err := s.repo.SaveGrant(ctx, domain.Grant{
+		TeamID: grantProjectRequest.TeamID,
+		ProjectID: grantProjectRequest.ProjectID,
+		Role: grantProjectRequest.Role,
+		GrantedAt: time.Now(),
+	})
+	if err != nil {
+		return domain.Grant{}, err
+	}
+
+	err = s.syncProject(ctx, grant.TeamID, grant.ProjectID)
+	if err != nil {
+		return domain.Grant{}, err
+	}
+	return grant, nil
+}
+
+func(s *teamService) RevokeProject(ctx context.Context, revokeProjectRequest RevokeProjectRequest) error {
+	err := s.membershipService.Authorize(ctx, revokeProjectRequest.ProjectID, membershipDomain.PermissionManageMembers)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.RemoveGrant(ctx, revokeProjectRequest.TeamID, revokeProjectRequest.ProjectID)
+	switch err {
+	case nil:
+	case ports.ErrGrantNotFound:
+		return ErrGrantNotFound
+	default:
+		return err
+	}
+	return s.syncProject(ctx, revokeProjectRequest.TeamID, revokeProjectRequest.ProjectID)
+}
+
+func(s *teamService) ListProjectGrants(ctx context.Context, projectID int) ([]domain.Grant, error) {
+	err := s.membershipService.Authorize(ctx, projectID, membershipDomain.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListProjectGrants(ctx, projectID)
+}
+
+// addMember checks that the acting user may hand out the team's roles before
+// adding the member.
+func(s *teamService) addMember(ctx context.Context, teamID int, userID int) error {
+	grants, err := s.repo.ListGrants(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	for _, grant := range grants {
+		err = s.membershipService.Authorize(ctx, grant.ProjectID, membershipDomain.PermissionManageMembers)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = s.repo.AddMember(ctx, domain.Member{
+		TeamID: teamID,
+		UserID: userID,
+		AddedAt: time.Now(),
+	})
+	switch err {
+	case nil:
+	case ports.ErrMemberAlreadyExists:
+		return ErrMemberAlreadyExists
+	default:
+		return err
+	}
+	return s.syncUser(ctx, teamID, userID)
+}
+
+// syncUser brings the user's membership of every project the team is granted
+// in line with their teams.
+func(s *teamService) syncUser(ctx context.Context, teamID int, userID int) error {
+	grants, err := s.repo.ListGrants(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	for _, grant := range grants {
+		err = s.membershipService.SyncMember(ctx, grant.ProjectID, userID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncProject brings the project membership of every member of the team in
+// line with their teams.
+func(s *teamService) syncProject(ctx context.Context, teamID int, projectID int) error {
+	members, err := s.repo.ListMembers(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		err = s.membershipService.SyncMember(ctx, projectID, member.UserID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// authorize loads the team and checks that the acting user leads it or is a
+// global admin.
+func(s *teamService) authorize(ctx context.Context, teamID int) (domain.Team, error) {
+	team, err := s.getTeam(ctx, teamID)
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	actorID, ok := actor.IDFromContext(ctx)
+	if ok && team.LeadID == actorID {
+		return team, nil
+	}
+	err = s.userService.RequireRole(ctx, userDomain.RoleAdmin)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return domain.Team{}, ErrForbidden
+	default:
+		return domain.Team{}, err
+	}
+	return team, nil
+}
+
+// authorizeView loads the team and checks that the acting user may see it.
+func(s *teamService) authorizeView(ctx context.Context, teamID int) (domain.Team, error) {
+	team, err := s.getTeam(ctx, teamID)
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	actorID, seesAll, err := s.viewer(ctx)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	if seesAll {
+		return team, nil
+	}
+	teams, err := s.repo.ListTeamsByUser(ctx, actorID)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	for _, memberOf := range teams {
+		if memberOf.ID == teamID {
+			return team, nil
+		}
+	}
+	return domain.Team{}, ErrViewForbidden
+}
+
+// viewer returns the acting user and whether they see every team, which the
+// system, global admins and managers do.
+func(s *teamService) viewer(ctx context.Context) (int, bool, error) {
+	if actor.IsSystem(ctx) {
+		return 0, true, nil
+	}
+	actorID, ok := actor.IDFromContext(ctx)
+	if !ok {
+		return 0, false, ErrActorRequired
+	}
+
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin, userDomain.RoleManager)
+	switch err {
+	case nil:
+		return actorID, true, nil
+	case userUseCase.ErrForbidden:
+		return actorID, false, nil
+	}
+	return 0, false, err
+}
+
+func(s *teamService) checkUser(ctx context.Context, userID int, notFound error) error {
+	_, err := s.userService.GetUser(ctx, userID)
+	switch err {
+	case userUseCase.ErrUserNotFound:
+		return notFound
+	}
+	return err
+}
+
+func(s *teamService) getTeam(ctx context.Context, id int) (domain.Team, error) {
+	team, err := s.repo.GetTeam(ctx, id)
+	switch err {
+	case ports.ErrTeamNotFound:
+		return domain.Team{}, ErrTeamNotFound
+	}
+	return team, err
+}
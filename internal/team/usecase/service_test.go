@@ -0,0 +1,394 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	"github.com/captainhbb/tbs-backend/internal/team/domain"
+	"github.com/captainhbb/tbs-backend/internal/team/ports"
+	portsMock "github.com/captainhbb/tbs-backend/internal/team/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/team/usecase"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var errConnectionReset = errors.New("connection reset")
+
+type teamMocks struct {
+	repo *portsMock.MockRepository
+	userService *userUseCaseMock.MockUserService
+	membershipService *membershipUseCaseMock.MockMembershipService
+}
+
+// newTeamService wires the service with user 1 as an admin, user 2 as a
+// manager and users 3 to 5 as regular users. Team 7 is led by user 3.
+func newTeamService(t *testing.T) (usecase.TeamService, teamMocks) {
+	m := teamMocks{
+		repo: portsMock.NewMockRepository(t),
+		userService: userUseCaseMock.NewMockUserService(t),
+		membershipService: membershipUseCaseMock.NewMockMembershipService(t),
+	}
+	roles := map[int]string{1: userDomain.RoleAdmin, 2: userDomain.RoleManager}
+	requireRole := func(ctx context.Context, allowed ...string) error {
+		actorID, _ := actor.IDFromContext(ctx)
+		if !slices.Contains(allowed, roles[actorID]) {
+			return userUseCase.ErrForbidden
+		}
+		return nil
+	}
+	m.userService.On("RequireRole", mock.Anything, userDomain.RoleAdmin).Return(requireRole).Maybe()
+	m.userService.On("RequireRole", mock.Anything, userDomain.RoleAdmin, userDomain.RoleManager).Return(requireRole).Maybe()
+	m.userService.On("GetUser", mock.Anything, 1).Return(userDomain.User{ID: 1, Role: userDomain.RoleAdmin}, nil).Maybe()
+	m.userService.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2, Role: userDomain.RoleManager}, nil).Maybe()
+	for _, userID := range []int{3, 4, 5} {
+		m.userService.On("GetUser", mock.Anything, userID).Return(userDomain.User{ID: userID}, nil).Maybe()
+	}
+	m.userService.On("GetUser", mock.Anything, 9).Return(userDomain.User{}, userUseCase.ErrUserNotFound).Maybe()
+	m.repo.On("GetTeam", mock.Anything, 7).Return(domain.Team{ID: 7, Name: "Platform", LeadID: 3}, nil).Maybe()
+	m.repo.On("GetTeam", mock.Anything, 8).Return(domain.Team{}, ports.ErrTeamNotFound).Maybe()
+
+	return usecase.New(m.repo, m.userService, m.membershipService), m
+}
+
+func TestCreateTeam(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name			string
+		actorID			int
+		input			usecase.CreateTeamRequest
+		mockSetup		func(m teamMocks)
+		expectedError	error
+	}{
+		{
+			name: "manager creates a team led by a member",
+			actorID: 2,
+			input: usecase.CreateTeamRequest{Name: " Platform ", LeadID: 3},
+			mockSetup: func(m teamMocks) {
+				m.repo.On("CreateTeam", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.Team)
+					require.Equal(t, "Platform", capturedArg.Name)
+				}).Return(domain.Team{ID: 7, Name: "Platform", LeadID: 3}, nil)
+				m.repo.On("AddMember", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.Member)
+					require.Equal(t, 7, capturedArg.TeamID)
+					require.Equal(t, 3, capturedArg.UserID)
+				}).Return(domain.Member{}, nil)
+			},
+		},
+		{
+			name: "regular users cannot create teams",
+			actorID: 4,
+			input: usecase.CreateTeamRequest{Name: "Platform", LeadID: 3},
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "lead must exist",
+			actorID: 1,
+			input: usecase.CreateTeamRequest{Name: "Platform", LeadID: 9},
+			expectedError: usecase.ErrLeadNotFound,
+		},
+		{
+			name: "name is required",
+			actorID: 1,
+			input: usecase.CreateTeamRequest{Name: "  ", LeadID: 3},
+			expectedError: usecase.ErrNameRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, m := newTeamService(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(m)
+			}
+
+			_, err := service.CreateTeam(actor.WithID(context.Background(), tt.actorID), tt.input)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestTeamMembership(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name			string
+		actorID			int
+		call			func(service usecase.TeamService, ctx context.Context) error
+		mockSetup		func(m teamMocks)
+		expectedError	error
+	}{
+		{
+			name: "joining syncs every project granted to the team",
+			actorID: 3,
+			call: func(service usecase.TeamService, ctx context.Context) error {
+				return service.AddTeamMember(ctx, usecase.TeamMemberRequest{TeamID: 7, UserID: 4})
+			},
+			mockSetup: func(m teamMocks) {
+				m.repo.On("AddMember", mock.Anything, mock.Anything).Return(domain.Member{TeamID: 7, UserID: 4}, nil)
+				m.repo.On("ListGrants", mock.Anything, 7).Return([]domain.Grant{{TeamID: 7, ProjectID: 10}, {TeamID: 7, ProjectID: 11}}, nil)
+				m.membershipService.On("Authorize", mock.Anything, 10, membershipDomain.PermissionManageMembers).Return(nil)
+				m.membershipService.On("Authorize", mock.Anything, 11, membershipDomain.PermissionManageMembers).Return(nil)
+				m.membershipService.On("SyncMember", mock.Anything, 10, 4).Return(nil).Once()
+				m.membershipService.On("SyncMember", mock.Anything, 11, 4).Return(nil).Once()
+			},
+		},
+		{
+			name: "the lead needs to manage the members of every granted project",
+			actorID: 3,
+			call: func(service usecase.TeamService, ctx context.Context) error {
+				return service.AddTeamMember(ctx, usecase.TeamMemberRequest{TeamID: 7, UserID: 4})
+			},
+			mockSetup: func(m teamMocks) {
+				m.repo.On("ListGrants", mock.Anything, 7).Return([]domain.Grant{{TeamID: 7, ProjectID: 10}, {TeamID: 7, ProjectID: 11}}, nil)
+				m.membershipService.On("Authorize", mock.Anything, 10, membershipDomain.PermissionManageMembers).Return(nil)
+				m.membershipService.On("Authorize", mock.Anything, 11, membershipDomain.PermissionManageMembers).Return(membershipUseCase.ErrForbidden)
+			},
+			expectedError: membershipUseCase.ErrForbidden,
+		},
+		{
+			name: "leaving syncs every project granted to the team",
+			actorID: 1,
+			call: func(service usecase.TeamService, ctx context.Context) error {
+				return service.RemoveTeamMember(ctx, usecase.TeamMemberRequest{TeamID: 7, UserID: 4})
+			},
+			mockSetup: func(m teamMocks) {
+				m.repo.On("RemoveMember", mock.Anything, 7, 4).Return(nil)
+				m.repo.On("ListGrants", mock.Anything, 7).Return([]domain.Grant{{TeamID: 7, ProjectID: 10}}, nil)
+				m.membershipService.On("SyncMember", mock.Anything, 10, 4).Return(nil).Once()
+			},
+		},
+		{
+			name: "only the lead and admins manage members",
+			actorID: 4,
+			call: func(service usecase.TeamService, ctx context.Context) error {
+				return service.AddTeamMember(ctx, usecase.TeamMemberRequest{TeamID: 7, UserID: 5})
+			},
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "the lead cannot leave",
+			actorID: 1,
+			call: func(service usecase.TeamService, ctx context.Context) error {
+				return service.RemoveTeamMember(ctx, usecase.TeamMemberRequest{TeamID: 7, UserID: 3})
+			},
+			expectedError: usecase.ErrLeadCannotLeave,
+		},
+		{
+			name: "already a member",
+			actorID: 3,
+			call: func(service usecase.TeamService, ctx context.Context) error {
+				return service.AddTeamMember(ctx, usecase.TeamMemberRequest{TeamID: 7, UserID: 4})
+			},
+			mockSetup: func(m teamMocks) {
+				m.repo.On("ListGrants", mock.Anything, 7).Return(nil, nil)
+				m.repo.On("AddMember", mock.Anything, mock.Anything).Return(domain.Member{}, ports.ErrMemberAlreadyExists)
+			},
+			expectedError: usecase.ErrMemberAlreadyExists,
+		},
+		{
+			name: "unknown team",
+			actorID: 1,
+			call: func(service usecase.TeamService, ctx context.Context) error {
+				return service.AddTeamMember(ctx, usecase.TeamMemberRequest{TeamID: 8, UserID: 4})
+			},
+			expectedError: usecase.ErrTeamNotFound,
+		},
+		{
+			name: "deleting withdraws the memberships held through the team",
+			actorID: 3,
+			call: func(service usecase.TeamService, ctx context.Context) error {
+				return service.DeleteTeam(ctx, 7)
+			},
+			mockSetup: func(m teamMocks) {
+				m.repo.On("ListGrants", mock.Anything, 7).Return([]domain.Grant{{TeamID: 7, ProjectID: 10}}, nil)
+				m.repo.On("RemoveGrant", mock.Anything, 7, 10).Return(nil).Once()
+				m.repo.On("ListMembers", mock.Anything, 7).Return([]domain.Member{{TeamID: 7, UserID: 3}, {TeamID: 7, UserID: 4}}, nil)
+				m.membershipService.On("SyncMember", mock.Anything, 10, 3).Return(nil).Once()
+				m.membershipService.On("SyncMember", mock.Anything, 10, 4).Return(nil).Once()
+				m.repo.On("DeleteTeam", mock.Anything, 7).Return(nil).Once()
+			},
+		},
+		{
+			name: "deleting keeps the team when a membership cannot be withdrawn",
+			actorID: 3,
+			call: func(service usecase.TeamService, ctx context.Context) error {
+				return service.DeleteTeam(ctx, 7)
+			},
+			mockSetup: func(m teamMocks) {
+				m.repo.On("ListGrants", mock.Anything, 7).Return([]domain.Grant{{TeamID: 7, ProjectID: 10}}, nil)
+				m.repo.On("RemoveGrant", mock.Anything, 7, 10).Return(nil).Once()
+				m.repo.On("ListMembers", mock.Anything, 7).Return([]domain.Member{{TeamID: 7, UserID: 4}}, nil)
+				m.membershipService.On("SyncMember", mock.Anything, 10, 4).Return(errConnectionReset).Once()
+			},
+			expectedError: errConnectionReset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, m := newTeamService(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(m)
+			}
+
+			err := tt.call(service, actor.WithID(context.Background(), tt.actorID))
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestViewTeam(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name			string
+		ctx				context.Context
+		mockSetup		func(m teamMocks)
+		expectedError	error
+	}{
+		{
+			name: "managers see every team",
+			ctx: actor.WithID(context.Background(), 2),
+		},
+		{
+			name: "members see their team",
+			ctx: actor.WithID(context.Background(), 4),
+			mockSetup: func(m teamMocks) {
+				m.repo.On("ListTeamsByUser", mock.Anything, 4).Return([]domain.Team{{ID: 7}}, nil)
+			},
+		},
+		{
+			name: "other users do not",
+			ctx: actor.WithID(context.Background(), 5),
+			mockSetup: func(m teamMocks) {
+				m.repo.On("ListTeamsByUser", mock.Anything, 5).Return([]domain.Team{{ID: 9}}, nil)
+			},
+			expectedError: usecase.ErrViewForbidden,
+		},
+		{
+			name: "no acting user",
+			ctx: context.Background(),
+			expectedError: usecase.ErrActorRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, m := newTeamService(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(m)
+			}
+			m.repo.On("ListMembers", mock.Anything, 7).Return([]domain.Member{{TeamID: 7, UserID: 3}}, nil).Maybe()
+
+			_, err := service.GetTeam(tt.ctx, 7)
+			require.ErrorIs(t, err, tt.expectedError)
+			_, err = service.ListTeamMembers(tt.ctx, 7)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestListTeamsShowsUsersTheirOwn(t *testing.T) {
+	t.Parallel()
+
+	service, m := newTeamService(t)
+	m.repo.On("ListTeamsByUser", mock.Anything, 4).Return([]domain.Team{{ID: 7}}, nil).Once()
+
+	teams, err := service.ListTeams(actor.WithID(context.Background(), 4))
+	require.NoError(t, err)
+	require.Len(t, teams, 1)
+	require.Equal(t, 7, teams[0].ID)
+}
+
+func TestGrantProject(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name			string
+		input			usecase.GrantProjectRequest
+		mockSetup		func(m teamMocks)
+		expectedError	error
+	}{
+		{
+			name: "grants the role to every member",
+			input: usecase.GrantProjectRequest{TeamID: 7, ProjectID: 10, Role: membershipDomain.RoleContributor},
+			mockSetup: func(m teamMocks) {
+				m.membershipService.On("Authorize", mock.Anything, 10, membershipDomain.PermissionManageMembers).Return(nil)
+				m.repo.On("SaveGrant", mock.Anything, mock.Anything).Return(func(_ context.Context, grant domain.Grant) (domain.Grant, error) {
+					return grant, nil
+				})
+				m.repo.On("ListMembers", mock.Anything, 7).Return([]domain.Member{{TeamID: 7, UserID: 3}, {TeamID: 7, UserID: 4}}, nil)
+				m.membershipService.On("SyncMember", mock.Anything, 10, 3).Return(nil).Once()
+				m.membershipService.On("SyncMember", mock.Anything, 10, 4).Return(nil).Once()
+			},
+		},
+		{
+			name: "invalid role",
+			input: usecase.GrantProjectRequest{TeamID: 7, ProjectID: 10, Role: "superhero"},
+			expectedError: usecase.ErrInvalidRole,
+		},
+		{
+			name: "needs to manage the project's members",
+			input: usecase.GrantProjectRequest{TeamID: 7, ProjectID: 10, Role: membershipDomain.RoleViewer},
+			mockSetup: func(m teamMocks) {
+				m.membershipService.On("Authorize", mock.Anything, 10, membershipDomain.PermissionManageMembers).Return(membershipUseCase.ErrForbidden)
+			},
+			expectedError: membershipUseCase.ErrForbidden,
+		},
+		{
+			name: "unknown team",
+			input: usecase.GrantProjectRequest{TeamID: 8, ProjectID: 10, Role: membershipDomain.RoleViewer},
+			mockSetup: func(m teamMocks) {
+				m.membershipService.On("Authorize", mock.Anything, 10, membershipDomain.PermissionManageMembers).Return(nil)
+			},
+			expectedError: usecase.ErrTeamNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, m := newTeamService(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(m)
+			}
+
+			grant, err := service.GrantProject(actor.WithID(context.Background(), 4), tt.input)
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				require.Equal(t, tt.input.Role, grant.Role)
+			}
+		})
+	}
+}
+
+func TestRevokeProject(t *testing.T) {
+	t.Parallel()
+
+	service, m := newTeamService(t)
+	m.membershipService.On("Authorize", mock.Anything, 10, membershipDomain.PermissionManageMembers).Return(nil)
+	m.repo.On("RemoveGrant", mock.Anything, 7, 10).Return(nil)
+	m.repo.On("ListMembers", mock.Anything, 7).Return([]domain.Member{{TeamID: 7, UserID: 4}}, nil)
+	m.membershipService.On("SyncMember", mock.Anything, 10, 4).Return(nil).Once()
+
+	err := service.RevokeProject(actor.WithID(context.Background(), 3), usecase.RevokeProjectRequest{TeamID: 7, ProjectID: 10})
+	require.NoError(t, err)
+}
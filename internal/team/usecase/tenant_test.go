@@ -0,0 +1,105 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	membershipScoped "github.com/captainhbb/tbs-backend/internal/membership/adapters/scoped"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipPortsMock "github.com/captainhbb/tbs-backend/internal/membership/ports/mock"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	portfolioScoped "github.com/captainhbb/tbs-backend/internal/portfolio/adapters/scoped"
+	portfolioPortsMock "github.com/captainhbb/tbs-backend/internal/portfolio/ports/mock"
+	projectScoped "github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/team/adapters/scoped"
+	"github.com/captainhbb/tbs-backend/internal/team/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/team/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/team/usecase"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTenantTeamService wires the team service to scoped repositories and the
+// real membership service. Project 1 and team 7 belong to tenant 1, project 2
+// and team 17 to tenant 2, and user 1 administers tenant 1.
+func newTenantTeamService(t *testing.T) usecase.TeamService {
+	repoMock := portsMock.NewMockRepository(t)
+	projectRepoMock := projectPortsMock.NewMockRepository(t)
+	userServiceMock := userUseCaseMock.NewMockUserService(t)
+
+	projectRepoMock.On("GetProject", mock.Anything, 1).Return(projectDomain.Project{ID: 1, TenantID: 1, OwnerID: 3}, nil).Maybe()
+	projectRepoMock.On("GetProject", mock.Anything, 2).Return(projectDomain.Project{ID: 2, TenantID: 2, OwnerID: 4}, nil).Maybe()
+	repoMock.On("GetTeam", mock.Anything, 7).Return(domain.Team{ID: 7, TenantID: 1, Name: "Platform", LeadID: 3}, nil).Maybe()
+	repoMock.On("GetTeam", mock.Anything, 17).Return(domain.Team{ID: 17, TenantID: 2, Name: "Billing", LeadID: 4}, nil).Maybe()
+	repoMock.On("ListTeams", mock.Anything).Return([]domain.Team{{ID: 7, TenantID: 1}, {ID: 17, TenantID: 2}}, nil).Maybe()
+	userServiceMock.On("GetUser", mock.Anything, 1).Return(userDomain.User{ID: 1, TenantID: 1, Role: userDomain.RoleAdmin}, nil).Maybe()
+	userServiceMock.On("RequireRole", mock.Anything, userDomain.RoleAdmin, userDomain.RoleManager).Return(nil).Maybe()
+
+	projectRepo := projectScoped.New(projectRepoMock)
+	teamRepo := scoped.New(repoMock, projectRepoMock)
+	membershipService := membershipUseCase.New(
+		membershipScoped.New(membershipPortsMock.NewMockRepository(t), projectRepoMock),
+		projectRepo,
+		userServiceMock,
+		portfolioScoped.New(portfolioPortsMock.NewMockRepository(t)),
+		teamRepo,
+	)
+	return usecase.New(teamRepo, userServiceMock, membershipService)
+}
+
+func TestGrantProjectAcrossTenants(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name			string
+		input			usecase.GrantProjectRequest
+		expectedError	error
+	}{
+		{
+			name: "project of another tenant",
+			input: usecase.GrantProjectRequest{TeamID: 7, ProjectID: 2, Role: membershipDomain.RoleViewer},
+			expectedError: membershipUseCase.ErrProjectNotFound,
+		},
+		{
+			name: "team of another tenant",
+			input: usecase.GrantProjectRequest{TeamID: 17, ProjectID: 1, Role: membershipDomain.RoleViewer},
+			expectedError: usecase.ErrTeamNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := newTenantTeamService(t)
+
+			_, err := service.GrantProject(tenant.WithID(actor.WithID(context.Background(), 1), 1), tt.input)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestTeamsOfAnotherTenantAreHidden(t *testing.T) {
+	t.Parallel()
+
+	service := newTenantTeamService(t)
+	ctx := tenant.WithID(actor.WithID(context.Background(), 1), 1)
+
+	teams, err := service.ListTeams(ctx)
+	require.NoError(t, err)
+	require.Len(t, teams, 1)
+	require.Equal(t, 7, teams[0].ID)
+
+	_, err = service.GetTeam(ctx, 17)
+	require.ErrorIs(t, err, usecase.ErrTeamNotFound)
+
+	teams, err = service.ListTeams(actor.WithID(context.Background(), 1))
+	require.NoError(t, err)
+	require.Empty(t, teams)
+}
@@ -0,0 +1,77 @@
+// Package scoped confines a template repository to the tenant carried in the
+// request context. Templates of other tenants are reported as not found.
+// Platform calls see every tenant, and requests carrying neither see no
+// templates at all.
+package scoped
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/template/domain"
+	"github.com/captainhbb/tbs-backend/internal/template/ports"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+)
+
+type repository struct {
+	next		ports.Repository
+}
+
+// New wraps next so that every query is filtered by the tenant in ctx and new
+// templates are created within it, which fails with tenant.ErrTenantRequired
+// when ctx carries none.
+func New(next ports.Repository) ports.Repository {
+	return &repository{next: next}
+}
+
+func(r *repository) CreateTemplate(ctx context.Context, template domain.Template) (domain.Template, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return domain.Template{}, err
+	}
+	template.TenantID = tenantID
+	return r.next.CreateTemplate(ctx, template)
+}
+
+func(r *repository) GetTemplate(ctx context.Context, id int) (domain.Template, error) {
+	template, err := r.next.GetTemplate(ctx, id)
+	if err != nil {
+		return domain.Template{}, err
+	}
+	if !tenant.Visible(ctx, template.TenantID) {
+		return domain.Template{}, ports.ErrTemplateNotFound
+	}
+	return template, nil
+}
+
+// UpdateTemplate keeps the template in the tenant it belongs to.
+func(r *repository) UpdateTemplate(ctx context.Context, template domain.Template) (domain.Template, error) {
+	existingTemplate, err := r.GetTemplate(ctx, template.ID)
+	if err != nil {
+		return domain.Template{}, err
+	}
+	template.TenantID = existingTemplate.TenantID
+	return r.next.UpdateTemplate(ctx, template)
+}
+
+func(r *repository) DeleteTemplate(ctx context.Context, id int) error {
+	_, err := r.GetTemplate(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeleteTemplate(ctx, id)
+}
+
+func(r *repository) ListTemplates(ctx context.Context) ([]domain.Template, error) {
+	templates, err := r.next.ListTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]domain.Template, 0, len(templates))
+	for _, template := range templates {
+		if tenant.Visible(ctx, template.TenantID) {
+			visible = append(visible, template)
+		}
+	}
+	return visible, nil
+}
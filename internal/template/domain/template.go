@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+// Template is the blueprint of a project. Dates are offsets from the start of
+// the project created from it, and tasks are referred to by their position in
+// Tasks counting from 1.
+type Template struct {
+	ID 					int
+	TenantID			int
+	Name				string
+	Description			string
+	// Duration is the default time from the project's start to its end. Zero
+	// leaves the project without an end date.
+	Duration			time.Duration
+	LineItems			[]LineItem
+	Tasks				[]Task
+	Dependencies		[]Dependency
+	Milestones			[]Milestone
+	CreatedBy			int
+	CreatedAt			time.Time
+}
+
+type LineItem struct {
+	Category			string
+	Planned				money.Money
+	Notes				string
+}
+
+type Task struct {
+	// Parent is the position of the parent task, which comes earlier in the
+	// template, or zero for a root task.
+	Parent				int
+	Name				string
+	Description			string
+	Estimate			time.Duration
+	// HasDueDate is unset for tasks without a due date; otherwise the task is
+	// due DueOffset after the project start.
+	HasDueDate			bool
+	DueOffset			time.Duration
+}
+
+type Dependency struct {
+	Predecessor			int
+	Successor			int
+	Type				string
+	Lag					time.Duration
+}
+
+type Milestone struct {
+	Name				string
+	Description			string
+	Deliverables		[]string
+	Tasks				[]int
+	TargetOffset		time.Duration
+}
+
+// Budget sums the planned amounts of the line items. It is zero and has no
+// currency when there are none.
+func (t Template) Budget() (money.Money, error) {
+	if len(t.LineItems) == 0 {
+		return money.Money{}, nil
+	}
+
+	total, err := money.Zero(t.LineItems[0].Planned.Currency())
+	if err != nil {
+		return money.Money{}, err
+	}
+	for _, item := range t.LineItems {
+		total, err = total.Add(item.Planned)
+		if err != nil {
+			return money.Money{}, err
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,7 @@
+package ports
+
+import "errors"
+
+var (
+	ErrTemplateNotFound			= errors.New("template not found")
+)
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/template/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	CreateTemplate(ctx context.Context, template domain.Template) (domain.Template, error)
+	GetTemplate(ctx context.Context, id int) (domain.Template, error)
+	UpdateTemplate(ctx context.Context, template domain.Template) (domain.Template, error)
+	DeleteTemplate(ctx context.Context, id int) error
+	ListTemplates(ctx context.Context) ([]domain.Template, error)
+}
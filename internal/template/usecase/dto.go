@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/template/domain"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+)
+
+type CreateTemplateRequest struct {
+	Name 					string
+	Description 			string
+	Duration 				time.Duration
+	LineItems 				[]domain.LineItem
+	Tasks 					[]domain.Task
+	Dependencies 			[]domain.Dependency
+	Milestones 				[]domain.Milestone
+}
+
+type UpdateTemplateRequest struct {
+	ID 						int
+	Name 					string
+	Description 			string
+	Duration 				time.Duration
+	LineItems 				[]domain.LineItem
+	Tasks 					[]domain.Task
+	Dependencies 			[]domain.Dependency
+	Milestones 				[]domain.Milestone
+}
+
+// CreateProjectFromTemplateRequest creates a project starting on StartDate,
+// with every date of the template shifted onto it.
+type CreateProjectFromTemplateRequest struct {
+	TemplateID 				int
+	// Name defaults to the template's name.
+	Name 					string
+	OwnerID 				int
+	StartDate 				time.Time
+	// ProposedBudget defaults to the sum of the template's line items.
+	ProposedBudget 			money.Money
+	Status 					string
+	Tags 					[]string
+	CustomFields 			map[string]string
+}
+
+// CloneProjectRequest copies the project with its tags, custom fields, line
+// items, tasks, dependencies and milestones. Progress, assignees, completions and reviews
+// are not copied.
+type CloneProjectRequest struct {
+	ProjectID 				int
+	// Name, StartDate and Status default to those of the project cloned. A
+	// different StartDate shifts every date of the copy by the same amount.
+	Name 					string
+	OwnerID 				int
+	StartDate 				time.Time
+	Status 					string
+}
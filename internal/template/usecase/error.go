@@ -0,0 +1,20 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrTemplateNotFound			= errors.New("template not found")
+	ErrNameRequired				= errors.New("templates and their tasks and milestones need a name")
+	ErrInvalidDuration			= errors.New("duration cannot be negative")
+	ErrOutsideDuration			= errors.New("task and milestone dates must fall within the template's duration")
+	ErrInvalidCategory			= errors.New("invalid budget category")
+	ErrInvalidAmount			= errors.New("planned amounts cannot be negative")
+	ErrCurrencyMismatch			= errors.New("line items must share one currency")
+	ErrInvalidTaskReference		= errors.New("task reference must name another task of the template, parents coming first")
+	ErrInvalidDependencyType	= errors.New("invalid dependency type")
+	ErrDependencyCycle			= errors.New("dependencies form a cycle")
+	ErrStartDateRequired		= errors.New("a start date is required to place the project's dates")
+	ErrBudgetCurrencyMismatch	= errors.New("line items must be in the currency of the proposed budget")
+	ErrExceedsProposedBudget	= errors.New("line items would exceed the proposed budget")
+	ErrForbidden				= errors.New("only admins and managers can manage templates")
+)
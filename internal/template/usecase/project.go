@@ -0,0 +1,307 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	budgetUseCase "github.com/captainhbb/tbs-backend/internal/budget/usecase"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	milestoneUseCase "github.com/captainhbb/tbs-backend/internal/milestone/usecase"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectUseCase "github.com/captainhbb/tbs-backend/internal/project/usecase"
+	taskDomain "github.com/captainhbb/tbs-backend/internal/task/domain"
+	taskUseCase "github.com/captainhbb/tbs-backend/internal/task/usecase"
+	"github.com/captainhbb/tbs-backend/internal/template/domain"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+)
+
+func(s *templateService) CreateProjectFromTemplate(ctx context.Context, createProjectRequest CreateProjectFromTemplateRequest) (projectDomain.Project, error) {
+	if createProjectRequest.StartDate.IsZero() {
+		return projectDomain.Project{}, ErrStartDateRequired
+	}
+
+	template, err := s.getTemplate(ctx, createProjectRequest.TemplateID)
+	if err != nil {
+		return projectDomain.Project{}, err
+	}
+
+	name := createProjectRequest.Name
+	if name == "" {
+		name = template.Name
+	}
+	budget := createProjectRequest.ProposedBudget
+	if budget.Currency() == "" {
+		budget, err = template.Budget()
+		if err != nil {
+			return projectDomain.Project{}, err
+		}
+	}
+
+	return s.instantiate(ctx, template, projectUseCase.CreateProjectRequest{
+		Name: name,
+		Description: template.Description,
+		StartDate: createProjectRequest.StartDate,
+		ProposedBudget: budget,
+		Status: createProjectRequest.Status,
+		OwnerID: createProjectRequest.OwnerID,
+		Tags: createProjectRequest.Tags,
+		CustomFields: createProjectRequest.CustomFields,
+	})
+}
+
+func(s *templateService) CloneProject(ctx context.Context, cloneProjectRequest CloneProjectRequest) (projectDomain.Project, error) {
+	source, err := s.projectService.GetProject(ctx, cloneProjectRequest.ProjectID)
+	if err != nil {
+		return projectDomain.Project{}, err
+	}
+	if source.StartDate.IsZero() {
+		return projectDomain.Project{}, ErrStartDateRequired
+	}
+
+	template, err := s.capture(ctx, source)
+	if err != nil {
+		return projectDomain.Project{}, err
+	}
+
+	project := projectUseCase.CreateProjectRequest{
+		Name: cloneProjectRequest.Name,
+		Description: source.Description,
+		StartDate: cloneProjectRequest.StartDate,
+		ProposedBudget: source.ProposedBudget,
+		Status: cloneProjectRequest.Status,
+		OwnerID: cloneProjectRequest.OwnerID,
+		Tags: source.Tags,
+		CustomFields: source.CustomFields,
+	}
+	if project.Name == "" {
+		project.Name = source.Name
+	}
+	if project.StartDate.IsZero() {
+		project.StartDate = source.StartDate
+	}
+	if project.Status == "" {
+		project.Status = source.Status
+	}
+	return s.instantiate(ctx, template, project)
+}
+
+// instantiate creates the project and then its content from the template,
+// placing every date relative to the project's start. It all happens in one
+// transaction, so a failure leaves no half-built project behind.
+func(s *templateService) instantiate(ctx context.Context, template domain.Template, createProjectRequest projectUseCase.CreateProjectRequest) (projectDomain.Project, error) {
+	err := checkBudget(template, createProjectRequest.ProposedBudget)
+	if err != nil {
+		return projectDomain.Project{}, err
+	}
+
+	start := createProjectRequest.StartDate
+	if template.Duration > 0 {
+		createProjectRequest.EndDate = start.Add(template.Duration)
+	}
+
+	var project projectDomain.Project
+	err = s.transactions.Do(ctx, func(ctx context.Context) error {
+		var err error
+		project, err = s.projectService.CreateProject(ctx, createProjectRequest)
+		if err != nil {
+			return err
+		}
+		err = s.grantSetup(ctx, project)
+		if err != nil {
+			return err
+		}
+		return s.fill(ctx, template, project.ID, start)
+	})
+	if err != nil {
+		return projectDomain.Project{}, err
+	}
+	return project, nil
+}
+
+// grantSetup makes the caller a manager of a project they create for someone
+// else, so that they fill it in with their own access rather than the owner's.
+// Callers already able to edit the project, such as global admins, are left
+// as they are.
+func(s *templateService) grantSetup(ctx context.Context, project projectDomain.Project) error {
+	actorID, ok := actor.IDFromContext(ctx)
+	if !ok || actor.IsSystem(ctx) || actorID == project.OwnerID {
+		return nil
+	}
+
+	err := s.membershipService.Authorize(ctx, project.ID, membershipDomain.PermissionEdit)
+	switch err {
+	case nil:
+		return nil
+	case membershipUseCase.ErrForbidden:
+	default:
+		return err
+	}
+	_, err = s.membershipService.AddMember(actor.AsSystem(ctx), membershipUseCase.AddMemberRequest{
+		ProjectID: project.ID,
+		UserID: actorID,
+		Role: membershipDomain.RoleManager,
+	})
+	return err
+}
+
+// fill creates the template's line items, tasks, dependencies and milestones
+// in the project starting on start.
+func(s *templateService) fill(ctx context.Context, template domain.Template, projectID int, start time.Time) error {
+	for _, item := range template.LineItems {
+		_, err := s.budgetService.CreateLineItem(ctx, budgetUseCase.CreateLineItemRequest{
+			ProjectID: projectID,
+			Category: item.Category,
+			Planned: item.Planned,
+			Notes: item.Notes,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	taskIDs := make([]int, len(template.Tasks))
+	for i, task := range template.Tasks {
+		createTaskRequest := taskUseCase.CreateTaskRequest{
+			ProjectID: projectID,
+			Name: task.Name,
+			Description: task.Description,
+			Estimate: task.Estimate,
+		}
+		if task.Parent != 0 {
+			createTaskRequest.ParentID = taskIDs[task.Parent - 1]
+		}
+		if task.HasDueDate {
+			createTaskRequest.DueDate = start.Add(task.DueOffset)
+		}
+
+		createdTask, err := s.taskService.CreateTask(ctx, createTaskRequest)
+		if err != nil {
+			return err
+		}
+		taskIDs[i] = createdTask.ID
+	}
+
+	for _, dependency := range template.Dependencies {
+		_, err := s.taskService.AddDependency(ctx, taskUseCase.AddDependencyRequest{
+			PredecessorID: taskIDs[dependency.Predecessor - 1],
+			SuccessorID: taskIDs[dependency.Successor - 1],
+			Type: dependency.Type,
+			Lag: dependency.Lag,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, milestone := range template.Milestones {
+		milestoneTaskIDs := make([]int, 0, len(milestone.Tasks))
+		for _, task := range milestone.Tasks {
+			milestoneTaskIDs = append(milestoneTaskIDs, taskIDs[task - 1])
+		}
+
+		_, err := s.milestoneService.CreateMilestone(ctx, milestoneUseCase.CreateMilestoneRequest{
+			ProjectID: projectID,
+			Name: milestone.Name,
+			Description: milestone.Description,
+			Deliverables: append([]string(nil), milestone.Deliverables...),
+			TaskIDs: milestoneTaskIDs,
+			TargetDate: start.Add(milestone.TargetOffset),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// capture turns the project and its content into a template with dates
+// relative to the project's start. Dependencies and milestone links naming
+// tasks not among the project's are left out; a task whose parent is missing
+// becomes a root task.
+func(s *templateService) capture(ctx context.Context, project projectDomain.Project) (domain.Template, error) {
+	template := domain.Template{
+		Name: project.Name,
+		Description: project.Description,
+	}
+	if !project.EndDate.IsZero() {
+		template.Duration = project.EndDate.Sub(project.StartDate)
+	}
+
+	items, err := s.budgetService.ListLineItems(ctx, project.ID)
+	if err != nil {
+		return domain.Template{}, err
+	}
+	for _, item := range items {
+		template.LineItems = append(template.LineItems, domain.LineItem{
+			Category: item.Category,
+			Planned: item.Planned,
+			Notes: item.Notes,
+		})
+	}
+
+	// Tasks come ordered by WBS code, so parents precede their children.
+	tasks, err := s.taskService.ListProjectTasks(ctx, project.ID, taskDomain.Filter{})
+	if err != nil {
+		return domain.Template{}, err
+	}
+	positions := make(map[int]int, len(tasks))
+	for i, task := range tasks {
+		positions[task.ID] = i + 1
+		template.Tasks = append(template.Tasks, domain.Task{
+			Parent: positions[task.ParentID],
+			Name: task.Name,
+			Description: task.Description,
+			Estimate: task.Estimate,
+			HasDueDate: !task.DueDate.IsZero(),
+			DueOffset: offset(project.StartDate, task.DueDate),
+		})
+	}
+
+	dependencies, err := s.taskService.ListDependencies(ctx, project.ID)
+	if err != nil {
+		return domain.Template{}, err
+	}
+	for _, dependency := range dependencies {
+		predecessor, successor := positions[dependency.PredecessorID], positions[dependency.SuccessorID]
+		if predecessor == 0 || successor == 0 {
+			continue
+		}
+		template.Dependencies = append(template.Dependencies, domain.Dependency{
+			Predecessor: predecessor,
+			Successor: successor,
+			Type: dependency.Type,
+			Lag: dependency.Lag,
+		})
+	}
+
+	milestones, err := s.milestoneService.ListMilestones(ctx, project.ID)
+	if err != nil {
+		return domain.Template{}, err
+	}
+	for _, milestone := range milestones {
+		milestoneTasks := make([]int, 0, len(milestone.TaskIDs))
+		for _, taskID := range milestone.TaskIDs {
+			position, ok := positions[taskID]
+			if !ok {
+				continue
+			}
+			milestoneTasks = append(milestoneTasks, position)
+		}
+		template.Milestones = append(template.Milestones, domain.Milestone{
+			Name: milestone.Name,
+			Description: milestone.Description,
+			Deliverables: append([]string(nil), milestone.Deliverables...),
+			Tasks: milestoneTasks,
+			TargetOffset: offset(project.StartDate, milestone.TargetDate),
+		})
+	}
+	return template, nil
+}
+
+func offset(start time.Time, date time.Time) time.Duration {
+	if date.IsZero() {
+		return 0
+	}
+	return date.Sub(start)
+}
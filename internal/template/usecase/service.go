@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	budgetDomain "github.com/captainhbb/tbs-backend/internal/budget/domain"
+	budgetUseCase "github.com/captainhbb/tbs-backend/internal/budget/usecase"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	milestoneUseCase "github.com/captainhbb/tbs-backend/internal/milestone/usecase"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectUseCase "github.com/captainhbb/tbs-backend/internal/project/usecase"
+	taskDomain "github.com/captainhbb/tbs-backend/internal/task/domain"
+	taskUseCase "github.com/captainhbb/tbs-backend/internal/task/usecase"
+	"github.com/captainhbb/tbs-backend/internal/template/domain"
+	"github.com/captainhbb/tbs-backend/internal/template/ports"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
+)
+
+//go:generate mockery --dir . --name TemplateService --structname MockTemplateService --filename mock_template_service.go --output ./mock --outpkg mock
+type TemplateService interface {
+	// CreateTemplate, UpdateTemplate and DeleteTemplate are restricted to global
+	// admins and managers.
+	CreateTemplate(ctx context.Context, template CreateTemplateRequest) (domain.Template, error)
+	GetTemplate(ctx context.Context, id int) (domain.Template, error)
+	UpdateTemplate(ctx context.Context, template UpdateTemplateRequest) (domain.Template, error)
+	DeleteTemplate(ctx context.Context, id int) error
+	ListTemplates(ctx context.Context) ([]domain.Template, error)
+	// CreateProjectFromTemplate creates the project with the template's line
+	// items, tasks, dependencies and milestones, all or nothing. The caller sets
+	// the project up with their own access; one creating it for someone else is
+	// made a manager of it.
+	CreateProjectFromTemplate(ctx context.Context, project CreateProjectFromTemplateRequest) (projectDomain.Project, error)
+	// CloneProject needs view access to the project cloned.
+	CloneProject(ctx context.Context, clone CloneProjectRequest) (projectDomain.Project, error)
+}
+
+type templateService struct {
+	repo ports.Repository
+	projectService projectUseCase.ProjectService
+	budgetService budgetUseCase.BudgetService
+	taskService taskUseCase.TaskService
+	milestoneService milestoneUseCase.MilestoneService
+	membershipService membershipUseCase.MembershipService
+	userService userUseCase.UserService
+	transactions transaction.Manager
+}
+
+func New(repo ports.Repository, projectService projectUseCase.ProjectService, budgetService budgetUseCase.BudgetService, taskService taskUseCase.TaskService, milestoneService milestoneUseCase.MilestoneService, membershipService membershipUseCase.MembershipService, userService userUseCase.UserService, transactions transaction.Manager) TemplateService {
+	return &templateService{
+		repo: repo,
+		projectService: projectService,
+		budgetService: budgetService,
+		taskService: taskService,
+		milestoneService: milestoneService,
+		membershipService: membershipService,
+		userService: userService,
+		transactions: transactions,
+	}
+}
+
+func(s *templateService) CreateTemplate(ctx context.Context, createTemplateRequest CreateTemplateRequest) (domain.Template, error) {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin, userDomain.RoleManager)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return domain.Template{}, ErrForbidden
+	default:
+		return domain.Template{}, err
+	}
+
+	createdBy, _ := actor.IDFromContext(ctx)
+	template := domain.Template{
+		Name: strings.TrimSpace(createTemplateRequest.Name),
+		Description: createTemplateRequest.Description,
+		Duration: createTemplateRequest.Duration,
+		LineItems: createTemplateRequest.LineItems,
+		Tasks: createTemplateRequest.Tasks,
+		Dependencies: createTemplateRequest.Dependencies,
+		Milestones: createTemplateRequest.Milestones,
+		CreatedBy: createdBy,
+		CreatedAt: time.Now(),
+	}
+	err = validate(template)
+	if err != nil {
+		return domain.Template{}, err
+	}
+	return s.repo.CreateTemplate(ctx, template)
+}
+
+func(s *templateService) GetTemplate(ctx context.Context, id int) (domain.Template, error) {
+	return s.getTemplate(ctx, id)
+}
+
+func(s *templateService) UpdateTemplate(ctx context.Context, updateTemplateRequest UpdateTemplateRequest) (domain.Template, error) {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin, userDomain.RoleManager)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return domain.Template{}, ErrForbidden
+	default:
+		return domain.Template{}, err
+	}
+
+	template, err := s.getTemplate(ctx, updateTemplateRequest.ID)
+	if err != nil {
+		return domain.Template{}, err
+	}
+	template.Name = strings.TrimSpace(updateTemplateRequest.Name)
+	template.Description = updateTemplateRequest.Description
+	template.Duration = updateTemplateRequest.Duration
+	template.LineItems = updateTemplateRequest.LineItems
+	template.Tasks = updateTemplateRequest.Tasks
+	template.Dependencies = updateTemplateRequest.Dependencies
+	template.Milestones = updateTemplateRequest.Milestones
+	err = validate(template)
+	if err != nil {
+		return domain.Template{}, err
+	}
+
+	updatedTemplate, err := s.repo.UpdateTemplate(ctx, template)
+	switch err {
+	case ports.ErrTemplateNotFound:
+		return domain.Template{}, ErrTemplateNotFound
+	}
+	return updatedTemplate, err
+}
+
+func(s *templateService) DeleteTemplate(ctx context.Context, id int) error {
+	err := s.userService.RequireRole(ctx, userDomain.RoleAdmin, userDomain.RoleManager)
+	switch err {
+	case nil:
+	case userUseCase.ErrForbidden:
+		return ErrForbidden
+	default:
+		return err
+	}
+
+	err = s.repo.DeleteTemplate(ctx, id)
+	switch err {
+	case ports.ErrTemplateNotFound:
+		return ErrTemplateNotFound
+	}
+	return err
+}
+
+func(s *templateService) ListTemplates(ctx context.Context) ([]domain.Template, error) {
+	return s.repo.ListTemplates(ctx)
+}
+
+// validate checks the template on its own. Whether its line items fit the
+// proposed budget depends on the project created from it, which checkBudget
+// covers.
+func validate(template domain.Template) error {
+	if template.Name == "" {
+		return ErrNameRequired
+	}
+	if template.Duration < 0 {
+		return ErrInvalidDuration
+	}
+
+	for _, item := range template.LineItems {
+		if !budgetDomain.IsValidCategory(item.Category) {
+			return ErrInvalidCategory
+		}
+		if item.Planned.IsNegative() {
+			return ErrInvalidAmount
+		}
+		if item.Planned.Currency() != template.LineItems[0].Planned.Currency() {
+			return ErrCurrencyMismatch
+		}
+	}
+
+	for i, task := range template.Tasks {
+		if task.Name == "" {
+			return ErrNameRequired
+		}
+		if task.Parent < 0 || task.Parent > i {
+			return ErrInvalidTaskReference
+		}
+		if task.HasDueDate && !withinDuration(template, task.DueOffset) {
+			return ErrOutsideDuration
+		}
+	}
+
+	dependencies := make([]taskDomain.Dependency, 0, len(template.Dependencies))
+	for _, dependency := range template.Dependencies {
+		if !isTask(template, dependency.Predecessor) || !isTask(template, dependency.Successor) || dependency.Predecessor == dependency.Successor {
+			return ErrInvalidTaskReference
+		}
+		if dependency.Type != "" && !taskDomain.IsValidDependencyType(dependency.Type) {
+			return ErrInvalidDependencyType
+		}
+		dependencies = append(dependencies, taskDomain.Dependency{
+			PredecessorID: dependency.Predecessor,
+			SuccessorID: dependency.Successor,
+		})
+	}
+	if taskDomain.HasCycle(dependencies) {
+		return ErrDependencyCycle
+	}
+
+	for _, milestone := range template.Milestones {
+		if milestone.Name == "" {
+			return ErrNameRequired
+		}
+		if !withinDuration(template, milestone.TargetOffset) {
+			return ErrOutsideDuration
+		}
+		for _, task := range milestone.Tasks {
+			if !isTask(template, task) {
+				return ErrInvalidTaskReference
+			}
+		}
+	}
+	return nil
+}
+
+// checkBudget checks that the line items are in the currency of the proposed
+// budget and do not exceed it, which creating them would otherwise only find
+// out once the project exists.
+func checkBudget(template domain.Template, budget money.Money) error {
+	if len(template.LineItems) == 0 {
+		return nil
+	}
+	total, err := template.Budget()
+	if err != nil {
+		return ErrCurrencyMismatch
+	}
+	if total.Currency() != budget.Currency() {
+		return ErrBudgetCurrencyMismatch
+	}
+	cmp, err := total.Cmp(budget)
+	if err != nil {
+		return err
+	}
+	if cmp > 0 {
+		return ErrExceedsProposedBudget
+	}
+	return nil
+}
+
+func isTask(template domain.Template, position int) bool {
+	return position >= 1 && position <= len(template.Tasks)
+}
+
+func withinDuration(template domain.Template, offset time.Duration) bool {
+	return offset >= 0 && (template.Duration == 0 || offset <= template.Duration)
+}
+
+func(s *templateService) getTemplate(ctx context.Context, id int) (domain.Template, error) {
+	template, err := s.repo.GetTemplate(ctx, id)
+	switch err {
+	case ports.ErrTemplateNotFound:
+		return domain.Template{}, ErrTemplateNotFound
+	}
+	return template, err
+}
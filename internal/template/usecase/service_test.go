@@ -0,0 +1,405 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	budgetDomain "github.com/captainhbb/tbs-backend/internal/budget/domain"
+	budgetUseCaseMock "github.com/captainhbb/tbs-backend/internal/budget/usecase/mock"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	milestoneDomain "github.com/captainhbb/tbs-backend/internal/milestone/domain"
+	milestoneUseCase "github.com/captainhbb/tbs-backend/internal/milestone/usecase"
+	milestoneUseCaseMock "github.com/captainhbb/tbs-backend/internal/milestone/usecase/mock"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectUseCase "github.com/captainhbb/tbs-backend/internal/project/usecase"
+	projectUseCaseMock "github.com/captainhbb/tbs-backend/internal/project/usecase/mock"
+	taskDomain "github.com/captainhbb/tbs-backend/internal/task/domain"
+	taskUseCase "github.com/captainhbb/tbs-backend/internal/task/usecase"
+	taskUseCaseMock "github.com/captainhbb/tbs-backend/internal/task/usecase/mock"
+	"github.com/captainhbb/tbs-backend/internal/template/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/template/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/template/usecase"
+	userDomain "github.com/captainhbb/tbs-backend/internal/user/domain"
+	userUseCase "github.com/captainhbb/tbs-backend/internal/user/usecase"
+	userUseCaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const day = 24 * time.Hour
+
+type templateMocks struct {
+	repo *portsMock.MockRepository
+	projectService *projectUseCaseMock.MockProjectService
+	budgetService *budgetUseCaseMock.MockBudgetService
+	taskService *taskUseCaseMock.MockTaskService
+	milestoneService *milestoneUseCaseMock.MockMilestoneService
+	membershipService *membershipUseCaseMock.MockMembershipService
+}
+
+// newTemplateService wires the service with user 1 as a manager and user 2 as
+// a regular user.
+func newTemplateService(t *testing.T) (usecase.TemplateService, templateMocks) {
+	m := templateMocks{
+		repo: portsMock.NewMockRepository(t),
+		projectService: projectUseCaseMock.NewMockProjectService(t),
+		budgetService: budgetUseCaseMock.NewMockBudgetService(t),
+		taskService: taskUseCaseMock.NewMockTaskService(t),
+		milestoneService: milestoneUseCaseMock.NewMockMilestoneService(t),
+		membershipService: membershipUseCaseMock.NewMockMembershipService(t),
+	}
+	userServiceMock := userUseCaseMock.NewMockUserService(t)
+	userServiceMock.On("RequireRole", mock.Anything, userDomain.RoleAdmin, userDomain.RoleManager).Return(func(ctx context.Context, _ ...string) error {
+		if actorID, _ := actor.IDFromContext(ctx); actorID != 1 {
+			return userUseCase.ErrForbidden
+		}
+		return nil
+	}).Maybe()
+	userServiceMock.On("GetUser", mock.Anything, 1).Return(userDomain.User{ID: 1, Role: userDomain.RoleManager}, nil).Maybe()
+	userServiceMock.On("GetUser", mock.Anything, 2).Return(userDomain.User{ID: 2}, nil).Maybe()
+
+	service := usecase.New(m.repo, m.projectService, m.budgetService, m.taskService, m.milestoneService, m.membershipService, userServiceMock, transaction.None())
+	return service, m
+}
+
+// rollout is a two week template: design, then build beneath it, with a
+// review milestone at the end of the first week.
+func rollout() usecase.CreateTemplateRequest {
+	return usecase.CreateTemplateRequest{
+		Name: "Rollout",
+		Description: "Standard rollout",
+		Duration: 14 * day,
+		LineItems: []domain.LineItem{
+			{Category: budgetDomain.CategoryLabor, Planned: money.MustNew(800000, "USD")},
+			{Category: budgetDomain.CategoryLicenses, Planned: money.MustNew(200000, "USD")},
+		},
+		Tasks: []domain.Task{
+			{Name: "Design", Estimate: 16 * time.Hour, HasDueDate: true, DueOffset: 6 * day},
+			{Parent: 1, Name: "Build", Estimate: 40 * time.Hour},
+		},
+		Dependencies: []domain.Dependency{{Predecessor: 1, Successor: 2, Type: taskDomain.DependencyFinishToStart}},
+		Milestones: []domain.Milestone{{Name: "Review", Tasks: []int{1}, TargetOffset: 7 * day}},
+	}
+}
+
+func TestCreateTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name			string
+		actorID			int
+		change			func(template *usecase.CreateTemplateRequest)
+		expectedError	error
+	}{
+		{
+			name: "valid template",
+			actorID: 1,
+			change: func(_ *usecase.CreateTemplateRequest) {},
+		},
+		{
+			name: "regular users cannot create templates",
+			actorID: 2,
+			change: func(_ *usecase.CreateTemplateRequest) {},
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "parent must come first",
+			actorID: 1,
+			change: func(template *usecase.CreateTemplateRequest) {
+				template.Tasks[0].Parent = 2
+				template.Tasks[1].Parent = 0
+			},
+			expectedError: usecase.ErrInvalidTaskReference,
+		},
+		{
+			name: "milestone names an unknown task",
+			actorID: 1,
+			change: func(template *usecase.CreateTemplateRequest) {
+				template.Milestones[0].Tasks = []int{3}
+			},
+			expectedError: usecase.ErrInvalidTaskReference,
+		},
+		{
+			name: "dependencies form a cycle",
+			actorID: 1,
+			change: func(template *usecase.CreateTemplateRequest) {
+				template.Dependencies = append(template.Dependencies, domain.Dependency{Predecessor: 2, Successor: 1})
+			},
+			expectedError: usecase.ErrDependencyCycle,
+		},
+		{
+			name: "line items in different currencies",
+			actorID: 1,
+			change: func(template *usecase.CreateTemplateRequest) {
+				template.LineItems[1].Planned = money.MustNew(200000, "EUR")
+			},
+			expectedError: usecase.ErrCurrencyMismatch,
+		},
+		{
+			name: "milestone after the end",
+			actorID: 1,
+			change: func(template *usecase.CreateTemplateRequest) {
+				template.Milestones[0].TargetOffset = 15 * day
+			},
+			expectedError: usecase.ErrOutsideDuration,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, m := newTemplateService(t)
+			m.repo.On("CreateTemplate", mock.Anything, mock.Anything).Return(func(_ context.Context, template domain.Template) (domain.Template, error) {
+				template.ID = 3
+				return template, nil
+			}).Maybe()
+
+			input := rollout()
+			tt.change(&input)
+			template, err := service.CreateTemplate(actor.WithID(context.Background(), tt.actorID), input)
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				require.Equal(t, 1, template.CreatedBy)
+			}
+		})
+	}
+}
+
+// expectProject records the project content created for project 20, owned
+// by user 5, and hands out task IDs from 100. The caller, who cannot edit the
+// project on their own, is made its manager and creates the content.
+func expectProject(t *testing.T, m templateMocks, callerID int) (*[]taskUseCase.CreateTaskRequest, *[]taskUseCase.AddDependencyRequest, *[]milestoneUseCase.CreateMilestoneRequest) {
+	var tasks []taskUseCase.CreateTaskRequest
+	var dependencies []taskUseCase.AddDependencyRequest
+	var milestones []milestoneUseCase.CreateMilestoneRequest
+	asCaller := mock.MatchedBy(func(ctx context.Context) bool {
+		actorID, _ := actor.IDFromContext(ctx)
+		return actorID == callerID && !actor.IsSystem(ctx)
+	})
+
+	m.membershipService.On("Authorize", mock.Anything, 20, membershipDomain.PermissionEdit).Return(membershipUseCase.ErrForbidden).Once()
+	m.membershipService.On("AddMember", mock.MatchedBy(actor.IsSystem), membershipUseCase.AddMemberRequest{ProjectID: 20, UserID: callerID, Role: membershipDomain.RoleManager}).Return(membershipDomain.Member{}, nil).Once()
+
+	m.budgetService.On("CreateLineItem", asCaller, mock.Anything).Return(budgetDomain.LineItem{}, nil).Maybe()
+	m.taskService.On("CreateTask", asCaller, mock.Anything).Return(func(_ context.Context, task taskUseCase.CreateTaskRequest) (taskDomain.Task, error) {
+		require.Equal(t, 20, task.ProjectID)
+		tasks = append(tasks, task)
+		return taskDomain.Task{ID: 99 + len(tasks), ProjectID: 20}, nil
+	})
+	m.taskService.On("AddDependency", asCaller, mock.Anything).Return(func(_ context.Context, dependency taskUseCase.AddDependencyRequest) (taskDomain.Dependency, error) {
+		dependencies = append(dependencies, dependency)
+		return taskDomain.Dependency{}, nil
+	}).Maybe()
+	m.milestoneService.On("CreateMilestone", asCaller, mock.Anything).Return(func(_ context.Context, milestone milestoneUseCase.CreateMilestoneRequest) (milestoneDomain.Milestone, error) {
+		milestones = append(milestones, milestone)
+		return milestoneDomain.Milestone{}, nil
+	}).Maybe()
+	return &tasks, &dependencies, &milestones
+}
+
+func TestCreateProjectFromTemplate(t *testing.T) {
+	t.Parallel()
+
+	service, m := newTemplateService(t)
+	input := rollout()
+	m.repo.On("GetTemplate", mock.Anything, 3).Return(domain.Template{
+		ID: 3,
+		Name: input.Name,
+		Description: input.Description,
+		Duration: input.Duration,
+		LineItems: input.LineItems,
+		Tasks: input.Tasks,
+		Dependencies: input.Dependencies,
+		Milestones: input.Milestones,
+	}, nil)
+
+	start := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	m.projectService.On("CreateProject", mock.Anything, mock.Anything).Return(func(_ context.Context, project projectUseCase.CreateProjectRequest) (projectDomain.Project, error) {
+		require.Equal(t, "Rollout", project.Name)
+		require.Equal(t, start, project.StartDate)
+		require.Equal(t, start.AddDate(0, 0, 14), project.EndDate)
+		require.Equal(t, money.MustNew(1000000, "USD"), project.ProposedBudget)
+		require.Equal(t, []string{"rollout"}, project.Tags)
+		require.Equal(t, map[string]string{"region": "emea"}, project.CustomFields)
+		return projectDomain.Project{ID: 20, OwnerID: project.OwnerID, StartDate: project.StartDate, EndDate: project.EndDate}, nil
+	})
+	tasks, dependencies, milestones := expectProject(t, m, 1)
+
+	project, err := service.CreateProjectFromTemplate(actor.WithID(context.Background(), 1), usecase.CreateProjectFromTemplateRequest{
+		TemplateID: 3,
+		OwnerID: 5,
+		StartDate: start,
+		Tags: []string{"rollout"},
+		CustomFields: map[string]string{"region": "emea"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 20, project.ID)
+	m.budgetService.AssertNumberOfCalls(t, "CreateLineItem", 2)
+
+	require.Len(t, *tasks, 2)
+	require.Equal(t, start.AddDate(0, 0, 6), (*tasks)[0].DueDate)
+	require.Equal(t, 100, (*tasks)[1].ParentID)
+	require.True(t, (*tasks)[1].DueDate.IsZero())
+	require.Equal(t, []taskUseCase.AddDependencyRequest{{PredecessorID: 100, SuccessorID: 101, Type: taskDomain.DependencyFinishToStart}}, *dependencies)
+	require.Len(t, *milestones, 1)
+	require.Equal(t, start.AddDate(0, 0, 7), (*milestones)[0].TargetDate)
+	require.Equal(t, []int{100}, (*milestones)[0].TaskIDs)
+}
+
+func TestCreateProjectFromTemplateChecksTheBudgetFirst(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name			string
+		budget			money.Money
+		expectedError	error
+	}{
+		{
+			name: "line items exceed the proposed budget",
+			budget: money.MustNew(900000, "USD"),
+			expectedError: usecase.ErrExceedsProposedBudget,
+		},
+		{
+			name: "proposed budget in another currency",
+			budget: money.MustNew(1000000, "EUR"),
+			expectedError: usecase.ErrBudgetCurrencyMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, m := newTemplateService(t)
+			input := rollout()
+			m.repo.On("GetTemplate", mock.Anything, 3).Return(domain.Template{ID: 3, Name: input.Name, LineItems: input.LineItems}, nil)
+
+			_, err := service.CreateProjectFromTemplate(actor.WithID(context.Background(), 1), usecase.CreateProjectFromTemplateRequest{
+				TemplateID: 3,
+				OwnerID: 5,
+				StartDate: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+				ProposedBudget: tt.budget,
+			})
+			require.ErrorIs(t, err, tt.expectedError)
+			m.projectService.AssertNotCalled(t, "CreateProject", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCreateProjectFromTemplateNeedsStartDate(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newTemplateService(t)
+	_, err := service.CreateProjectFromTemplate(context.Background(), usecase.CreateProjectFromTemplateRequest{TemplateID: 3, OwnerID: 5})
+	require.ErrorIs(t, err, usecase.ErrStartDateRequired)
+}
+
+func TestCloneProject(t *testing.T) {
+	t.Parallel()
+
+	service, m := newTemplateService(t)
+	sourceStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	m.projectService.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{
+		ID: 10,
+		Name: "Pilot",
+		Description: "First rollout",
+		StartDate: sourceStart,
+		EndDate: sourceStart.AddDate(0, 0, 30),
+		OwnerID: 2,
+		ProposedBudget: money.MustNew(500000, "USD"),
+		Status: "active",
+		Tags: []string{"pilot"},
+		CustomFields: map[string]string{"region": "emea"},
+	}, nil)
+	m.budgetService.On("ListLineItems", mock.Anything, 10).Return([]budgetDomain.LineItem{
+		{ID: 1, ProjectID: 10, Category: budgetDomain.CategoryLabor, Planned: money.MustNew(500000, "USD")},
+	}, nil)
+	m.taskService.On("ListProjectTasks", mock.Anything, 10, taskDomain.Filter{}).Return([]taskDomain.Task{
+		{ID: 41, ProjectID: 10, WBSCode: "1", Name: "Plan", AssigneeID: 2, Status: taskDomain.StatusDone, Progress: 100, DueDate: sourceStart.AddDate(0, 0, 5)},
+		{ID: 42, ProjectID: 10, ParentID: 41, WBSCode: "1.1", Name: "Budget"},
+		{ID: 43, ProjectID: 10, WBSCode: "2", Name: "Run"},
+	}, nil)
+	m.taskService.On("ListDependencies", mock.Anything, 10).Return([]taskDomain.Dependency{
+		{ID: 7, ProjectID: 10, PredecessorID: 41, SuccessorID: 43, Type: taskDomain.DependencyStartToStart, Lag: 2 * day},
+	}, nil)
+	m.milestoneService.On("ListMilestones", mock.Anything, 10).Return([]milestoneDomain.Milestone{
+		{ID: 8, ProjectID: 10, Name: "Go live", Deliverables: []string{"runbook"}, TaskIDs: []int{43}, TargetDate: sourceStart.AddDate(0, 0, 30), CompletedAt: sourceStart.AddDate(0, 0, 29), Acceptance: milestoneDomain.AcceptanceAccepted},
+	}, nil)
+
+	start := sourceStart.AddDate(0, 2, 0)
+	m.projectService.On("CreateProject", mock.Anything, projectUseCase.CreateProjectRequest{
+		Name: "Pilot",
+		Description: "First rollout",
+		StartDate: start,
+		EndDate: start.AddDate(0, 0, 30),
+		ProposedBudget: money.MustNew(500000, "USD"),
+		Status: "active",
+		OwnerID: 5,
+		Tags: []string{"pilot"},
+		CustomFields: map[string]string{"region": "emea"},
+	}).Return(projectDomain.Project{ID: 20, OwnerID: 5}, nil)
+	tasks, dependencies, milestones := expectProject(t, m, 2)
+
+	_, err := service.CloneProject(actor.WithID(context.Background(), 2), usecase.CloneProjectRequest{
+		ProjectID: 10,
+		OwnerID: 5,
+		StartDate: start,
+	})
+	require.NoError(t, err)
+	m.budgetService.AssertNumberOfCalls(t, "CreateLineItem", 1)
+
+	require.Equal(t, []taskUseCase.CreateTaskRequest{
+		{ProjectID: 20, Name: "Plan", DueDate: start.AddDate(0, 0, 5)},
+		{ProjectID: 20, ParentID: 100, Name: "Budget"},
+		{ProjectID: 20, Name: "Run"},
+	}, *tasks)
+	require.Equal(t, []taskUseCase.AddDependencyRequest{
+		{PredecessorID: 100, SuccessorID: 102, Type: taskDomain.DependencyStartToStart, Lag: 2 * day},
+	}, *dependencies)
+	require.Equal(t, []milestoneUseCase.CreateMilestoneRequest{
+		{ProjectID: 20, Name: "Go live", Deliverables: []string{"runbook"}, TaskIDs: []int{102}, TargetDate: start.AddDate(0, 0, 30)},
+	}, *milestones)
+}
+
+func TestCloneProjectLeavesOutLinksToMissingTasks(t *testing.T) {
+	t.Parallel()
+
+	service, m := newTemplateService(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	m.projectService.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{ID: 10, Name: "Pilot", StartDate: start, OwnerID: 5}, nil)
+	m.budgetService.On("ListLineItems", mock.Anything, 10).Return(nil, nil)
+	m.taskService.On("ListProjectTasks", mock.Anything, 10, taskDomain.Filter{}).Return([]taskDomain.Task{
+		{ID: 41, ProjectID: 10, WBSCode: "1", Name: "Plan"},
+	}, nil)
+	m.taskService.On("ListDependencies", mock.Anything, 10).Return([]taskDomain.Dependency{
+		{ID: 7, ProjectID: 10, PredecessorID: 41, SuccessorID: 44},
+	}, nil)
+	m.milestoneService.On("ListMilestones", mock.Anything, 10).Return([]milestoneDomain.Milestone{
+		{ID: 8, ProjectID: 10, Name: "Go live", TaskIDs: []int{44, 41}, TargetDate: start},
+	}, nil)
+	m.projectService.On("CreateProject", mock.Anything, mock.Anything).Return(projectDomain.Project{ID: 20, OwnerID: 5}, nil)
+	_, dependencies, milestones := expectProject(t, m, 2)
+
+	_, err := service.CloneProject(actor.WithID(context.Background(), 2), usecase.CloneProjectRequest{ProjectID: 10, OwnerID: 5})
+	require.NoError(t, err)
+	require.Empty(t, *dependencies)
+	require.Len(t, *milestones, 1)
+	require.Equal(t, []int{100}, (*milestones)[0].TaskIDs)
+}
+
+func TestCloneProjectNeedsViewAccess(t *testing.T) {
+	t.Parallel()
+
+	service, m := newTemplateService(t)
+	m.projectService.On("GetProject", mock.Anything, 10).Return(projectDomain.Project{}, membershipUseCase.ErrForbidden)
+
+	_, err := service.CloneProject(actor.WithID(context.Background(), 2), usecase.CloneProjectRequest{ProjectID: 10, OwnerID: 2})
+	require.ErrorIs(t, err, membershipUseCase.ErrForbidden)
+}
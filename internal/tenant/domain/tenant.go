@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"time"
+)
+
+// Tenant is a client organization hosted on the deployment. Every user and
+// project belongs to exactly one tenant.
+type Tenant struct {
+	ID 					int
+	Name				string
+	// Slug identifies the tenant in URLs and host names.
+	Slug				string
+	Active				bool
+	CreatedAt			time.Time
+}
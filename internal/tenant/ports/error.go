@@ -0,0 +1,8 @@
+package ports
+
+import "errors"
+
+var (
+	ErrTenantNotFound			= errors.New("tenant not found")
+	ErrSlugAlreadyExists		= errors.New("tenant slug already exists")
+)
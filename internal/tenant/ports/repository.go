@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/tenant/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	// CreateTenant returns ErrSlugAlreadyExists when another tenant uses the
+	// slug.
+	CreateTenant(ctx context.Context, tenant domain.Tenant) (domain.Tenant, error)
+	GetTenant(ctx context.Context, id int) (domain.Tenant, error)
+	UpdateTenant(ctx context.Context, tenant domain.Tenant) (domain.Tenant, error)
+	ListTenants(ctx context.Context) ([]domain.Tenant, error)
+}
@@ -0,0 +1,12 @@
+package usecase
+
+type CreateTenantRequest struct {
+	Name 					string
+	Slug 					string
+}
+
+type UpdateTenantRequest struct {
+	ID 						int
+	Name 					string
+	Active 					bool
+}
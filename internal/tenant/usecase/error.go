@@ -0,0 +1,12 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrTenantNotFound			= errors.New("tenant not found")
+	ErrTenantInactive			= errors.New("tenant is deactivated")
+	ErrSlugAlreadyExists		= errors.New("tenant slug already exists")
+	ErrInvalidSlug				= errors.New("slug must be lowercase letters and digits separated by single hyphens")
+	ErrNameRequired				= errors.New("a tenant needs a name")
+	ErrForbidden				= errors.New("tenants can only be managed by platform calls")
+)
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/tenant/domain"
+	"github.com/captainhbb/tbs-backend/internal/tenant/ports"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+)
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+//go:generate mockery --dir . --name TenantService --structname MockTenantService --filename mock_tenant_service.go --output ./mock --outpkg mock
+type TenantService interface {
+	// CreateTenant, UpdateTenant and ListTenants are platform operations and
+	// fail for any other request.
+	CreateTenant(ctx context.Context, tenant CreateTenantRequest) (domain.Tenant, error)
+	// GetTenant only finds the request's own tenant, unless it is a platform
+	// call.
+	GetTenant(ctx context.Context, id int) (domain.Tenant, error)
+	UpdateTenant(ctx context.Context, tenant UpdateTenantRequest) (domain.Tenant, error)
+	ListTenants(ctx context.Context) ([]domain.Tenant, error)
+	// Enter returns a copy of ctx acting within the tenant, which must exist
+	// and be active. Every repository query made with it is confined to that
+	// tenant. Requests already acting within another tenant cannot enter it.
+	Enter(ctx context.Context, tenantID int) (context.Context, error)
+}
+
+type tenantService struct {
+	repo ports.Repository
+}
+
+func New(repo ports.Repository) TenantService {
+	return &tenantService{
+		repo: repo,
+	}
+}
+
+func(s *tenantService) CreateTenant(ctx context.Context, createTenantRequest CreateTenantRequest) (domain.Tenant, error) {
+	err := requirePlatform(ctx)
+	if err != nil {
+		return domain.Tenant{}, err
+	}
+
+	name := strings.TrimSpace(createTenantRequest.Name)
+	if name == "" {
+		return domain.Tenant{}, ErrNameRequired
+	}
+	if !slugPattern.MatchString(createTenantRequest.Slug) {
+		return domain.Tenant{}, ErrInvalidSlug
+	}
+
+	createdTenant, err := s.repo.CreateTenant(ctx, domain.Tenant{
+		Name: name,
+		Slug: createTenantRequest.Slug,
+		Active: true,
+		CreatedAt: time.Now(),
+	})
+	switch err {
+	case ports.ErrSlugAlreadyExists:
+		return domain.Tenant{}, ErrSlugAlreadyExists
+	}
+	return createdTenant, err
+}
+
+func(s *tenantService) GetTenant(ctx context.Context, id int) (domain.Tenant, error) {
+	if !tenant.Visible(ctx, id) {
+		return domain.Tenant{}, ErrTenantNotFound
+	}
+	return s.getTenant(ctx, id)
+}
+
+func(s *tenantService) UpdateTenant(ctx context.Context, updateTenantRequest UpdateTenantRequest) (domain.Tenant, error) {
+	err := requirePlatform(ctx)
+	if err != nil {
+		return domain.Tenant{}, err
+	}
+
+	name := strings.TrimSpace(updateTenantRequest.Name)
+	if name == "" {
+		return domain.Tenant{}, ErrNameRequired
+	}
+
+	existingTenant, err := s.getTenant(ctx, updateTenantRequest.ID)
+	if err != nil {
+		return domain.Tenant{}, err
+	}
+	existingTenant.Name = name
+	existingTenant.Active = updateTenantRequest.Active
+
+	updatedTenant, err := s.repo.UpdateTenant(ctx, existingTenant)
+	switch err {
+	case ports.ErrTenantNotFound:
+		return domain.Tenant{}, ErrTenantNotFound
+	}
+	return updatedTenant, err
+}
+
+func(s *tenantService) ListTenants(ctx context.Context) ([]domain.Tenant, error) {
+	err := requirePlatform(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListTenants(ctx)
+}
+
+func(s *tenantService) Enter(ctx context.Context, tenantID int) (context.Context, error) {
+	if id, ok := tenant.IDFromContext(ctx); ok && id != tenantID {
+		return nil, ErrTenantNotFound
+	}
+	existingTenant, err := s.getTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if !existingTenant.Active {
+		return nil, ErrTenantInactive
+	}
+	return tenant.WithID(ctx, tenantID), nil
+}
+
+func(s *tenantService) getTenant(ctx context.Context, id int) (domain.Tenant, error) {
+	existingTenant, err := s.repo.GetTenant(ctx, id)
+	switch err {
+	case ports.ErrTenantNotFound:
+		return domain.Tenant{}, ErrTenantNotFound
+	}
+	return existingTenant, err
+}
+
+func requirePlatform(ctx context.Context) error {
+	if !tenant.IsPlatform(ctx) {
+		return ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/captainhbb/tbs-backend/internal/tenant/domain"
+	"github.com/captainhbb/tbs-backend/internal/tenant/ports"
+	portsMock "github.com/captainhbb/tbs-backend/internal/tenant/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/tenant/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTenant(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name			string
+		ctx				context.Context
+		input			usecase.CreateTenantRequest
+		mockSetup		func(repo *portsMock.MockRepository)
+		expectedError	error
+	}{
+		{
+			name: "creates an active tenant",
+			ctx: tenant.AsPlatform(context.Background()),
+			input: usecase.CreateTenantRequest{Name: " Acme ", Slug: "acme-corp"},
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("CreateTenant", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(domain.Tenant)
+					require.Equal(t, "Acme", capturedArg.Name)
+					require.True(t, capturedArg.Active)
+				}).Return(domain.Tenant{ID: 1, Name: "Acme", Slug: "acme-corp", Active: true}, nil)
+			},
+		},
+		{
+			name: "rejects requests acting within a tenant",
+			ctx: tenant.WithID(context.Background(), 1),
+			input: usecase.CreateTenantRequest{Name: "Acme", Slug: "acme"},
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "rejects requests that are no platform calls",
+			ctx: context.Background(),
+			input: usecase.CreateTenantRequest{Name: "Acme", Slug: "acme"},
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "rejects an invalid slug",
+			ctx: tenant.AsPlatform(context.Background()),
+			input: usecase.CreateTenantRequest{Name: "Acme", Slug: "Acme Corp"},
+			expectedError: usecase.ErrInvalidSlug,
+		},
+		{
+			name: "rejects a taken slug",
+			ctx: tenant.AsPlatform(context.Background()),
+			input: usecase.CreateTenantRequest{Name: "Acme", Slug: "acme"},
+			mockSetup: func(repo *portsMock.MockRepository) {
+				repo.On("CreateTenant", mock.Anything, mock.Anything).Return(domain.Tenant{}, ports.ErrSlugAlreadyExists)
+			},
+			expectedError: usecase.ErrSlugAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoMock := portsMock.NewMockRepository(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(repoMock)
+			}
+
+			service := usecase.New(repoMock)
+			_, err := service.CreateTenant(tt.ctx, tt.input)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestEnter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name			string
+		ctx				context.Context
+		tenantID		int
+		expectedError	error
+	}{
+		{
+			name: "enters an active tenant",
+			ctx: context.Background(),
+			tenantID: 1,
+		},
+		{
+			name: "refuses an inactive tenant",
+			ctx: context.Background(),
+			tenantID: 2,
+			expectedError: usecase.ErrTenantInactive,
+		},
+		{
+			name: "refuses an unknown tenant",
+			ctx: context.Background(),
+			tenantID: 3,
+			expectedError: usecase.ErrTenantNotFound,
+		},
+		{
+			name: "cannot switch from one tenant to another",
+			ctx: tenant.WithID(context.Background(), 2),
+			tenantID: 1,
+			expectedError: usecase.ErrTenantNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoMock := portsMock.NewMockRepository(t)
+			repoMock.On("GetTenant", mock.Anything, 1).Return(domain.Tenant{ID: 1, Active: true}, nil).Maybe()
+			repoMock.On("GetTenant", mock.Anything, 2).Return(domain.Tenant{ID: 2, Active: false}, nil).Maybe()
+			repoMock.On("GetTenant", mock.Anything, 3).Return(domain.Tenant{}, ports.ErrTenantNotFound).Maybe()
+
+			service := usecase.New(repoMock)
+			ctx, err := service.Enter(tt.ctx, tt.tenantID)
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				tenantID, ok := tenant.IDFromContext(ctx)
+				require.True(t, ok)
+				require.Equal(t, tt.tenantID, tenantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Package scoped confines a time entry repository to the tenant carried in the
+// request context, through the project each time entry belongs to. Time entries
+// and timesheets of projects in other tenants are reported as not found.
+// Platform calls see every tenant, and requests carrying neither see no time
+// entries at all.
+package scoped
+
+import (
+	"context"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/timesheet/domain"
+	"github.com/captainhbb/tbs-backend/internal/timesheet/ports"
+	projectScoped "github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+)
+
+type repository struct {
+	next			ports.Repository
+	projectRepo		projectPorts.Repository
+}
+
+// New wraps next so that every query is confined to the projects of the
+// tenant in ctx, which projectRepo looks up.
+func New(next ports.Repository, projectRepo projectPorts.Repository) ports.Repository {
+	return &repository{next: next, projectRepo: projectRepo}
+}
+
+func(r *repository) CreateEntry(ctx context.Context, entry domain.TimeEntry) (domain.TimeEntry, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, entry.ProjectID)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	return r.next.CreateEntry(ctx, entry)
+}
+
+func(r *repository) GetEntry(ctx context.Context, id int) (domain.TimeEntry, error) {
+	entry, err := r.next.GetEntry(ctx, id)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	err = projectScoped.CheckProject(ctx, r.projectRepo, entry.ProjectID)
+	switch err {
+	case nil:
+	case projectPorts.ErrProjectNotFound:
+		return domain.TimeEntry{}, ports.ErrEntryNotFound
+	default:
+		return domain.TimeEntry{}, err
+	}
+	return entry, nil
+}
+
+// UpdateEntry keeps the time entry in the project it belongs to.
+func(r *repository) UpdateEntry(ctx context.Context, entry domain.TimeEntry) (domain.TimeEntry, error) {
+	existingTimeEntry, err := r.GetEntry(ctx, entry.ID)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	entry.ProjectID = existingTimeEntry.ProjectID
+	return r.next.UpdateEntry(ctx, entry)
+}
+
+func(r *repository) DeleteEntry(ctx context.Context, id int) error {
+	_, err := r.GetEntry(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeleteEntry(ctx, id)
+}
+
+// ListEntries checks the filter's project, or leaves out the entries of
+// projects in other tenants when it names none.
+func(r *repository) ListEntries(ctx context.Context, filter domain.EntryFilter) ([]domain.TimeEntry, error) {
+	if filter.ProjectID != 0 {
+		err := projectScoped.CheckProject(ctx, r.projectRepo, filter.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		return r.next.ListEntries(ctx, filter)
+	}
+
+	entries, err := r.next.ListEntries(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	visible := make([]domain.TimeEntry, 0, len(entries))
+	checked := make(map[int]bool)
+	for _, entry := range entries {
+		projectVisible, ok := checked[entry.ProjectID]
+		if !ok {
+			err := projectScoped.CheckProject(ctx, r.projectRepo, entry.ProjectID)
+			switch err {
+			case nil:
+				projectVisible = true
+			case projectPorts.ErrProjectNotFound:
+			default:
+				return nil, err
+			}
+			checked[entry.ProjectID] = projectVisible
+		}
+		if projectVisible {
+			visible = append(visible, entry)
+		}
+	}
+	return visible, nil
+}
+
+func(r *repository) GetTimesheet(ctx context.Context, userID int, projectID int, weekStart time.Time) (domain.Timesheet, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, projectID)
+	switch err {
+	case nil:
+	case projectPorts.ErrProjectNotFound:
+		return domain.Timesheet{}, ports.ErrTimesheetNotFound
+	default:
+		return domain.Timesheet{}, err
+	}
+	return r.next.GetTimesheet(ctx, userID, projectID, weekStart)
+}
+
+func(r *repository) SaveTimesheet(ctx context.Context, timesheet domain.Timesheet) (domain.Timesheet, error) {
+	err := projectScoped.CheckProject(ctx, r.projectRepo, timesheet.ProjectID)
+	if err != nil {
+		return domain.Timesheet{}, err
+	}
+	return r.next.SaveTimesheet(ctx, timesheet)
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	StatusDraft					= "draft"
+	StatusSubmitted				= "submitted"
+	StatusApproved				= "approved"
+	StatusRejected				= "rejected"
+)
+
+// TimeEntry is time a user spent on a project on a single day.
+type TimeEntry struct {
+	ID 					int
+	UserID				int
+	ProjectID			int
+	// TaskID is zero when the time is not booked against a task.
+	TaskID				int
+	Date				time.Time
+	Duration			time.Duration
+	Billable			bool
+	Note				string
+}
+
+// Timesheet groups one user's entries on one project for the week starting on
+// WeekStart. A timesheet that has never been submitted is a draft and is not
+// stored.
+type Timesheet struct {
+	ID 					int
+	UserID				int
+	ProjectID			int
+	WeekStart			time.Time
+	Status				string
+	SubmittedAt			time.Time
+	DecidedAt			time.Time
+	DecidedBy			int
+	Comment				string
+	Entries				[]TimeEntry
+	Total				time.Duration
+	BillableTotal		time.Duration
+}
+
+// Locked reports whether the timesheet's entries may no longer change.
+func (t Timesheet) Locked() bool {
+	return t.Status == StatusSubmitted || t.Status == StatusApproved
+}
+
+// EntryFilter narrows a time entry query. Zero-valued fields do not filter.
+type EntryFilter struct {
+	UserID				int
+	ProjectID			int
+	TaskID				int
+	// From is inclusive and To is exclusive.
+	From				time.Time
+	To					time.Time
+}
+
+// Day truncates t to midnight UTC of its calendar date.
+func Day(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// WeekStart returns the Monday of the week containing t.
+func WeekStart(t time.Time) time.Time {
+	day := Day(t)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
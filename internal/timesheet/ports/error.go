@@ -0,0 +1,8 @@
+package ports
+
+import "errors"
+
+var (
+	ErrEntryNotFound			= errors.New("time entry not found")
+	ErrTimesheetNotFound		= errors.New("timesheet not found")
+)
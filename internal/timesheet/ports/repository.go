@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/captainhbb/tbs-backend/internal/timesheet/domain"
+)
+
+//go:generate mockery --dir . --name Repository --structname MockRepository --filename mock_repository.go --output ./mock --outpkg mock
+type Repository interface {
+	CreateEntry(ctx context.Context, entry domain.TimeEntry) (domain.TimeEntry, error)
+	GetEntry(ctx context.Context, id int) (domain.TimeEntry, error)
+	UpdateEntry(ctx context.Context, entry domain.TimeEntry) (domain.TimeEntry, error)
+	DeleteEntry(ctx context.Context, id int) error
+	// ListEntries returns the entries matching filter ordered by date.
+	ListEntries(ctx context.Context, filter domain.EntryFilter) ([]domain.TimeEntry, error)
+	// GetTimesheet returns ErrTimesheetNotFound for weeks that were never
+	// submitted. Entries and totals are not populated.
+	GetTimesheet(ctx context.Context, userID int, projectID int, weekStart time.Time) (domain.Timesheet, error)
+	// SaveTimesheet creates or updates the timesheet of its user, project and
+	// week.
+	SaveTimesheet(ctx context.Context, timesheet domain.Timesheet) (domain.Timesheet, error)
+}
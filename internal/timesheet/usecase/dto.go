@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"time"
+)
+
+type LogTimeRequest struct {
+	ProjectID 				int
+	TaskID 					int
+	Date 					time.Time
+	Duration 				time.Duration
+	Billable 				bool
+	Note 					string
+}
+
+type UpdateTimeEntryRequest struct {
+	ID 						int
+	TaskID 					int
+	Date 					time.Time
+	Duration 				time.Duration
+	Billable 				bool
+	Note 					string
+}
+
+// TimesheetRequest selects the timesheet of UserID on ProjectID for the week
+// containing Week.
+type TimesheetRequest struct {
+	UserID 					int
+	ProjectID 				int
+	Week 					time.Time
+}
+
+type DecideTimesheetRequest struct {
+	UserID 					int
+	ProjectID 				int
+	Week 					time.Time
+	Comment 				string
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import "errors"
+
+var (
+	ErrEntryNotFound			= errors.New("time entry not found")
+	ErrProjectNotFound			= errors.New("project not found")
+	ErrUserRequired				= errors.New("time can only be logged by a signed-in user")
+	ErrNotEntryOwner			= errors.New("only the user who logged a time entry can change it")
+	ErrInvalidDuration			= errors.New("duration must be positive and at most one day")
+	ErrOutsideProject			= errors.New("time entries must fall within the project's start and end dates")
+	ErrInvalidTask				= errors.New("task must belong to the entry's project")
+	ErrPeriodLocked				= errors.New("the timesheet for this week is submitted or approved")
+	ErrEmptyTimesheet			= errors.New("timesheet has no entries")
+	ErrNotSubmitted				= errors.New("timesheet is not submitted")
+	ErrNotProjectOwner			= errors.New("only the project owner can approve timesheets")
+	ErrSelfApproval				= errors.New("timesheets cannot be decided by their own user")
+	ErrNotOwnerApprover			= errors.New("the project owner's timesheets can only be decided by an admin or a project manager")
+	ErrDailyLimitExceeded		= errors.New("time logged on one day must not exceed 24 hours")
+)
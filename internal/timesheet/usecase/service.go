@@ -0,0 +1,411 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	alertUseCase "github.com/captainhbb/tbs-backend/internal/alert/usecase"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
+	taskPorts "github.com/captainhbb/tbs-backend/internal/task/ports"
+	"github.com/captainhbb/tbs-backend/internal/timesheet/domain"
+	"github.com/captainhbb/tbs-backend/internal/timesheet/ports"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+)
+
+// MaxDailyDuration caps the time a user can log on one day, across all of
+// their projects.
+const MaxDailyDuration = 24 * time.Hour
+
+//go:generate mockery --dir . --name TimesheetService --structname MockTimesheetService --filename mock_timesheet_service.go --output ./mock --outpkg mock
+type TimesheetService interface {
+	// LogTime records time for the acting user.
+	LogTime(ctx context.Context, entry LogTimeRequest) (domain.TimeEntry, error)
+	UpdateEntry(ctx context.Context, entry UpdateTimeEntryRequest) (domain.TimeEntry, error)
+	DeleteEntry(ctx context.Context, id int) error
+	// ListEntries returns project entries when filter names a project the acting
+	// user may view, and otherwise the acting user's own entries.
+	ListEntries(ctx context.Context, filter domain.EntryFilter) ([]domain.TimeEntry, error)
+	GetTimesheet(ctx context.Context, timesheet TimesheetRequest) (domain.Timesheet, error)
+	// SubmitTimesheet submits the acting user's timesheet for approval and locks
+	// its entries. The user must still be able to log time on the project.
+	SubmitTimesheet(ctx context.Context, timesheet TimesheetRequest) (domain.Timesheet, error)
+	// ApproveTimesheet is reserved for the project owner, who cannot decide
+	// on their own timesheet. The owner's timesheets are decided by a global
+	// admin or a manager of the project instead. Approved hours count towards
+	// the project's spend, so its budget alerts are evaluated again.
+	ApproveTimesheet(ctx context.Context, decision DecideTimesheetRequest) (domain.Timesheet, error)
+	// RejectTimesheet returns a submitted timesheet to its user and unlocks it.
+	RejectTimesheet(ctx context.Context, decision DecideTimesheetRequest) (domain.Timesheet, error)
+}
+
+type timesheetService struct {
+	repo ports.Repository
+	projectRepo projectPorts.Repository
+	taskRepo taskPorts.Repository
+	membershipService membershipUseCase.MembershipService
+	alertService alertUseCase.AlertService
+}
+
+func New(repo ports.Repository, projectRepo projectPorts.Repository, taskRepo taskPorts.Repository, membershipService membershipUseCase.MembershipService, alertService alertUseCase.AlertService) TimesheetService {
+	return &timesheetService{
+		repo: repo,
+		projectRepo: projectRepo,
+		taskRepo: taskRepo,
+		membershipService: membershipService,
+		alertService: alertService,
+	}
+}
+
+func(s *timesheetService) LogTime(ctx context.Context, logTimeRequest LogTimeRequest) (domain.TimeEntry, error) {
+	userID, ok := actor.IDFromContext(ctx)
+	if !ok {
+		return domain.TimeEntry{}, ErrUserRequired
+	}
+
+	entry := domain.TimeEntry{
+		UserID: userID,
+		ProjectID: logTimeRequest.ProjectID,
+		TaskID: logTimeRequest.TaskID,
+		Date: domain.Day(logTimeRequest.Date),
+		Duration: logTimeRequest.Duration,
+		Billable: logTimeRequest.Billable,
+		Note: logTimeRequest.Note,
+	}
+
+	err := s.membershipService.Authorize(ctx, entry.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	err = s.validate(ctx, entry)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	err = s.checkUnlocked(ctx, entry)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	return s.repo.CreateEntry(ctx, entry)
+}
+
+func(s *timesheetService) UpdateEntry(ctx context.Context, updateEntryRequest UpdateTimeEntryRequest) (domain.TimeEntry, error) {
+	existingEntry, err := s.ownEntry(ctx, updateEntryRequest.ID)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	entry := existingEntry
+	entry.TaskID = updateEntryRequest.TaskID
+	entry.Date = domain.Day(updateEntryRequest.Date)
+	entry.Duration = updateEntryRequest.Duration
+	entry.Billable = updateEntryRequest.Billable
+	entry.Note = updateEntryRequest.Note
+
+	err = s.validate(ctx, entry)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	for _, affected := range []domain.TimeEntry{existingEntry, entry} {
+		err = s.checkUnlocked(ctx, affected)
+		if err != nil {
+			return domain.TimeEntry{}, err
+		}
+	}
+
+	updatedEntry, err := s.repo.UpdateEntry(ctx, entry)
+	switch err {
+	case ports.ErrEntryNotFound:
+		return domain.TimeEntry{}, ErrEntryNotFound
+	}
+	return updatedEntry, err
+}
+
+func(s *timesheetService) DeleteEntry(ctx context.Context, id int) error {
+	entry, err := s.ownEntry(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkUnlocked(ctx, entry)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteEntry(ctx, id)
+	switch err {
+	case ports.ErrEntryNotFound:
+		return ErrEntryNotFound
+	}
+	return err
+}
+
+func(s *timesheetService) ListEntries(ctx context.Context, filter domain.EntryFilter) ([]domain.TimeEntry, error) {
+	if filter.ProjectID != 0 {
+		err := s.membershipService.Authorize(ctx, filter.ProjectID, membershipDomain.PermissionView)
+		if err != nil {
+			return nil, err
+		}
+		return s.repo.ListEntries(ctx, filter)
+	}
+
+	userID, ok := actor.IDFromContext(ctx)
+	if !ok {
+		return nil, ErrUserRequired
+	}
+	filter.UserID = userID
+	return s.repo.ListEntries(ctx, filter)
+}
+
+func(s *timesheetService) GetTimesheet(ctx context.Context, timesheetRequest TimesheetRequest) (domain.Timesheet, error) {
+	err := s.membershipService.Authorize(ctx, timesheetRequest.ProjectID, membershipDomain.PermissionView)
+	if err != nil {
+		return domain.Timesheet{}, err
+	}
+	return s.timesheet(ctx, timesheetRequest.UserID, timesheetRequest.ProjectID, timesheetRequest.Week)
+}
+
+func(s *timesheetService) SubmitTimesheet(ctx context.Context, timesheetRequest TimesheetRequest) (domain.Timesheet, error) {
+	userID, ok := actor.IDFromContext(ctx)
+	if !ok {
+		return domain.Timesheet{}, ErrUserRequired
+	}
+
+	err := s.membershipService.Authorize(ctx, timesheetRequest.ProjectID, membershipDomain.PermissionEdit)
+	if err != nil {
+		return domain.Timesheet{}, err
+	}
+
+	timesheet, err := s.timesheet(ctx, userID, timesheetRequest.ProjectID, timesheetRequest.Week)
+	if err != nil {
+		return domain.Timesheet{}, err
+	}
+	if timesheet.Locked() {
+		return domain.Timesheet{}, ErrPeriodLocked
+	}
+	if len(timesheet.Entries) == 0 {
+		return domain.Timesheet{}, ErrEmptyTimesheet
+	}
+
+	timesheet.Status = domain.StatusSubmitted
+	timesheet.SubmittedAt = time.Now()
+	timesheet.DecidedAt = time.Time{}
+	timesheet.DecidedBy = 0
+	timesheet.Comment = ""
+	return s.saveTimesheet(ctx, timesheet)
+}
+
+func(s *timesheetService) ApproveTimesheet(ctx context.Context, decideRequest DecideTimesheetRequest) (domain.Timesheet, error) {
+	timesheet, err := s.decide(ctx, decideRequest, domain.StatusApproved)
+	if err != nil {
+		return domain.Timesheet{}, err
+	}
+	s.evaluateAlerts(ctx, timesheet.ProjectID)
+	return timesheet, nil
+}
+
+func(s *timesheetService) RejectTimesheet(ctx context.Context, decideRequest DecideTimesheetRequest) (domain.Timesheet, error) {
+	return s.decide(ctx, decideRequest, domain.StatusRejected)
+}
+
+func(s *timesheetService) decide(ctx context.Context, decideRequest DecideTimesheetRequest, status string) (domain.Timesheet, error) {
+	project, err := s.getProject(ctx, decideRequest.ProjectID)
+	if err != nil {
+		return domain.Timesheet{}, err
+	}
+	deciderID, ok := actor.IDFromContext(ctx)
+	if !ok {
+		return domain.Timesheet{}, ErrNotProjectOwner
+	}
+	if deciderID == decideRequest.UserID {
+		return domain.Timesheet{}, ErrSelfApproval
+	}
+	if decideRequest.UserID == project.OwnerID {
+		err := s.membershipService.Authorize(ctx, project.ID, membershipDomain.PermissionManageMembers)
+		switch err {
+		case nil:
+		case membershipUseCase.ErrForbidden:
+			return domain.Timesheet{}, ErrNotOwnerApprover
+		default:
+			return domain.Timesheet{}, err
+		}
+	} else if deciderID != project.OwnerID {
+		return domain.Timesheet{}, ErrNotProjectOwner
+	}
+
+	timesheet, err := s.timesheet(ctx, decideRequest.UserID, decideRequest.ProjectID, decideRequest.Week)
+	if err != nil {
+		return domain.Timesheet{}, err
+	}
+	if timesheet.Status != domain.StatusSubmitted {
+		return domain.Timesheet{}, ErrNotSubmitted
+	}
+
+	timesheet.Status = status
+	timesheet.DecidedAt = time.Now()
+	timesheet.DecidedBy = deciderID
+	timesheet.Comment = decideRequest.Comment
+	return s.saveTimesheet(ctx, timesheet)
+}
+
+// timesheet assembles the week's timesheet from its stored state, if any, and
+// its entries.
+func(s *timesheetService) timesheet(ctx context.Context, userID int, projectID int, week time.Time) (domain.Timesheet, error) {
+	weekStart := domain.WeekStart(week)
+	timesheet, err := s.repo.GetTimesheet(ctx, userID, projectID, weekStart)
+	switch err {
+	case nil:
+	case ports.ErrTimesheetNotFound:
+		timesheet = domain.Timesheet{
+			UserID: userID,
+			ProjectID: projectID,
+			WeekStart: weekStart,
+			Status: domain.StatusDraft,
+		}
+	default:
+		return domain.Timesheet{}, err
+	}
+
+	entries, err := s.repo.ListEntries(ctx, domain.EntryFilter{
+		UserID: userID,
+		ProjectID: projectID,
+		From: weekStart,
+		To: weekStart.AddDate(0, 0, 7),
+	})
+	if err != nil {
+		return domain.Timesheet{}, err
+	}
+
+	timesheet.Entries = entries
+	for _, entry := range entries {
+		timesheet.Total += entry.Duration
+		if entry.Billable {
+			timesheet.BillableTotal += entry.Duration
+		}
+	}
+	return timesheet, nil
+}
+
+func(s *timesheetService) saveTimesheet(ctx context.Context, timesheet domain.Timesheet) (domain.Timesheet, error) {
+	savedTimesheet, err := s.repo.SaveTimesheet(ctx, timesheet)
+	if err != nil {
+		return domain.Timesheet{}, err
+	}
+	savedTimesheet.Entries = timesheet.Entries
+	savedTimesheet.Total = timesheet.Total
+	savedTimesheet.BillableTotal = timesheet.BillableTotal
+	return savedTimesheet, nil
+}
+
+func(s *timesheetService) validate(ctx context.Context, entry domain.TimeEntry) error {
+	if entry.Duration <= 0 || entry.Duration > MaxDailyDuration {
+		return ErrInvalidDuration
+	}
+
+	project, err := s.getProject(ctx, entry.ProjectID)
+	if err != nil {
+		return err
+	}
+	if !project.StartDate.IsZero() && entry.Date.Before(domain.Day(project.StartDate)) {
+		return ErrOutsideProject
+	}
+	if !project.EndDate.IsZero() && entry.Date.After(domain.Day(project.EndDate)) {
+		return ErrOutsideProject
+	}
+
+	if entry.TaskID != 0 {
+		task, err := s.taskRepo.GetTask(ctx, entry.TaskID)
+		switch err {
+		case nil:
+		case taskPorts.ErrTaskNotFound:
+			return ErrInvalidTask
+		default:
+			return err
+		}
+		if task.ProjectID != entry.ProjectID {
+			return ErrInvalidTask
+		}
+	}
+	return s.checkDailyDuration(ctx, entry)
+}
+
+// checkDailyDuration fails when entry would take the time its user logged on
+// its day, on any project, past MaxDailyDuration. The stored version of entry,
+// when it is being updated, is left out of the sum.
+func(s *timesheetService) checkDailyDuration(ctx context.Context, entry domain.TimeEntry) error {
+	entries, err := s.repo.ListEntries(ctx, domain.EntryFilter{
+		UserID: entry.UserID,
+		From: entry.Date,
+		To: entry.Date.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		return err
+	}
+
+	total := entry.Duration
+	for _, logged := range entries {
+		if logged.ID != entry.ID {
+			total += logged.Duration
+		}
+	}
+	if total > MaxDailyDuration {
+		return ErrDailyLimitExceeded
+	}
+	return nil
+}
+
+// checkUnlocked fails when the week of entry has been submitted or approved.
+func(s *timesheetService) checkUnlocked(ctx context.Context, entry domain.TimeEntry) error {
+	timesheet, err := s.repo.GetTimesheet(ctx, entry.UserID, entry.ProjectID, domain.WeekStart(entry.Date))
+	switch err {
+	case nil:
+	case ports.ErrTimesheetNotFound:
+		return nil
+	default:
+		return err
+	}
+
+	if timesheet.Locked() {
+		return ErrPeriodLocked
+	}
+	return nil
+}
+
+func(s *timesheetService) ownEntry(ctx context.Context, id int) (domain.TimeEntry, error) {
+	entry, err := s.repo.GetEntry(ctx, id)
+	switch err {
+	case nil:
+	case ports.ErrEntryNotFound:
+		return domain.TimeEntry{}, ErrEntryNotFound
+	default:
+		return domain.TimeEntry{}, err
+	}
+
+	userID, _ := actor.IDFromContext(ctx)
+	if userID == 0 || userID != entry.UserID {
+		return domain.TimeEntry{}, ErrNotEntryOwner
+	}
+	return entry, nil
+}
+
+func(s *timesheetService) getProject(ctx context.Context, projectID int) (projectDomain.Project, error) {
+	project, err := s.projectRepo.GetProject(ctx, projectID)
+	switch err {
+	case projectPorts.ErrProjectNotFound:
+		return projectDomain.Project{}, ErrProjectNotFound
+	}
+	return project, err
+}
+
+// evaluateAlerts re-checks the project's alert rules once approved hours add to
+// its labor cost. The approval is already stored by then, so a failed
+// evaluation is logged rather than reported to the caller.
+func(s *timesheetService) evaluateAlerts(ctx context.Context, projectID int) {
+	_, err := s.alertService.Evaluate(ctx, projectID)
+	if err != nil {
+		slog.ErrorContext(ctx, "evaluating budget alerts", "project_id", projectID, "error", err)
+	}
+}
@@ -0,0 +1,243 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	alertUseCaseMock "github.com/captainhbb/tbs-backend/internal/alert/usecase/mock"
+	membershipDomain "github.com/captainhbb/tbs-backend/internal/membership/domain"
+	membershipUseCase "github.com/captainhbb/tbs-backend/internal/membership/usecase"
+	membershipUseCaseMock "github.com/captainhbb/tbs-backend/internal/membership/usecase/mock"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	taskDomain "github.com/captainhbb/tbs-backend/internal/task/domain"
+	taskPortsMock "github.com/captainhbb/tbs-backend/internal/task/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/timesheet/domain"
+	"github.com/captainhbb/tbs-backend/internal/timesheet/ports"
+	portsMock "github.com/captainhbb/tbs-backend/internal/timesheet/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/timesheet/usecase"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// Wednesday; its week starts on Monday 2024-03-04.
+	wednesday = time.Date(2024, 3, 6, 15, 0, 0, 0, time.UTC)
+	monday = time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	project = projectDomain.Project{
+		ID: 1,
+		OwnerID: 9,
+		StartDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+)
+
+func TestLogTime(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		actorID int
+		input usecase.LogTimeRequest
+		// loggedThatDay is the time the actor already logged on the entry's day.
+		loggedThatDay []domain.TimeEntry
+		mockSetup func(repo *portsMock.MockRepository, taskRepo *taskPortsMock.MockRepository)
+		expectError bool
+		expectedError error
+	}{
+		{
+			name: "success",
+			actorID: 2,
+			input: usecase.LogTimeRequest{ProjectID: 1, TaskID: 4, Date: wednesday, Duration: 90 * time.Minute, Billable: true},
+			mockSetup: func(repo *portsMock.MockRepository, taskRepo *taskPortsMock.MockRepository) {
+				taskRepo.On("GetTask", mock.Anything, 4).Return(taskDomain.Task{ID: 4, ProjectID: 1}, nil)
+				repo.On("GetTimesheet", mock.Anything, 2, 1, monday).Return(domain.Timesheet{}, ports.ErrTimesheetNotFound)
+				repo.On("CreateEntry", mock.Anything, mock.Anything).Return(func(_ context.Context, entry domain.TimeEntry) (domain.TimeEntry, error) {
+					entry.ID = 1
+					return entry, nil
+				})
+			},
+		},
+		{
+			name: "approved week is locked",
+			actorID: 2,
+			input: usecase.LogTimeRequest{ProjectID: 1, Date: wednesday, Duration: time.Hour},
+			mockSetup: func(repo *portsMock.MockRepository, _ *taskPortsMock.MockRepository) {
+				repo.On("GetTimesheet", mock.Anything, 2, 1, monday).Return(domain.Timesheet{ID: 3, Status: domain.StatusApproved}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrPeriodLocked,
+		},
+		{
+			name: "before project start",
+			actorID: 2,
+			input: usecase.LogTimeRequest{ProjectID: 1, Date: time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), Duration: time.Hour},
+			mockSetup: func(_ *portsMock.MockRepository, _ *taskPortsMock.MockRepository) {},
+			expectError: true,
+			expectedError: usecase.ErrOutsideProject,
+		},
+		{
+			name: "longer than a day",
+			actorID: 2,
+			input: usecase.LogTimeRequest{ProjectID: 1, Date: wednesday, Duration: 25 * time.Hour},
+			mockSetup: func(_ *portsMock.MockRepository, _ *taskPortsMock.MockRepository) {},
+			expectError: true,
+			expectedError: usecase.ErrInvalidDuration,
+		},
+		{
+			name: "more than a day across projects",
+			actorID: 2,
+			input: usecase.LogTimeRequest{ProjectID: 1, Date: wednesday, Duration: 3 * time.Hour},
+			loggedThatDay: []domain.TimeEntry{
+				{ID: 1, UserID: 2, ProjectID: 1, Duration: 10 * time.Hour},
+				{ID: 2, UserID: 2, ProjectID: 3, Duration: 12 * time.Hour},
+			},
+			mockSetup: func(_ *portsMock.MockRepository, _ *taskPortsMock.MockRepository) {},
+			expectError: true,
+			expectedError: usecase.ErrDailyLimitExceeded,
+		},
+		{
+			name: "task of another project",
+			actorID: 2,
+			input: usecase.LogTimeRequest{ProjectID: 1, TaskID: 4, Date: wednesday, Duration: time.Hour},
+			mockSetup: func(_ *portsMock.MockRepository, taskRepo *taskPortsMock.MockRepository) {
+				taskRepo.On("GetTask", mock.Anything, 4).Return(taskDomain.Task{ID: 4, ProjectID: 2}, nil)
+			},
+			expectError: true,
+			expectedError: usecase.ErrInvalidTask,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			taskRepoMock := taskPortsMock.NewMockRepository(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			service := usecase.New(repoMock, projectRepoMock, taskRepoMock, membershipServiceMock, alertUseCaseMock.NewMockAlertService(t))
+
+			membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+			projectRepoMock.On("GetProject", mock.Anything, 1).Return(project, nil).Maybe()
+			day := domain.Day(tt.input.Date)
+			repoMock.On("ListEntries", mock.Anything, domain.EntryFilter{UserID: tt.actorID, From: day, To: day.AddDate(0, 0, 1)}).Return(tt.loggedThatDay, nil).Maybe()
+			tt.mockSetup(repoMock, taskRepoMock)
+
+			entry, err := service.LogTime(actor.WithID(context.Background(), tt.actorID), tt.input)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.actorID, entry.UserID)
+				require.Equal(t, time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC), entry.Date)
+			}
+		})
+	}
+}
+
+func TestSubmitTimesheet(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	service := usecase.New(repoMock, projectPortsMock.NewMockRepository(t), taskPortsMock.NewMockRepository(t), membershipServiceMock, alertUseCaseMock.NewMockAlertService(t))
+
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(nil)
+	repoMock.On("GetTimesheet", mock.Anything, 2, 1, monday).Return(domain.Timesheet{}, ports.ErrTimesheetNotFound)
+	repoMock.On("ListEntries", mock.Anything, domain.EntryFilter{UserID: 2, ProjectID: 1, From: monday, To: monday.AddDate(0, 0, 7)}).Return([]domain.TimeEntry{
+		{ID: 1, UserID: 2, ProjectID: 1, Date: monday, Duration: 8 * time.Hour, Billable: true},
+		{ID: 2, UserID: 2, ProjectID: 1, Date: monday.AddDate(0, 0, 1), Duration: 2 * time.Hour},
+	}, nil)
+	repoMock.On("SaveTimesheet", mock.Anything, mock.Anything).Return(func(_ context.Context, timesheet domain.Timesheet) (domain.Timesheet, error) {
+		timesheet.ID = 5
+		return timesheet, nil
+	})
+
+	timesheet, err := service.SubmitTimesheet(actor.WithID(context.Background(), 2), usecase.TimesheetRequest{ProjectID: 1, Week: wednesday})
+	require.NoError(t, err)
+	require.Equal(t, domain.StatusSubmitted, timesheet.Status)
+	require.Equal(t, monday, timesheet.WeekStart)
+	require.Equal(t, 10 * time.Hour, timesheet.Total)
+	require.Equal(t, 8 * time.Hour, timesheet.BillableTotal)
+}
+
+func TestSubmitTimesheetAfterLeavingProject(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+	service := usecase.New(repoMock, projectPortsMock.NewMockRepository(t), taskPortsMock.NewMockRepository(t), membershipServiceMock, alertUseCaseMock.NewMockAlertService(t))
+
+	membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionEdit).Return(membershipUseCase.ErrForbidden)
+
+	_, err := service.SubmitTimesheet(actor.WithID(context.Background(), 2), usecase.TimesheetRequest{ProjectID: 1, Week: wednesday})
+	require.ErrorIs(t, err, membershipUseCase.ErrForbidden)
+}
+
+func TestApproveTimesheet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		actorID int
+		userID int
+		status string
+		expectedError error
+	}{
+		{name: "owner approves", actorID: 9, userID: 2, status: domain.StatusSubmitted},
+		{name: "not the owner", actorID: 3, userID: 2, status: domain.StatusSubmitted, expectedError: usecase.ErrNotProjectOwner},
+		{name: "not submitted", actorID: 9, userID: 2, status: domain.StatusRejected, expectedError: usecase.ErrNotSubmitted},
+		{name: "owner's own timesheet", actorID: 9, userID: 9, status: domain.StatusSubmitted, expectedError: usecase.ErrSelfApproval},
+		{name: "manager approves the owner's timesheet", actorID: 4, userID: 9, status: domain.StatusSubmitted},
+		{name: "contributor cannot approve the owner's timesheet", actorID: 3, userID: 9, status: domain.StatusSubmitted, expectedError: usecase.ErrNotOwnerApprover},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			membershipServiceMock := membershipUseCaseMock.NewMockMembershipService(t)
+			alertServiceMock := alertUseCaseMock.NewMockAlertService(t)
+			service := usecase.New(repoMock, projectRepoMock, taskPortsMock.NewMockRepository(t), membershipServiceMock, alertServiceMock)
+
+			projectRepoMock.On("GetProject", mock.Anything, 1).Return(project, nil)
+			membershipServiceMock.On("Authorize", mock.Anything, 1, membershipDomain.PermissionManageMembers).Return(func(ctx context.Context, _ int, _ string) error {
+				if actorID, _ := actor.IDFromContext(ctx); actorID != 4 {
+					return membershipUseCase.ErrForbidden
+				}
+				return nil
+			}).Maybe()
+			repoMock.On("GetTimesheet", mock.Anything, tt.userID, 1, monday).Return(domain.Timesheet{ID: 5, UserID: tt.userID, ProjectID: 1, WeekStart: monday, Status: tt.status}, nil).Maybe()
+			repoMock.On("ListEntries", mock.Anything, mock.Anything).Return([]domain.TimeEntry{}, nil).Maybe()
+			repoMock.On("SaveTimesheet", mock.Anything, mock.Anything).Return(func(_ context.Context, timesheet domain.Timesheet) (domain.Timesheet, error) {
+				return timesheet, nil
+			}).Maybe()
+			alertServiceMock.On("Evaluate", mock.Anything, 1).Return(nil, nil).Maybe()
+
+			timesheet, err := service.ApproveTimesheet(actor.WithID(context.Background(), tt.actorID), usecase.DecideTimesheetRequest{UserID: tt.userID, ProjectID: 1, Week: wednesday})
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				alertServiceMock.AssertNotCalled(t, "Evaluate", mock.Anything, mock.Anything)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, domain.StatusApproved, timesheet.Status)
+				require.Equal(t, tt.actorID, timesheet.DecidedBy)
+				require.True(t, timesheet.Locked())
+				alertServiceMock.AssertCalled(t, "Evaluate", mock.Anything, 1)
+			}
+		})
+	}
+}
+
+func TestUpdateEntryOfAnotherUser(t *testing.T) {
+	t.Parallel()
+
+	repoMock := portsMock.NewMockRepository(t)
+	service := usecase.New(repoMock, projectPortsMock.NewMockRepository(t), taskPortsMock.NewMockRepository(t), membershipUseCaseMock.NewMockMembershipService(t), alertUseCaseMock.NewMockAlertService(t))
+
+	repoMock.On("GetEntry", mock.Anything, 1).Return(domain.TimeEntry{ID: 1, UserID: 2, ProjectID: 1}, nil)
+
+	_, err := service.UpdateEntry(actor.WithID(context.Background(), 3), usecase.UpdateTimeEntryRequest{ID: 1, Date: wednesday, Duration: time.Hour})
+	require.ErrorIs(t, err, usecase.ErrNotEntryOwner)
+}
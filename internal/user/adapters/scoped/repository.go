@@ -0,0 +1,69 @@
+// Package scoped confines a user repository to the tenant carried in the
+// request context. Users of other tenants are reported as not found, platform
+// calls see every tenant, and requests carrying neither see no users at all.
+package scoped
+
+import (
+	"context"
+
+	"github.com/captainhbb/tbs-backend/internal/user/domain"
+	"github.com/captainhbb/tbs-backend/internal/user/ports"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+)
+
+type repository struct {
+	next		ports.Repository
+}
+
+// New wraps next so that every query is filtered by the tenant in ctx and new
+// users are created within it, which fails with tenant.ErrTenantRequired when
+// ctx carries none.
+func New(next ports.Repository) ports.Repository {
+	return &repository{next: next}
+}
+
+func(r *repository) CreateUser(ctx context.Context, user domain.User) (domain.User, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return domain.User{}, err
+	}
+	user.TenantID = tenantID
+	return r.next.CreateUser(ctx, user)
+}
+
+func(r *repository) GetUser(ctx context.Context, id int) (domain.User, error) {
+	user, err := r.next.GetUser(ctx, id)
+	if err != nil {
+		return domain.User{}, err
+	}
+	if !tenant.Visible(ctx, user.TenantID) {
+		return domain.User{}, ports.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// UpdateUser keeps the user in the tenant it belongs to.
+func(r *repository) UpdateUser(ctx context.Context, user domain.User) (domain.User, error) {
+	existingUser, err := r.GetUser(ctx, user.ID)
+	if err != nil {
+		return domain.User{}, err
+	}
+	user.TenantID = existingUser.TenantID
+	return r.next.UpdateUser(ctx, user)
+}
+
+func(r *repository) DeleteUser(ctx context.Context, id int) error {
+	_, err := r.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeleteUser(ctx, id)
+}
+
+func(r *repository) DeactivateUser(ctx context.Context, id int) error {
+	_, err := r.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.next.DeactivateUser(ctx, id)
+}
@@ -1,14 +1,21 @@
 package domain
 
+const (
+	RoleAdmin			= "admin"
+	RoleManager			= "manager"
+)
 
 type User struct {
 	ID 					int
+	// TenantID is the organization the user belongs to.
+	TenantID			int
 	Username			string
 	FirstName 			string
 	LastName			string
 	Phone				string
 	Email				string
-	HashedPassword		string
+	HashedPassword		string		`audit:"-"`
 	Role				string
+	Active				bool
 }
 
@@ -42,6 +42,24 @@ func (_m *MockRepository) CreateUser(ctx context.Context, user domain.User) (dom
 	return r0, r1
 }
 
+// DeactivateUser provides a mock function with given fields: ctx, id
+func (_m *MockRepository) DeactivateUser(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteUser provides a mock function with given fields: ctx, id
 func (_m *MockRepository) DeleteUser(ctx context.Context, id int) error {
 	ret := _m.Called(ctx, id)
@@ -12,5 +12,6 @@ type Repository interface {
 	GetUser(ctx context.Context, id int) (domain.User, error)
 	UpdateUser(ctx context.Context, user domain.User) (domain.User, error)
 	DeleteUser(ctx context.Context, id int) error
+	DeactivateUser(ctx context.Context, id int) error
 }
 
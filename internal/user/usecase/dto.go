@@ -21,3 +21,12 @@ type UpdateUserRequest struct {
 	Role           string
 }
 
+type DeleteUserRequest struct {
+	ID				int
+	SuccessorID		int
+}
+
+type DeactivateUserRequest struct {
+	ID				int
+	SuccessorID		int
+}
@@ -7,4 +7,8 @@ var (
 	ErrPasswordGeneration		= errors.New("failed to generate password")
 	ErrUserNotFound 			= errors.New("user not found")
 	ErrUsernameAlreadyExists	= errors.New("username already exists")
+	ErrUserOwnsProjects			= errors.New("user owns projects, a successor is required")
+	ErrSuccessorNotFound		= errors.New("successor not found")
+	ErrInvalidSuccessor			= errors.New("successor must be another active user")
+	ErrForbidden				= errors.New("not allowed to perform this action")
 )
\ No newline at end of file
@@ -44,17 +44,35 @@ func (_m *MockUserService) CreateUser(ctx context.Context, user usecase.CreateUs
 	return r0, r1
 }
 
-// DeleteUser provides a mock function with given fields: ctx, id
-func (_m *MockUserService) DeleteUser(ctx context.Context, id int) error {
-	ret := _m.Called(ctx, id)
+// DeactivateUser provides a mock function with given fields: ctx, user
+func (_m *MockUserService) DeactivateUser(ctx context.Context, user usecase.DeactivateUserRequest) error {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.DeactivateUserRequest) error); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUser provides a mock function with given fields: ctx, user
+func (_m *MockUserService) DeleteUser(ctx context.Context, user usecase.DeleteUserRequest) error {
+	ret := _m.Called(ctx, user)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteUser")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.DeleteUserRequest) error); ok {
+		r0 = rf(ctx, user)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -90,6 +108,31 @@ func (_m *MockUserService) GetUser(ctx context.Context, id int) (domain.User, er
 	return r0, r1
 }
 
+// RequireRole provides a mock function with given fields: ctx, roles
+func (_m *MockUserService) RequireRole(ctx context.Context, roles ...string) error {
+	_va := make([]interface{}, len(roles))
+	for _i := range roles {
+		_va[_i] = roles[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequireRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) error); ok {
+		r0 = rf(ctx, roles...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateUser provides a mock function with given fields: ctx, user
 func (_m *MockUserService) UpdateUser(ctx context.Context, user usecase.UpdateUserRequest) (domain.User, error) {
 	ret := _m.Called(ctx, user)
@@ -3,9 +3,14 @@ package usecase
 import (
 	"context"
 
+	auditDomain "github.com/captainhbb/tbs-backend/internal/audit/domain"
+	auditUseCase "github.com/captainhbb/tbs-backend/internal/audit/usecase"
+	projectPorts "github.com/captainhbb/tbs-backend/internal/project/ports"
 	"github.com/captainhbb/tbs-backend/internal/user/domain"
 	"github.com/captainhbb/tbs-backend/internal/user/ports"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
 	hash "github.com/captainhbb/tbs-backend/pkg/hash"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
 )
 
 //go:generate mockery --dir . --name UserService --structname MockUserService --filename mock_user_service.go --output ./mock --outpkg mock
@@ -13,16 +18,45 @@ type UserService interface {
 	CreateUser(ctx context.Context, user CreateUserRequest) (domain.User, error)
 	GetUser(ctx context.Context, id int) (domain.User, error)
 	UpdateUser(ctx context.Context, user UpdateUserRequest) (domain.User, error)
-	DeleteUser(ctx context.Context, id int) error
+	// DeleteUser and DeactivateUser are restricted to global admins.
+	DeleteUser(ctx context.Context, user DeleteUserRequest) error
+	DeactivateUser(ctx context.Context, user DeactivateUserRequest) error
+	// RequireRole checks that the user carried in ctx has one of roles and
+	// fails with ErrForbidden otherwise. Requests without an acting user are
+	// denied; the system actor is always allowed.
+	RequireRole(ctx context.Context, roles ...string) error
+}
+
+// ProjectHandover moves every project a user owns to another owner, the way
+// an ownership transfer does. It is implemented by the project service, which
+// depends on UserService in turn, so it is usually wired through a closure.
+//go:generate mockery --dir . --name ProjectHandover --structname MockProjectHandover --filename mock_project_handover.go --output ./mock --outpkg mock
+type ProjectHandover interface {
+	HandOverProjects(ctx context.Context, fromOwnerID int, toOwnerID int) error
+}
+
+// ProjectHandoverFunc adapts a function to ProjectHandover.
+type ProjectHandoverFunc func(ctx context.Context, fromOwnerID int, toOwnerID int) error
+
+func(f ProjectHandoverFunc) HandOverProjects(ctx context.Context, fromOwnerID int, toOwnerID int) error {
+	return f(ctx, fromOwnerID, toOwnerID)
 }
 
 type userService struct {
 	repo   ports.Repository
+	projectRepo projectPorts.Repository
+	auditService auditUseCase.AuditService
+	projectHandover ProjectHandover
+	transactions transaction.Manager
 }
 
-func New(repo ports.Repository) UserService {
+func New(repo ports.Repository, projectRepo projectPorts.Repository, auditService auditUseCase.AuditService, projectHandover ProjectHandover, transactions transaction.Manager) UserService {
 	return &userService{
 		repo: repo,
+		projectRepo: projectRepo,
+		auditService: auditService,
+		projectHandover: projectHandover,
+		transactions: transactions,
 	}
 }
 
@@ -44,15 +78,32 @@ func(s *userService) CreateUser(ctx context.Context, createUserRequest CreateUse
 		Phone: createUserRequest.Phone,
 		Role: createUserRequest.Role,
 		HashedPassword: hashedPassword,
+		Active: true,
 	}
 
-	createdUser, err := s.repo.CreateUser(ctx, user)
-	switch err {
-	case ports.ErrUsernameAlreadyExists:
-		return domain.User{}, ErrUsernameAlreadyExists
-	}
+	var createdUser domain.User
+	err = s.transactions.Do(ctx, func(ctx context.Context) error {
+		var err error
+		createdUser, err = s.repo.CreateUser(ctx, user)
+		switch err {
+		case nil:
+		case ports.ErrUsernameAlreadyExists:
+			return ErrUsernameAlreadyExists
+		default:
+			return err
+		}
 
-	return createdUser, err
+		return s.auditService.Record(ctx, auditUseCase.RecordRequest{
+			Action: auditDomain.ActionCreate,
+			EntityType: auditDomain.EntityUser,
+			EntityID: createdUser.ID,
+			After: createdUser,
+		})
+	})
+	if err != nil {
+		return domain.User{}, err
+	}
+	return createdUser, nil
 }
 
 func(s *userService) GetUser(ctx context.Context, id int) (domain.User, error) {
@@ -66,7 +117,37 @@ func(s *userService) GetUser(ctx context.Context, id int) (domain.User, error) {
 	return user, err
 }
 
+func(s *userService) RequireRole(ctx context.Context, roles ...string) error {
+	if actor.IsSystem(ctx) {
+		return nil
+	}
+	actorID, ok := actor.IDFromContext(ctx)
+	if !ok {
+		return ErrForbidden
+	}
+
+	user, err := s.GetUser(ctx, actorID)
+	switch err {
+	case nil:
+	case ErrUserNotFound:
+		return ErrForbidden
+	default:
+		return err
+	}
+	for _, role := range roles {
+		if user.Role == role {
+			return nil
+		}
+	}
+	return ErrForbidden
+}
+
 func(s *userService) UpdateUser(ctx context.Context, user UpdateUserRequest) (domain.User, error) {
+	existingUser, err := s.GetUser(ctx, user.ID)
+	if err != nil {
+		return domain.User{}, err
+	}
+
 	updatedUserDomain := domain.User{
 		ID: user.ID,
 		Username: user.Username,
@@ -77,22 +158,136 @@ func(s *userService) UpdateUser(ctx context.Context, user UpdateUserRequest) (do
 		Role: user.Role,
 	}
 
-	updatedUser, err := s.repo.UpdateUser(ctx, updatedUserDomain)
-	switch err {
-	case ports.ErrUserNotFound:
-		return domain.User{}, ErrUserNotFound
-	case ports.ErrUsernameAlreadyExists:
-		return domain.User{}, ErrUsernameAlreadyExists
+	var updatedUser domain.User
+	err = s.transactions.Do(ctx, func(ctx context.Context) error {
+		var err error
+		updatedUser, err = s.repo.UpdateUser(ctx, updatedUserDomain)
+		switch err {
+		case nil:
+		case ports.ErrUserNotFound:
+			return ErrUserNotFound
+		case ports.ErrUsernameAlreadyExists:
+			return ErrUsernameAlreadyExists
+		default:
+			return err
+		}
+
+		return s.auditService.Record(ctx, auditUseCase.RecordRequest{
+			Action: auditDomain.ActionUpdate,
+			EntityType: auditDomain.EntityUser,
+			EntityID: updatedUser.ID,
+			Before: existingUser,
+			After: updatedUser,
+		})
+	})
+	if err != nil {
+		return domain.User{}, err
 	}
-	return updatedUser, err
+	return updatedUser, nil
 }
 
-func(s *userService) DeleteUser(ctx context.Context, id int) error {
-	err := s.repo.DeleteUser(ctx, id)
+func(s *userService) DeleteUser(ctx context.Context, deleteUserRequest DeleteUserRequest) error {
+	err := s.RequireRole(ctx, domain.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	existingUser, err := s.GetUser(ctx, deleteUserRequest.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.transactions.Do(ctx, func(ctx context.Context) error {
+		err := s.handOverProjects(ctx, deleteUserRequest.ID, deleteUserRequest.SuccessorID)
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.DeleteUser(ctx, deleteUserRequest.ID)
+		switch err {
+		case nil:
+		case ports.ErrUserNotFound:
+			return ErrUserNotFound
+		default:
+			return err
+		}
+
+		return s.auditService.Record(ctx, auditUseCase.RecordRequest{
+			Action: auditDomain.ActionDelete,
+			EntityType: auditDomain.EntityUser,
+			EntityID: existingUser.ID,
+			Before: existingUser,
+		})
+	})
+}
+
+func(s *userService) DeactivateUser(ctx context.Context, deactivateUserRequest DeactivateUserRequest) error {
+	err := s.RequireRole(ctx, domain.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	existingUser, err := s.GetUser(ctx, deactivateUserRequest.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.transactions.Do(ctx, func(ctx context.Context) error {
+		err := s.handOverProjects(ctx, deactivateUserRequest.ID, deactivateUserRequest.SuccessorID)
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.DeactivateUser(ctx, deactivateUserRequest.ID)
+		switch err {
+		case nil:
+		case ports.ErrUserNotFound:
+			return ErrUserNotFound
+		default:
+			return err
+		}
+
+		deactivatedUser := existingUser
+		deactivatedUser.Active = false
+		return s.auditService.Record(ctx, auditUseCase.RecordRequest{
+			Action: auditDomain.ActionUpdate,
+			EntityType: auditDomain.EntityUser,
+			EntityID: existingUser.ID,
+			Before: existingUser,
+			After: deactivatedUser,
+		})
+	})
+}
+
+// handOverProjects transfers every project owned by userID to successorID so
+// that no project is left pointing at a user who is about to disappear.
+func(s *userService) handOverProjects(ctx context.Context, userID int, successorID int) error {
+	ownedProjects, err := s.projectRepo.ListProjectsByOwner(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(ownedProjects) == 0 {
+		return nil
+	}
+
+	if successorID == 0 {
+		return ErrUserOwnsProjects
+	}
+	if successorID == userID {
+		return ErrInvalidSuccessor
+	}
+
+	successor, err := s.repo.GetUser(ctx, successorID)
 	switch err {
+	case nil:
 	case ports.ErrUserNotFound:
-		return ErrUserNotFound
+		return ErrSuccessorNotFound
+	default:
+		return err
+	}
+	if !successor.Active {
+		return ErrInvalidSuccessor
 	}
-	return err
-}
 
+	return s.projectHandover.HandOverProjects(ctx, userID, successorID)
+}
@@ -2,12 +2,21 @@ package usecase_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	auditDomain "github.com/captainhbb/tbs-backend/internal/audit/domain"
+	auditUseCase "github.com/captainhbb/tbs-backend/internal/audit/usecase"
+	auditUseCaseMock "github.com/captainhbb/tbs-backend/internal/audit/usecase/mock"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
 	"github.com/captainhbb/tbs-backend/internal/user/domain"
 	"github.com/captainhbb/tbs-backend/internal/user/ports"
 	portsMock "github.com/captainhbb/tbs-backend/internal/user/ports/mock"
 	"github.com/captainhbb/tbs-backend/internal/user/usecase"
+	usecaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -18,7 +27,7 @@ func TestCreateUser(t *testing.T) {
 	tests := []struct {
 		name          	string
 		input         	usecase.CreateUserRequest
-		mockSetup     	func(repo *portsMock.MockRepository)
+		mockSetup     	func(repo *portsMock.MockRepository, auditService *auditUseCaseMock.MockAuditService)
 		expectError 	bool
 		expectedError   error
 	}{
@@ -34,7 +43,13 @@ func TestCreateUser(t *testing.T) {
 				RepeatPassword: "capitanhb12345",
 				Role:           "admin",
 			},
-			mockSetup: func(repo *portsMock.MockRepository) {
+			mockSetup: func(repo *portsMock.MockRepository, auditService *auditUseCaseMock.MockAuditService) {
+				auditService.On("Record", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(auditUseCase.RecordRequest)
+					require.Equal(t, auditDomain.ActionCreate, capturedArg.Action)
+					require.Equal(t, auditDomain.EntityUser, capturedArg.EntityType)
+					require.Equal(t, 1, capturedArg.EntityID)
+				}).Return(nil).Once()
 				repo.On("CreateUser", mock.Anything, mock.Anything).
 					Run(func(args mock.Arguments) {
 						capturedArg := args.Get(1).(domain.User)
@@ -65,7 +80,7 @@ func TestCreateUser(t *testing.T) {
 				RepeatPassword: "xyz123",
 				Role:           "admin",
 			},
-			mockSetup:     	func(repo *portsMock.MockRepository) {}, // no DB call expected
+			mockSetup:     	func(repo *portsMock.MockRepository, _ *auditUseCaseMock.MockAuditService) {}, // no DB call expected
 			expectError: 	true,
 			expectedError: 	usecase.ErrPasswordMismatch,
 		},
@@ -81,7 +96,7 @@ func TestCreateUser(t *testing.T) {
 				RepeatPassword: "abc123",
 				Role:           "admin",
 			},
-			mockSetup: func(repo *portsMock.MockRepository) {
+			mockSetup: func(repo *portsMock.MockRepository, _ *auditUseCaseMock.MockAuditService) {
 				repo.On("CreateUser", mock.Anything, mock.Anything).
 					Run(func(args mock.Arguments) {
 						capturedArg := args.Get(1).(domain.User)
@@ -96,10 +111,11 @@ func TestCreateUser(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := portsMock.NewMockRepository(t)
-			service := usecase.New(repo)
+			auditService := auditUseCaseMock.NewMockAuditService(t)
+			service := usecase.New(repo, projectPortsMock.NewMockRepository(t), auditService, usecaseMock.NewMockProjectHandover(t), transaction.None())
 
 			ctx := context.Background()
-			tt.mockSetup(repo)
+			tt.mockSetup(repo, auditService)
 
 			createdUser, err := service.CreateUser(ctx, tt.input)
 
@@ -162,7 +178,7 @@ func TestGetUser(t *testing.T) {
 
 	for _, tt := range tests {
 		repo := portsMock.NewMockRepository(t)
-		service := usecase.New(repo)
+		service := usecase.New(repo, projectPortsMock.NewMockRepository(t), auditUseCaseMock.NewMockAuditService(t), usecaseMock.NewMockProjectHandover(t), transaction.None())
 		ctx := context.Background()
 
 		tt.mockSetup(repo)
@@ -192,7 +208,7 @@ func TestUpdateUser(t *testing.T) {
 	tests := []struct {
 		name     		string
 		input           usecase.UpdateUserRequest
-		mockSetup       func(*portsMock.MockRepository)
+		mockSetup       func(*portsMock.MockRepository, *auditUseCaseMock.MockAuditService)
 		expectError     bool
 		expectedError   error
 	} {
@@ -207,7 +223,23 @@ func TestUpdateUser(t *testing.T) {
 				Email: "hossein1377075@gmail.com",
 				Role: "admin",
 			},
-			mockSetup: func(repo *portsMock.MockRepository) {
+			mockSetup: func(repo *portsMock.MockRepository, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetUser", mock.Anything, 1).Return(domain.User{
+					ID: 1,
+					Username: "testuser1",
+					FirstName: "Hossein",
+					LastName: "Beiranvand",
+					Phone: "+989399915084",
+					Email: "old@example.com",
+					Role: "admin",
+					HashedPassword: "somehashedpassword",
+				}, nil)
+				auditService.On("Record", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(auditUseCase.RecordRequest)
+					require.Equal(t, auditDomain.ActionUpdate, capturedArg.Action)
+					require.Equal(t, "old@example.com", capturedArg.Before.(domain.User).Email)
+					require.Equal(t, "hossein1377075@gmail.com", capturedArg.After.(domain.User).Email)
+				}).Return(nil).Once()
 				repo.On("UpdateUser", mock.Anything, domain.User{
 					ID: 1,
 					Username: "testuser1",
@@ -243,7 +275,8 @@ func TestUpdateUser(t *testing.T) {
 				Email: "hossein1377075@gmail.com",
 				Role: "admin",
 			},
-			mockSetup: func(repo *portsMock.MockRepository) {
+			mockSetup: func(repo *portsMock.MockRepository, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetUser", mock.Anything, 1).Return(domain.User{ID: 1, Username: "testuser1"}, nil)
 				repo.On("UpdateUser", mock.Anything, domain.User{
 					ID: 1,
 					Username: "testuser2",
@@ -264,11 +297,12 @@ func TestUpdateUser(t *testing.T) {
 	
 	for _, tt := range tests {
 		repo := portsMock.NewMockRepository(t)
-		service := usecase.New(repo)
+		auditService := auditUseCaseMock.NewMockAuditService(t)
+		service := usecase.New(repo, projectPortsMock.NewMockRepository(t), auditService, usecaseMock.NewMockProjectHandover(t), transaction.None())
 		
 		ctx := context.Background()
 
-		tt.mockSetup(repo)
+		tt.mockSetup(repo, auditService)
 
 		updatedUser, err := service.UpdateUser(ctx, tt.input)
 		if tt.expectError {
@@ -287,42 +321,113 @@ func TestUpdateUser(t *testing.T) {
 	}
 }
 
+var errHandover = errors.New("new owner not eligible")
+
 func TestDeleteUser(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name 			string
-		input       	int
-		mockSetup   	func(*portsMock.MockRepository)
+		input       	usecase.DeleteUserRequest
+		mockSetup   	func(*portsMock.MockRepository, *projectPortsMock.MockRepository, *usecaseMock.MockProjectHandover, *auditUseCaseMock.MockAuditService)
 		expectError 	bool
 		expectedError   error
 	} {
 		{
 			name: "success",
-			input: 1,
-			mockSetup: func(repo *portsMock.MockRepository) {
+			input: usecase.DeleteUserRequest{ID: 1},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, _ *usecaseMock.MockProjectHandover, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetUser", mock.Anything, 1).Return(domain.User{ID: 1, Active: true}, nil).Once()
+				projectRepo.On("ListProjectsByOwner", mock.Anything, 1).Return([]projectDomain.Project{}, nil).Once()
 				repo.On("DeleteUser", mock.Anything, 1).Return(nil).Once()
+				auditService.On("Record", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(auditUseCase.RecordRequest)
+					require.Equal(t, auditDomain.ActionDelete, capturedArg.Action)
+					require.Nil(t, capturedArg.After)
+				}).Return(nil).Once()
 			},
 			expectError: false,
 		},
 		{
 			name: "error",
-			input: 2,
-			mockSetup: func(repo *portsMock.MockRepository) {
+			input: usecase.DeleteUserRequest{ID: 2},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, _ *usecaseMock.MockProjectHandover, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetUser", mock.Anything, 2).Return(domain.User{ID: 2, Active: true}, nil).Once()
+				projectRepo.On("ListProjectsByOwner", mock.Anything, 2).Return([]projectDomain.Project{}, nil).Once()
 				repo.On("DeleteUser", mock.Anything, 2).Return(ports.ErrUserNotFound).Once()
 			},
 			expectError: true,
 			expectedError: usecase.ErrUserNotFound,
 		},
+		{
+			name: "owns projects without successor",
+			input: usecase.DeleteUserRequest{ID: 3},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, _ *usecaseMock.MockProjectHandover, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetUser", mock.Anything, 3).Return(domain.User{ID: 3, Active: true}, nil).Once()
+				projectRepo.On("ListProjectsByOwner", mock.Anything, 3).Return([]projectDomain.Project{{ID: 10, OwnerID: 3}}, nil).Once()
+			},
+			expectError: true,
+			expectedError: usecase.ErrUserOwnsProjects,
+		},
+		{
+			name: "successor is the deleted user",
+			input: usecase.DeleteUserRequest{ID: 3, SuccessorID: 3},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, _ *usecaseMock.MockProjectHandover, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetUser", mock.Anything, 3).Return(domain.User{ID: 3, Active: true}, nil).Once()
+				projectRepo.On("ListProjectsByOwner", mock.Anything, 3).Return([]projectDomain.Project{{ID: 10, OwnerID: 3}}, nil).Once()
+			},
+			expectError: true,
+			expectedError: usecase.ErrInvalidSuccessor,
+		},
+		{
+			name: "successor not found",
+			input: usecase.DeleteUserRequest{ID: 3, SuccessorID: 4},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, _ *usecaseMock.MockProjectHandover, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetUser", mock.Anything, 3).Return(domain.User{ID: 3, Active: true}, nil).Once()
+				projectRepo.On("ListProjectsByOwner", mock.Anything, 3).Return([]projectDomain.Project{{ID: 10, OwnerID: 3}}, nil).Once()
+				repo.On("GetUser", mock.Anything, 4).Return(domain.User{}, ports.ErrUserNotFound).Once()
+			},
+			expectError: true,
+			expectedError: usecase.ErrSuccessorNotFound,
+		},
+		{
+			name: "inactive successor",
+			input: usecase.DeleteUserRequest{ID: 3, SuccessorID: 4},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, _ *usecaseMock.MockProjectHandover, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetUser", mock.Anything, 3).Return(domain.User{ID: 3, Active: true}, nil).Once()
+				projectRepo.On("ListProjectsByOwner", mock.Anything, 3).Return([]projectDomain.Project{{ID: 10, OwnerID: 3}}, nil).Once()
+				repo.On("GetUser", mock.Anything, 4).Return(domain.User{ID: 4, Active: false}, nil).Once()
+			},
+			expectError: true,
+			expectedError: usecase.ErrInvalidSuccessor,
+		},
+		{
+			name: "transfers projects to successor",
+			input: usecase.DeleteUserRequest{ID: 3, SuccessorID: 4},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, projectHandover *usecaseMock.MockProjectHandover, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetUser", mock.Anything, 3).Return(domain.User{ID: 3, Active: true}, nil).Once()
+				projectRepo.On("ListProjectsByOwner", mock.Anything, 3).Return([]projectDomain.Project{{ID: 10, OwnerID: 3}, {ID: 11, OwnerID: 3}}, nil).Once()
+				repo.On("GetUser", mock.Anything, 4).Return(domain.User{ID: 4, Active: true}, nil).Once()
+				projectHandover.On("HandOverProjects", mock.Anything, 3, 4).Return(nil).Once()
+				repo.On("DeleteUser", mock.Anything, 3).Return(nil).Once()
+				auditService.On("Record", mock.Anything, mock.MatchedBy(func(record auditUseCase.RecordRequest) bool {
+					return record.Action == auditDomain.ActionDelete
+				})).Return(nil).Once()
+			},
+			expectError: false,
+		},
 	}
 
 	for _, tt := range tests {
 		repo := portsMock.NewMockRepository(t)
-		service := usecase.New(repo)
+		projectRepo := projectPortsMock.NewMockRepository(t)
+		projectHandover := usecaseMock.NewMockProjectHandover(t)
+		auditService := auditUseCaseMock.NewMockAuditService(t)
+		service := usecase.New(repo, projectRepo, auditService, projectHandover, transaction.None())
 		
-		ctx := context.Background()
+		ctx := actor.AsSystem(context.Background())
 
-		tt.mockSetup(repo)
+		tt.mockSetup(repo, projectRepo, projectHandover, auditService)
 
 		err := service.DeleteUser(ctx, tt.input)
 		if tt.expectError {
@@ -332,5 +437,156 @@ func TestDeleteUser(t *testing.T) {
 		}
 
 		repo.AssertExpectations(t)
+		projectRepo.AssertExpectations(t)
+		auditService.AssertExpectations(t)
 	}
-}
\ No newline at end of file
+}
+
+func TestDeactivateUser(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name 			string
+		input       	usecase.DeactivateUserRequest
+		mockSetup   	func(*portsMock.MockRepository, *projectPortsMock.MockRepository, *usecaseMock.MockProjectHandover, *auditUseCaseMock.MockAuditService)
+		expectError 	bool
+		expectedError   error
+	} {
+		{
+			name: "success",
+			input: usecase.DeactivateUserRequest{ID: 1},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, _ *usecaseMock.MockProjectHandover, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetUser", mock.Anything, 1).Return(domain.User{ID: 1, Active: true}, nil).Once()
+				projectRepo.On("ListProjectsByOwner", mock.Anything, 1).Return([]projectDomain.Project{}, nil).Once()
+				repo.On("DeactivateUser", mock.Anything, 1).Return(nil).Once()
+				auditService.On("Record", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					capturedArg := args.Get(1).(auditUseCase.RecordRequest)
+					require.Equal(t, []auditDomain.FieldChange{{Field: "Active", Before: "true", After: "false"}}, auditDomain.Diff(capturedArg.Before, capturedArg.After))
+				}).Return(nil).Once()
+			},
+			expectError: false,
+		},
+		{
+			name: "owns projects without successor",
+			input: usecase.DeactivateUserRequest{ID: 1},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, _ *usecaseMock.MockProjectHandover, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetUser", mock.Anything, 1).Return(domain.User{ID: 1, Active: true}, nil).Once()
+				projectRepo.On("ListProjectsByOwner", mock.Anything, 1).Return([]projectDomain.Project{{ID: 10, OwnerID: 1}}, nil).Once()
+			},
+			expectError: true,
+			expectedError: usecase.ErrUserOwnsProjects,
+		},
+		{
+			name: "transfers projects to successor",
+			input: usecase.DeactivateUserRequest{ID: 1, SuccessorID: 2},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, projectHandover *usecaseMock.MockProjectHandover, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("GetUser", mock.Anything, 1).Return(domain.User{ID: 1, Active: true}, nil).Once()
+				projectRepo.On("ListProjectsByOwner", mock.Anything, 1).Return([]projectDomain.Project{{ID: 10, OwnerID: 1}}, nil).Once()
+				repo.On("GetUser", mock.Anything, 2).Return(domain.User{ID: 2, Active: true}, nil).Once()
+				projectHandover.On("HandOverProjects", mock.Anything, 1, 2).Return(nil).Once()
+				repo.On("DeactivateUser", mock.Anything, 1).Return(nil).Once()
+				auditService.On("Record", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expectError: false,
+		},
+		{
+			name: "keeps the user when the handover fails",
+			input: usecase.DeactivateUserRequest{ID: 1, SuccessorID: 2},
+			mockSetup: func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, projectHandover *usecaseMock.MockProjectHandover, _ *auditUseCaseMock.MockAuditService) {
+				repo.On("GetUser", mock.Anything, 1).Return(domain.User{ID: 1, Active: true}, nil).Once()
+				projectRepo.On("ListProjectsByOwner", mock.Anything, 1).Return([]projectDomain.Project{{ID: 10, OwnerID: 1}}, nil).Once()
+				repo.On("GetUser", mock.Anything, 2).Return(domain.User{ID: 2, Active: true}, nil).Once()
+				projectHandover.On("HandOverProjects", mock.Anything, 1, 2).Return(errHandover).Once()
+			},
+			expectError: true,
+			expectedError: errHandover,
+		},
+	}
+
+	for _, tt := range tests {
+		repo := portsMock.NewMockRepository(t)
+		projectRepo := projectPortsMock.NewMockRepository(t)
+		projectHandover := usecaseMock.NewMockProjectHandover(t)
+		auditService := auditUseCaseMock.NewMockAuditService(t)
+		service := usecase.New(repo, projectRepo, auditService, projectHandover, transaction.None())
+
+		ctx := actor.AsSystem(context.Background())
+
+		tt.mockSetup(repo, projectRepo, projectHandover, auditService)
+
+		err := service.DeactivateUser(ctx, tt.input)
+		if tt.expectError {
+			require.ErrorIs(t, err, tt.expectedError)
+		} else {
+			require.NoError(t, err)
+		}
+
+		repo.AssertExpectations(t)
+		projectRepo.AssertExpectations(t)
+		auditService.AssertExpectations(t)
+	}
+}
+
+func TestDeleteAndDeactivateUserAreForAdmins(t *testing.T) {
+	t.Parallel()
+
+	repo := portsMock.NewMockRepository(t)
+	service := usecase.New(repo, projectPortsMock.NewMockRepository(t), auditUseCaseMock.NewMockAuditService(t), usecaseMock.NewMockProjectHandover(t), transaction.None())
+	ctx := actor.WithID(context.Background(), 5)
+
+	repo.On("GetUser", mock.Anything, 5).Return(domain.User{ID: 5, Role: domain.RoleManager, Active: true}, nil)
+
+	err := service.DeleteUser(ctx, usecase.DeleteUserRequest{ID: 3, SuccessorID: 5})
+	require.ErrorIs(t, err, usecase.ErrForbidden)
+
+	err = service.DeactivateUser(ctx, usecase.DeactivateUserRequest{ID: 3, SuccessorID: 5})
+	require.ErrorIs(t, err, usecase.ErrForbidden)
+}
+
+func TestRequireRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name			string
+		ctx				context.Context
+		expectedError	error
+	}{
+		{
+			name: "manager among the roles",
+			ctx: actor.WithID(context.Background(), 2),
+		},
+		{
+			name: "regular user",
+			ctx: actor.WithID(context.Background(), 3),
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "unknown user",
+			ctx: actor.WithID(context.Background(), 9),
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "no actor",
+			ctx: context.Background(),
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "system",
+			ctx: actor.AsSystem(context.Background()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := portsMock.NewMockRepository(t)
+			service := usecase.New(repo, projectPortsMock.NewMockRepository(t), auditUseCaseMock.NewMockAuditService(t), usecaseMock.NewMockProjectHandover(t), transaction.None())
+
+			repo.On("GetUser", mock.Anything, 2).Return(domain.User{ID: 2, Role: domain.RoleManager}, nil).Maybe()
+			repo.On("GetUser", mock.Anything, 3).Return(domain.User{ID: 3}, nil).Maybe()
+			repo.On("GetUser", mock.Anything, 9).Return(domain.User{}, ports.ErrUserNotFound).Maybe()
+
+			err := service.RequireRole(tt.ctx, domain.RoleAdmin, domain.RoleManager)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
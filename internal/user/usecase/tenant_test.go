@@ -0,0 +1,126 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	auditUseCaseMock "github.com/captainhbb/tbs-backend/internal/audit/usecase/mock"
+	projectScoped "github.com/captainhbb/tbs-backend/internal/project/adapters/scoped"
+	projectDomain "github.com/captainhbb/tbs-backend/internal/project/domain"
+	projectPortsMock "github.com/captainhbb/tbs-backend/internal/project/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/user/adapters/scoped"
+	"github.com/captainhbb/tbs-backend/internal/user/domain"
+	portsMock "github.com/captainhbb/tbs-backend/internal/user/ports/mock"
+	"github.com/captainhbb/tbs-backend/internal/user/usecase"
+	usecaseMock "github.com/captainhbb/tbs-backend/internal/user/usecase/mock"
+	"github.com/captainhbb/tbs-backend/pkg/actor"
+	"github.com/captainhbb/tbs-backend/pkg/tenant"
+	"github.com/captainhbb/tbs-backend/pkg/transaction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTenantIsolation runs the user service on top of the tenant scoped
+// repositories, the way it is wired in production, with a caller in tenant 1.
+// User 1 belongs to tenant 1 and user 2 to tenant 2.
+func TestTenantIsolation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name			string
+		call			func(service usecase.UserService, ctx context.Context) error
+		mockSetup		func(repo *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, auditService *auditUseCaseMock.MockAuditService)
+		expectedError	error
+	}{
+		{
+			name: "reads a user of the same tenant",
+			call: func(service usecase.UserService, ctx context.Context) error {
+				user, err := service.GetUser(ctx, 1)
+				require.Equal(t, 1, user.ID)
+				return err
+			},
+		},
+		{
+			name: "cannot read a user of another tenant",
+			call: func(service usecase.UserService, ctx context.Context) error {
+				_, err := service.GetUser(ctx, 2)
+				return err
+			},
+			expectedError: usecase.ErrUserNotFound,
+		},
+		{
+			name: "cannot update a user of another tenant",
+			call: func(service usecase.UserService, ctx context.Context) error {
+				_, err := service.UpdateUser(ctx, usecase.UpdateUserRequest{ID: 2, Username: "taken-over"})
+				return err
+			},
+			expectedError: usecase.ErrUserNotFound,
+		},
+		{
+			name: "cannot delete a user of another tenant",
+			call: func(service usecase.UserService, ctx context.Context) error {
+				return service.DeleteUser(ctx, usecase.DeleteUserRequest{ID: 2})
+			},
+			expectedError: usecase.ErrUserNotFound,
+		},
+		{
+			name: "cannot deactivate a user of another tenant",
+			call: func(service usecase.UserService, ctx context.Context) error {
+				return service.DeactivateUser(ctx, usecase.DeactivateUserRequest{ID: 2})
+			},
+			expectedError: usecase.ErrUserNotFound,
+		},
+		{
+			name: "cannot hand projects over to a user of another tenant",
+			call: func(service usecase.UserService, ctx context.Context) error {
+				return service.DeactivateUser(ctx, usecase.DeactivateUserRequest{ID: 1, SuccessorID: 2})
+			},
+			mockSetup: func(_ *portsMock.MockRepository, projectRepo *projectPortsMock.MockRepository, _ *auditUseCaseMock.MockAuditService) {
+				projectRepo.On("ListProjectsByOwner", mock.Anything, 1).Return([]projectDomain.Project{{ID: 10, TenantID: 1, OwnerID: 1}}, nil)
+			},
+			expectedError: usecase.ErrSuccessorNotFound,
+		},
+		{
+			name: "creates users within the caller's tenant",
+			call: func(service usecase.UserService, ctx context.Context) error {
+				user, err := service.CreateUser(ctx, usecase.CreateUserRequest{
+					Username: "newcomer",
+					Password: "secret12345",
+					RepeatPassword: "secret12345",
+					Role: domain.RoleManager,
+				})
+				require.Equal(t, 1, user.TenantID)
+				return err
+			},
+			mockSetup: func(repo *portsMock.MockRepository, _ *projectPortsMock.MockRepository, auditService *auditUseCaseMock.MockAuditService) {
+				repo.On("CreateUser", mock.Anything, mock.Anything).Return(func(_ context.Context, user domain.User) (domain.User, error) {
+					user.ID = 3
+					return user, nil
+				})
+				auditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoMock := portsMock.NewMockRepository(t)
+			projectRepoMock := projectPortsMock.NewMockRepository(t)
+			auditServiceMock := auditUseCaseMock.NewMockAuditService(t)
+			repoMock.On("GetUser", mock.Anything, 1).Return(domain.User{ID: 1, TenantID: 1, Username: "own", Active: true}, nil).Maybe()
+			repoMock.On("GetUser", mock.Anything, 2).Return(domain.User{ID: 2, TenantID: 2, Username: "foreign", Active: true}, nil).Maybe()
+			if tt.mockSetup != nil {
+				tt.mockSetup(repoMock, projectRepoMock, auditServiceMock)
+			}
+
+			service := usecase.New(scoped.New(repoMock), projectScoped.New(projectRepoMock), auditServiceMock, usecaseMock.NewMockProjectHandover(t), transaction.None())
+			err := tt.call(service, actor.AsSystem(tenant.WithID(context.Background(), 1)))
+			require.ErrorIs(t, err, tt.expectedError)
+			repoMock.AssertNotCalled(t, "UpdateUser", mock.Anything, mock.Anything)
+			repoMock.AssertNotCalled(t, "DeleteUser", mock.Anything, mock.Anything)
+			repoMock.AssertNotCalled(t, "DeactivateUser", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package actor
+
+import (
+	"context"
+)
+
+type contextKey struct{}
+
+type systemKey struct{}
+
+// WithID returns a copy of ctx carrying the ID of the user performing the request.
+func WithID(ctx context.Context, id int) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// IDFromContext returns the ID of the acting user, if the request carries one.
+func IDFromContext(ctx context.Context) (int, bool) {
+	id, ok := ctx.Value(contextKey{}).(int)
+	return id, ok
+}
+
+// AsSystem returns a copy of ctx acting as the system itself rather than as a
+// user. Background jobs and internal follow-up work use it to pass permission
+// checks, which deny requests carrying no actor at all.
+func AsSystem(ctx context.Context) context.Context {
+	return context.WithValue(ctx, systemKey{}, true)
+}
+
+// IsSystem reports whether ctx acts as the system.
+func IsSystem(ctx context.Context) bool {
+	system, _ := ctx.Value(systemKey{}).(bool)
+	return system
+}
@@ -0,0 +1,24 @@
+package money
+
+// minorUnits maps ISO 4217 currency codes to the number of decimal digits of
+// their minor unit.
+var minorUnits = map[string]int{
+	"AED": 2, "AUD": 2, "BHD": 3, "BRL": 2, "CAD": 2, "CHF": 2, "CLP": 0,
+	"CNY": 2, "CZK": 2, "DKK": 2, "EUR": 2, "GBP": 2, "HKD": 2, "HUF": 2,
+	"IDR": 2, "ILS": 2, "INR": 2, "IQD": 3, "IRR": 2, "ISK": 0, "JOD": 3,
+	"JPY": 0, "KRW": 0, "KWD": 3, "LYD": 3, "MXN": 2, "MYR": 2, "NOK": 2,
+	"NZD": 2, "OMR": 3, "PLN": 2, "QAR": 2, "RUB": 2, "SAR": 2, "SEK": 2,
+	"SGD": 2, "THB": 2, "TND": 3, "TRY": 2, "UGX": 0, "USD": 2, "VND": 0,
+	"XAF": 0, "XOF": 0, "ZAR": 2,
+}
+
+// IsKnownCurrency reports whether code is a supported ISO 4217 currency code.
+func IsKnownCurrency(code string) bool {
+	_, ok := minorUnits[code]
+	return ok
+}
+
+// MinorUnits returns the number of decimal digits used by the currency.
+func MinorUnits(code string) int {
+	return minorUnits[code]
+}
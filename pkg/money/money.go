@@ -0,0 +1,337 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrUnknownCurrency		= errors.New("unknown currency")
+	ErrCurrencyMismatch		= errors.New("currencies do not match")
+	ErrInvalidAmount		= errors.New("invalid amount")
+	ErrTooManyDecimals		= errors.New("amount has more decimals than the currency allows")
+	ErrOverflow				= errors.New("amount out of range")
+)
+
+// Money is an exact amount in a single currency, stored as an integer number of
+// minor units (cents for USD, yen for JPY, fils for KWD). The zero value has no
+// currency and represents "no amount".
+//
+// Amounts are never rounded implicitly. Parsing rejects more decimals than the
+// currency has, and operations that can produce fractions of a minor unit
+// (Mul, Allocate) round half to even.
+type Money struct {
+	amount		int64
+	currency	string
+}
+
+// New returns an amount of minorUnits in currency.
+func New(minorUnits int64, currency string) (Money, error) {
+	if !IsKnownCurrency(currency) {
+		return Money{}, ErrUnknownCurrency
+	}
+	return Money{amount: minorUnits, currency: currency}, nil
+}
+
+// MustNew is like New but panics on an unknown currency. It is meant for
+// constants and tests.
+func MustNew(minorUnits int64, currency string) Money {
+	m, err := New(minorUnits, currency)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Parse reads a decimal amount such as "-1234.5" in currency.
+func Parse(amount string, currency string) (Money, error) {
+	if !IsKnownCurrency(currency) {
+		return Money{}, ErrUnknownCurrency
+	}
+
+	text := strings.TrimSpace(amount)
+	negative := false
+	switch {
+	case strings.HasPrefix(text, "-"):
+		negative = true
+		text = text[1:]
+	case strings.HasPrefix(text, "+"):
+		text = text[1:]
+	}
+
+	whole, fraction, hasPoint := strings.Cut(text, ".")
+	if whole == "" && fraction == "" || hasPoint && fraction == "" || !isDigits(whole) || !isDigits(fraction) {
+		return Money{}, ErrInvalidAmount
+	}
+
+	digits := MinorUnits(currency)
+	if len(fraction) > digits {
+		return Money{}, ErrTooManyDecimals
+	}
+	fraction += strings.Repeat("0", digits - len(fraction))
+
+	minor, err := strconv.ParseInt(whole + fraction, 10, 64)
+	if err != nil {
+		return Money{}, ErrOverflow
+	}
+	if negative {
+		minor = -minor
+	}
+	return Money{amount: minor, currency: currency}, nil
+}
+
+// Zero returns a zero amount in currency.
+func Zero(currency string) (Money, error) {
+	return New(0, currency)
+}
+
+// Amount returns the amount in minor units.
+func (m Money) Amount() int64 {
+	return m.amount
+}
+
+func (m Money) Currency() string {
+	return m.currency
+}
+
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+	sum := m.amount + other.amount
+	if (sum > m.amount) != (other.amount > 0) {
+		return Money{}, ErrOverflow
+	}
+	return Money{amount: sum, currency: m.currency}, nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	return m.Add(other.Neg())
+}
+
+func (m Money) Neg() Money {
+	return Money{amount: -m.amount, currency: m.currency}
+}
+
+// Cmp compares two amounts in the same currency and returns -1, 0 or +1.
+func (m Money) Cmp(other Money) (int, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.amount < other.amount:
+		return -1, nil
+	case m.amount > other.amount:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Mul multiplies the amount by numerator/denominator, rounding half to even.
+func (m Money) Mul(numerator int64, denominator int64) (Money, error) {
+	return m.MulRat(new(big.Rat).SetFrac64(numerator, denominator))
+}
+
+// MulRat multiplies the amount by an exact ratio, rounding half to even.
+func (m Money) MulRat(factor *big.Rat) (Money, error) {
+	product := new(big.Rat).Mul(new(big.Rat).SetInt64(m.amount), factor)
+	rounded := RoundHalfEven(product)
+	if !rounded.IsInt64() {
+		return Money{}, ErrOverflow
+	}
+	return Money{amount: rounded.Int64(), currency: m.currency}, nil
+}
+
+// Allocate splits the amount in proportion to ratios without losing a minor
+// unit: the remainder left by rounding down is handed out one unit at a time
+// starting with the first share.
+func (m Money) Allocate(ratios ...int64) ([]Money, error) {
+	var total int64
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, ErrInvalidAmount
+		}
+		total += ratio
+	}
+	if total == 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	shares := make([]Money, len(ratios))
+	remainder := m.amount
+	for i, ratio := range ratios {
+		share := new(big.Int).Mul(big.NewInt(m.amount), big.NewInt(ratio))
+		share.Quo(share, big.NewInt(total))
+		shares[i] = Money{amount: share.Int64(), currency: m.currency}
+		remainder -= share.Int64()
+	}
+
+	step := int64(1)
+	if remainder < 0 {
+		step = -1
+	}
+	for i := 0; remainder != 0; i = (i + 1) % len(shares) {
+		if ratios[i] == 0 {
+			continue
+		}
+		shares[i].amount += step
+		remainder -= step
+	}
+	return shares, nil
+}
+
+// Decimal formats the amount without its currency, e.g. "-1234.50".
+func (m Money) Decimal() string {
+	digits := MinorUnits(m.currency)
+	amount := m.amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+	}
+	text := strconv.FormatUint(absolute(amount), 10)
+	if digits == 0 {
+		return sign + text
+	}
+	if len(text) <= digits {
+		text = strings.Repeat("0", digits - len(text) + 1) + text
+	}
+	return sign + text[:len(text)-digits] + "." + text[len(text)-digits:]
+}
+
+// Float64 returns an approximation of the amount in major units. It is meant
+// for ratios and statistics, never for storing or adding money.
+func (m Money) Float64() float64 {
+	value, _ := new(big.Rat).SetFrac(big.NewInt(m.amount), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(MinorUnits(m.currency))), nil)).Float64()
+	return value
+}
+
+func (m Money) String() string {
+	if m.currency == "" {
+		return ""
+	}
+	return m.Decimal() + " " + m.currency
+}
+
+type jsonMoney struct {
+	Amount		string	`json:"amount"`
+	Currency	string	`json:"currency"`
+}
+
+// MarshalJSON encodes the amount as a decimal string so that no precision is
+// lost to floating point: {"amount":"1234.50","currency":"USD"}.
+func (m Money) MarshalJSON() ([]byte, error) {
+	if m.currency == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(jsonMoney{Amount: m.Decimal(), Currency: m.currency})
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*m = Money{}
+		return nil
+	}
+	var decoded jsonMoney
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	parsed, err := Parse(decoded.Amount, decoded.Currency)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Value stores the amount in a single text column as "1234.50 USD".
+func (m Money) Value() (driver.Value, error) {
+	if m.currency == "" {
+		return nil, nil
+	}
+	return m.String(), nil
+}
+
+func (m *Money) Scan(src any) error {
+	var text string
+	switch value := src.(type) {
+	case nil:
+		*m = Money{}
+		return nil
+	case string:
+		text = value
+	case []byte:
+		text = string(value)
+	default:
+		return fmt.Errorf("money: cannot scan %T", src)
+	}
+
+	amount, currency, ok := strings.Cut(strings.TrimSpace(text), " ")
+	if !ok {
+		return ErrInvalidAmount
+	}
+	parsed, err := Parse(amount, currency)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// RoundHalfEven rounds x to the nearest integer, ties going to the even one.
+func RoundHalfEven(x *big.Rat) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(x.Num(), x.Denom(), new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	twiceRemainder := new(big.Int).Abs(remainder)
+	twiceRemainder.Lsh(twiceRemainder, 1)
+	switch twiceRemainder.Cmp(x.Denom()) {
+	case -1:
+		return quotient
+	case 0:
+		if quotient.Bit(0) == 0 {
+			return quotient
+		}
+	}
+	if x.Sign() < 0 {
+		return quotient.Sub(quotient, big.NewInt(1))
+	}
+	return quotient.Add(quotient, big.NewInt(1))
+}
+
+func (m Money) checkCurrency(other Money) error {
+	if m.currency != other.currency {
+		return ErrCurrencyMismatch
+	}
+	return nil
+}
+
+func isDigits(text string) bool {
+	for _, r := range text {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func absolute(amount int64) uint64 {
+	if amount < 0 {
+		return uint64(-(amount + 1)) + 1
+	}
+	return uint64(amount)
+}
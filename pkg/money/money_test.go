@@ -0,0 +1,149 @@
+package money_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/captainhbb/tbs-backend/pkg/money"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		amount string
+		currency string
+		expectedMinor int64
+		expectError bool
+		expectedError error
+	}{
+		{name: "whole amount", amount: "1000000", currency: "USD", expectedMinor: 100000000},
+		{name: "cents", amount: "1234.56", currency: "USD", expectedMinor: 123456},
+		{name: "short fraction", amount: "0.5", currency: "EUR", expectedMinor: 50},
+		{name: "negative", amount: "-12.05", currency: "USD", expectedMinor: -1205},
+		{name: "three decimal currency", amount: "1.234", currency: "KWD", expectedMinor: 1234},
+		{name: "zero decimal currency", amount: "1500", currency: "JPY", expectedMinor: 1500},
+		{name: "large amount keeps cents", amount: "90071992547409.93", currency: "USD", expectedMinor: 9007199254740993},
+		{name: "too many decimals", amount: "1.005", currency: "USD", expectError: true, expectedError: money.ErrTooManyDecimals},
+		{name: "decimals on yen", amount: "1.5", currency: "JPY", expectError: true, expectedError: money.ErrTooManyDecimals},
+		{name: "garbage", amount: "12a", currency: "USD", expectError: true, expectedError: money.ErrInvalidAmount},
+		{name: "dangling point", amount: "12.", currency: "USD", expectError: true, expectedError: money.ErrInvalidAmount},
+		{name: "unknown currency", amount: "1", currency: "XYZ", expectError: true, expectedError: money.ErrUnknownCurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := money.Parse(tt.amount, tt.currency)
+			if tt.expectError {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedMinor, parsed.Amount())
+				require.Equal(t, tt.currency, parsed.Currency())
+			}
+		})
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "1234.50", money.MustNew(123450, "USD").Decimal())
+	require.Equal(t, "0.05", money.MustNew(5, "USD").Decimal())
+	require.Equal(t, "-0.05", money.MustNew(-5, "USD").Decimal())
+	require.Equal(t, "1500", money.MustNew(1500, "JPY").Decimal())
+	require.Equal(t, "0.001", money.MustNew(1, "KWD").Decimal())
+	require.Equal(t, "12.34 EUR", money.MustNew(1234, "EUR").String())
+}
+
+func TestArithmetic(t *testing.T) {
+	t.Parallel()
+
+	sum, err := money.MustNew(1050, "USD").Add(money.MustNew(275, "USD"))
+	require.NoError(t, err)
+	require.Equal(t, money.MustNew(1325, "USD"), sum)
+
+	difference, err := money.MustNew(1050, "USD").Sub(money.MustNew(2000, "USD"))
+	require.NoError(t, err)
+	require.True(t, difference.IsNegative())
+
+	_, err = money.MustNew(1, "USD").Add(money.MustNew(1, "EUR"))
+	require.ErrorIs(t, err, money.ErrCurrencyMismatch)
+
+	_, err = money.MustNew(1<<62, "USD").Add(money.MustNew(1<<62, "USD"))
+	require.ErrorIs(t, err, money.ErrOverflow)
+}
+
+func TestMulRoundsHalfToEven(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		minor int64
+		numerator int64
+		denominator int64
+		expected int64
+	}{
+		{minor: 5, numerator: 1, denominator: 2, expected: 2},
+		{minor: 15, numerator: 1, denominator: 10, expected: 2},
+		{minor: 25, numerator: 1, denominator: 10, expected: 2},
+		{minor: 26, numerator: 1, denominator: 10, expected: 3},
+		{minor: -5, numerator: 1, denominator: 2, expected: -2},
+		{minor: -15, numerator: 1, denominator: 10, expected: -2},
+		{minor: 100, numerator: 1, denominator: 3, expected: 33},
+	}
+
+	for _, tt := range tests {
+		product, err := money.MustNew(tt.minor, "USD").Mul(tt.numerator, tt.denominator)
+		require.NoError(t, err)
+		require.Equal(t, tt.expected, product.Amount(), "%d * %d/%d", tt.minor, tt.numerator, tt.denominator)
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	t.Parallel()
+
+	shares, err := money.MustNew(100, "USD").Allocate(1, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, []money.Money{money.MustNew(34, "USD"), money.MustNew(33, "USD"), money.MustNew(33, "USD")}, shares)
+
+	shares, err = money.MustNew(-100, "USD").Allocate(1, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, []money.Money{money.MustNew(-50, "USD"), money.MustNew(0, "USD"), money.MustNew(-50, "USD")}, shares)
+
+	_, err = money.MustNew(100, "USD").Allocate(0, 0)
+	require.ErrorIs(t, err, money.ErrInvalidAmount)
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	original := money.MustNew(9007199254740993, "USD")
+
+	encoded, err := json.Marshal(original)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"amount":"90071992547409.93","currency":"USD"}`, string(encoded))
+
+	var decoded money.Money
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.Equal(t, original, decoded)
+
+	encoded, err = json.Marshal(money.Money{})
+	require.NoError(t, err)
+	require.Equal(t, "null", string(encoded))
+}
+
+func TestSQLRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	original := money.MustNew(-1234, "KWD")
+
+	value, err := original.Value()
+	require.NoError(t, err)
+	require.Equal(t, "-1.234 KWD", value)
+
+	var scanned money.Money
+	require.NoError(t, scanned.Scan([]byte(value.(string))))
+	require.Equal(t, original, scanned)
+}
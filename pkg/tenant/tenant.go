@@ -0,0 +1,63 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrTenantRequired is returned for requests that neither act within a tenant
+// nor make a platform call.
+var ErrTenantRequired = errors.New("request acts within no tenant")
+
+type contextKey struct{}
+
+type platformKey struct{}
+
+// WithID returns a copy of ctx carrying the ID of the tenant the request acts
+// within.
+func WithID(ctx context.Context, id int) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// IDFromContext returns the ID of the request's tenant, if it carries one.
+func IDFromContext(ctx context.Context) (int, bool) {
+	id, ok := ctx.Value(contextKey{}).(int)
+	return id, ok
+}
+
+// Require returns the ID of the request's tenant, failing with
+// ErrTenantRequired when it carries none.
+func Require(ctx context.Context) (int, error) {
+	id, ok := IDFromContext(ctx)
+	if !ok {
+		return 0, ErrTenantRequired
+	}
+	return id, nil
+}
+
+// AsPlatform returns a copy of ctx making a platform call, which spans every
+// tenant. Tenant administration and jobs that work across tenants use it;
+// entering a tenant ends it.
+func AsPlatform(ctx context.Context) context.Context {
+	return context.WithValue(ctx, platformKey{}, true)
+}
+
+// IsPlatform reports whether ctx makes a platform call and acts within no
+// tenant.
+func IsPlatform(ctx context.Context) bool {
+	if _, ok := IDFromContext(ctx); ok {
+		return false
+	}
+	platform, _ := ctx.Value(platformKey{}).(bool)
+	return platform
+}
+
+// Visible reports whether data owned by tenantID may be seen from ctx: by
+// platform calls, or by requests acting within that same tenant. Requests
+// carrying neither see nothing.
+func Visible(ctx context.Context, tenantID int) bool {
+	if id, ok := IDFromContext(ctx); ok {
+		return id == tenantID
+	}
+	return IsPlatform(ctx)
+}
@@ -0,0 +1,74 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+)
+
+type contextKey struct{}
+
+// Manager runs work that has to succeed or fail as a whole.
+type Manager interface {
+	// Do runs fn within a transaction carried by the context handed to it,
+	// committing when fn returns nil and rolling back otherwise. Calls to Do
+	// made inside fn join the transaction already under way.
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Executor runs statements, either directly on the database or within a
+// transaction.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type sqlManager struct {
+	db *sql.DB
+}
+
+// NewSQL returns a Manager running work in transactions of db. Repositories
+// built on the same db take part by running their statements on From(ctx, db).
+func NewSQL(db *sql.DB) Manager {
+	return &sqlManager{
+		db: db,
+	}
+}
+
+func(m *sqlManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(contextKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = fn(context.WithValue(ctx, contextKey{}, tx))
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// From returns the transaction carried by ctx, or db when there is none.
+func From(ctx context.Context, db *sql.DB) Executor {
+	if tx, ok := ctx.Value(contextKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+type noneManager struct{}
+
+// None returns a Manager that runs work as it comes, for storage without
+// transactions such as the in-memory adapters, and for tests.
+func None() Manager {
+	return noneManager{}
+}
+
+func(noneManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}